@@ -0,0 +1,216 @@
+package instana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	//DataSourceAlertingChannel the name of the terraform-provider-instana data source to read alerting channels
+	DataSourceAlertingChannel = "instana_alerting_channel"
+
+	//AlertingChannelDataSourceFieldNameRegex constant value for the schema field name_regex of the alerting channel data source
+	AlertingChannelDataSourceFieldNameRegex = "name_regex"
+	//AlertingChannelDataSourceFieldKind constant value for the schema field kind of the alerting channel data source
+	AlertingChannelDataSourceFieldKind = "kind"
+)
+
+//NewAlertingChannelDataSource creates a new DataSource for alerting channels
+func NewAlertingChannelDataSource() DataSource {
+	return &alertingChannelDataSource{}
+}
+
+type alertingChannelDataSource struct{}
+
+//CreateResource creates the terraform resource of the data source for alerting channels
+func (ds *alertingChannelDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		Read: WithOperationContext(schema.TimeoutRead, ds.read),
+		Schema: map[string]*schema.Schema{
+			AlertingChannelFieldName: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{AlertingChannelDataSourceFieldNameRegex},
+				Description:   "The exact name of the alerting channel as it is shown in Instana",
+			},
+			AlertingChannelDataSourceFieldNameRegex: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.ValidateRegexp,
+				ConflictsWith: []string{AlertingChannelFieldName},
+				Description:   "A regular expression which is used to look up the alerting channel by its name",
+			},
+			AlertingChannelDataSourceFieldKind: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(convertSupportedAlertingChannelTypesToStringSlice(), false),
+				Description:  "The kind of the alerting channel. When configured only alerting channels of the given kind are considered",
+			},
+			AlertingChannelEmailFieldEmails: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The list of emails of an Email alerting channel",
+			},
+			AlertingChannelWebhookBasedFieldWebhookURL: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The webhook URL of a Google Chat, Office 365, Prometheus Webhook, Slack or Webex Teams Webhook alerting channel",
+			},
+			AlertingChannelOpsGenieFieldAPIKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key of an OpsGenie or VictorOps alerting channel",
+			},
+			AlertingChannelOpsGenieFieldTags: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The comma separated tags of an OpsGenie alerting channel",
+			},
+			AlertingChannelOpsGenieFieldRegion: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region of an OpsGenie alerting channel",
+			},
+			AlertingChannelVictorOpsFieldRoutingKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The routing key of a VictorOps alerting channel",
+			},
+			AlertingChannelPagerDutyFieldServiceIntegrationKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The service integration key of a PagerDuty alerting channel",
+			},
+			AlertingChannelSlackFieldIconURL: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The icon URL of a Slack alerting channel",
+			},
+			AlertingChannelSlackFieldChannel: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Slack channel of a Slack alerting channel",
+			},
+			AlertingChannelSplunkFieldURL: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of a Splunk alerting channel",
+			},
+			AlertingChannelSplunkFieldToken: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token of a Splunk alerting channel",
+			},
+			AlertingChannelWebhookFieldWebhookURLs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The list of webhook urls of a Webhook alerting channel",
+			},
+			AlertingChannelWebhookFieldHTTPHeaders: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The map of HTTP headers of a Webhook alerting channel",
+			},
+			AlertingChannelPrometheusWebhookFieldReceiver: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The receiver name of a Prometheus Webhook alerting channel",
+			},
+		},
+	}
+}
+
+func (ds *alertingChannelDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	matcher, err := ds.createNameMatcher(d)
+	if err != nil {
+		return err
+	}
+	kind := restapi.AlertingChannelType(d.Get(AlertingChannelDataSourceFieldKind).(string))
+
+	channels, err := instanaAPI.AlertingChannels().GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	matchingChannels := make([]restapi.AlertingChannel, 0)
+	for _, obj := range channels {
+		channel := obj.(restapi.AlertingChannel)
+		if matcher(channel.Name) && (len(kind) == 0 || channel.Kind == kind) {
+			matchingChannels = append(matchingChannels, channel)
+		}
+	}
+
+	if len(matchingChannels) == 0 {
+		return errors.New("no alerting channel found matching the given criteria")
+	}
+	if len(matchingChannels) > 1 {
+		return fmt.Errorf("%d alerting channels found matching the given criteria; criteria must match exactly one alerting channel", len(matchingChannels))
+	}
+	ds.updateState(d, matchingChannels[0])
+	return nil
+}
+
+func (ds *alertingChannelDataSource) createNameMatcher(d *schema.ResourceData) (func(name string) bool, error) {
+	if nameRegex, ok := d.GetOk(AlertingChannelDataSourceFieldNameRegex); ok {
+		regex, err := regexp.Compile(nameRegex.(string))
+		if err != nil {
+			return nil, err
+		}
+		return regex.MatchString, nil
+	}
+	if name, ok := d.GetOk(AlertingChannelFieldName); ok {
+		return func(n string) bool { return n == name.(string) }, nil
+	}
+	return nil, fmt.Errorf("either %s or %s must be configured", AlertingChannelFieldName, AlertingChannelDataSourceFieldNameRegex)
+}
+
+func (ds *alertingChannelDataSource) updateState(d *schema.ResourceData, channel restapi.AlertingChannel) {
+	d.SetId(channel.ID)
+	d.Set(AlertingChannelFieldName, channel.Name)
+	d.Set(AlertingChannelDataSourceFieldKind, string(channel.Kind))
+	d.Set(AlertingChannelEmailFieldEmails, channel.Emails)
+	d.Set(AlertingChannelWebhookBasedFieldWebhookURL, channel.WebhookURL)
+	d.Set(AlertingChannelOpsGenieFieldAPIKey, channel.APIKey)
+	d.Set(AlertingChannelOpsGenieFieldTags, channel.Tags)
+	if channel.Region != nil {
+		d.Set(AlertingChannelOpsGenieFieldRegion, string(*channel.Region))
+	}
+	d.Set(AlertingChannelVictorOpsFieldRoutingKey, channel.RoutingKey)
+	d.Set(AlertingChannelPagerDutyFieldServiceIntegrationKey, channel.ServiceIntegrationKey)
+	d.Set(AlertingChannelSlackFieldIconURL, channel.IconURL)
+	d.Set(AlertingChannelSlackFieldChannel, channel.Channel)
+	d.Set(AlertingChannelSplunkFieldURL, channel.URL)
+	d.Set(AlertingChannelSplunkFieldToken, channel.Token)
+	d.Set(AlertingChannelWebhookFieldWebhookURLs, channel.WebhookURLs)
+	d.Set(AlertingChannelWebhookFieldHTTPHeaders, createHTTPHeaderMapFromList(channel.Headers))
+	d.Set(AlertingChannelPrometheusWebhookFieldReceiver, channel.Receiver)
+}
+
+func convertSupportedAlertingChannelTypesToStringSlice() []string {
+	result := make([]string, len(restapi.SupportedAlertingChannels))
+	for i, t := range restapi.SupportedAlertingChannels {
+		result[i] = string(t)
+	}
+	return result
+}
@@ -0,0 +1,222 @@
+package instana_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertingChannelDataSourceShouldDefineSchema(t *testing.T) {
+	schemaMap := NewAlertingChannelDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelDataSourceFieldNameRegex)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelSlackFieldChannel)
+
+	assert.True(t, schemaMap[AlertingChannelFieldName].Optional)
+	assert.True(t, schemaMap[AlertingChannelFieldName].Computed)
+	assert.True(t, schemaMap[AlertingChannelDataSourceFieldKind].Optional)
+	assert.True(t, schemaMap[AlertingChannelDataSourceFieldKind].Computed)
+}
+
+func TestAlertingChannelDataSourceShouldMarkSecretsAsSensitive(t *testing.T) {
+	schemaMap := NewAlertingChannelDataSource().CreateResource().Schema
+
+	assert.True(t, schemaMap[AlertingChannelOpsGenieFieldAPIKey].Sensitive)
+	assert.True(t, schemaMap[AlertingChannelPagerDutyFieldServiceIntegrationKey].Sensitive)
+	assert.True(t, schemaMap[AlertingChannelSplunkFieldToken].Sensitive)
+	assert.True(t, schemaMap[AlertingChannelWebhookBasedFieldWebhookURL].Sensitive)
+	assert.True(t, schemaMap[AlertingChannelVictorOpsFieldRoutingKey].Sensitive)
+	assert.True(t, schemaMap[AlertingChannelWebhookFieldWebhookURLs].Sensitive)
+	assert.True(t, schemaMap[AlertingChannelWebhookFieldHTTPHeaders].Sensitive)
+	assert.False(t, schemaMap[AlertingChannelSlackFieldChannel].Sensitive)
+}
+
+func TestShouldReadAlertingChannelByExactName(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createAlertingChannelDataSourceResourceData(t, map[string]interface{}{AlertingChannelFieldName: "slack"})
+		mockRestResource := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(createTestAlertingChannelsForDataSource(), nil).Times(1)
+
+		err := NewAlertingChannelDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "id-slack", resourceData.Id())
+		assert.Equal(t, "slack", resourceData.Get(AlertingChannelFieldName))
+		assert.Equal(t, string(restapi.SlackChannelType), resourceData.Get(AlertingChannelDataSourceFieldKind))
+		assert.Equal(t, "webhook url", resourceData.Get(AlertingChannelWebhookBasedFieldWebhookURL))
+		assert.Equal(t, "channel", resourceData.Get(AlertingChannelSlackFieldChannel))
+	})
+}
+
+func TestShouldReadAlertingChannelByNameRegex(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createAlertingChannelDataSourceResourceData(t, map[string]interface{}{AlertingChannelDataSourceFieldNameRegex: "^e.*l$"})
+		mockRestResource := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(createTestAlertingChannelsForDataSource(), nil).Times(1)
+
+		err := NewAlertingChannelDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "id-email", resourceData.Id())
+		assert.Equal(t, "email", resourceData.Get(AlertingChannelFieldName))
+		assert.Equal(t, []interface{}{"email1", "email2"}, resourceData.Get(AlertingChannelEmailFieldEmails))
+	})
+}
+
+func TestShouldReadAlertingChannelByNameRegexAndKind(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		data := map[string]interface{}{
+			AlertingChannelDataSourceFieldNameRegex: ".*",
+			AlertingChannelDataSourceFieldKind:      string(restapi.WebhookChannelType),
+		}
+		resourceData := createAlertingChannelDataSourceResourceData(t, data)
+		mockRestResource := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(createTestAlertingChannelsForDataSource(), nil).Times(1)
+
+		err := NewAlertingChannelDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "id-webhook", resourceData.Id())
+		assert.Equal(t, []interface{}{"url1", "url2"}, resourceData.Get(AlertingChannelWebhookFieldWebhookURLs))
+		assert.Equal(t, map[string]interface{}{"key": "value"}, resourceData.Get(AlertingChannelWebhookFieldHTTPHeaders))
+	})
+}
+
+func TestShouldReadPrometheusWebhookAlertingChannelByKind(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		data := map[string]interface{}{
+			AlertingChannelDataSourceFieldNameRegex: ".*",
+			AlertingChannelDataSourceFieldKind:      string(restapi.PrometheusWebhookChannelType),
+		}
+		resourceData := createAlertingChannelDataSourceResourceData(t, data)
+		mockRestResource := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(createTestAlertingChannelsForDataSource(), nil).Times(1)
+
+		err := NewAlertingChannelDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "id-prometheus", resourceData.Id())
+		assert.Equal(t, "webhook url", resourceData.Get(AlertingChannelWebhookBasedFieldWebhookURL))
+		assert.Equal(t, "receiver", resourceData.Get(AlertingChannelPrometheusWebhookFieldReceiver))
+	})
+}
+
+func TestShouldFailToReadAlertingChannelWhenNoAlertingChannelMatches(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createAlertingChannelDataSourceResourceData(t, map[string]interface{}{AlertingChannelFieldName: "invalid"})
+		mockRestResource := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(createTestAlertingChannelsForDataSource(), nil).Times(1)
+
+		err := NewAlertingChannelDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "no alerting channel found")
+	})
+}
+
+func TestShouldFailToReadAlertingChannelWhenMultipleAlertingChannelsMatch(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createAlertingChannelDataSourceResourceData(t, map[string]interface{}{AlertingChannelDataSourceFieldNameRegex: ".*"})
+		mockRestResource := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(createTestAlertingChannelsForDataSource(), nil).Times(1)
+
+		err := NewAlertingChannelDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "4 alerting channels found")
+	})
+}
+
+func TestShouldFailToReadAlertingChannelWhenAPICallFails(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createAlertingChannelDataSourceResourceData(t, map[string]interface{}{AlertingChannelFieldName: "slack"})
+		expectedError := errors.New("test")
+		mockRestResource := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(nil, expectedError).Times(1)
+
+		err := NewAlertingChannelDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.Equal(t, expectedError, err)
+	})
+}
+
+func TestShouldFailToReadAlertingChannelWhenNeitherNameNorNameRegexIsProvided(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createAlertingChannelDataSourceResourceData(t, map[string]interface{}{})
+
+		err := NewAlertingChannelDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "either name or name_regex must be configured")
+	})
+}
+
+func createAlertingChannelDataSourceResourceData(t *testing.T, data map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, NewAlertingChannelDataSource().CreateResource().Schema, data)
+}
+
+func createTestAlertingChannelsForDataSource() []restapi.InstanaDataObject {
+	webhookURL := "webhook url"
+	channel := "channel"
+	receiver := "receiver"
+	return []restapi.InstanaDataObject{
+		restapi.AlertingChannel{
+			ID:     "id-email",
+			Name:   "email",
+			Kind:   restapi.EmailChannelType,
+			Emails: []string{"email1", "email2"},
+		},
+		restapi.AlertingChannel{
+			ID:         "id-slack",
+			Name:       "slack",
+			Kind:       restapi.SlackChannelType,
+			WebhookURL: &webhookURL,
+			Channel:    &channel,
+		},
+		restapi.AlertingChannel{
+			ID:          "id-webhook",
+			Name:        "webhook",
+			Kind:        restapi.WebhookChannelType,
+			WebhookURLs: []string{"url1", "url2"},
+			Headers:     []string{"key: value"},
+		},
+		restapi.AlertingChannel{
+			ID:         "id-prometheus",
+			Name:       "prometheus",
+			Kind:       restapi.PrometheusWebhookChannelType,
+			WebhookURL: &webhookURL,
+			Receiver:   &receiver,
+		},
+	}
+}
@@ -0,0 +1,110 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	//DataSourceBuiltinEventSpecification the name of the terraform-provider-instana data source to read builtin event specifications
+	DataSourceBuiltinEventSpecification = "instana_builtin_event_spec"
+
+	//BuiltinEventSpecificationFieldName constant value for the schema field name
+	BuiltinEventSpecificationFieldName = "name"
+	//BuiltinEventSpecificationFieldShortPluginID constant value for the schema field short_plugin_id
+	BuiltinEventSpecificationFieldShortPluginID = "short_plugin_id"
+	//BuiltinEventSpecificationFieldDescription constant value for the schema field description
+	BuiltinEventSpecificationFieldDescription = "description"
+	//BuiltinEventSpecificationFieldSeverity constant value for the schema field severity
+	BuiltinEventSpecificationFieldSeverity = "severity"
+	//BuiltinEventSpecificationFieldTriggering constant value for the schema field triggering
+	BuiltinEventSpecificationFieldTriggering = "triggering"
+	//BuiltinEventSpecificationFieldEnabled constant value for the schema field enabled
+	BuiltinEventSpecificationFieldEnabled = "enabled"
+)
+
+//NewBuiltinEventSpecificationDataSource creates a new DataSource for builtin event specifications
+func NewBuiltinEventSpecificationDataSource() DataSource {
+	return &builtinEventSpecificationDataSource{}
+}
+
+type builtinEventSpecificationDataSource struct{}
+
+//CreateResource creates the terraform resource of the data source for builtin event specifications
+func (ds *builtinEventSpecificationDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		Read: WithOperationContext(schema.TimeoutRead, ds.read),
+		Schema: map[string]*schema.Schema{
+			BuiltinEventSpecificationFieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the builtin event specification",
+			},
+			BuiltinEventSpecificationFieldShortPluginID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The plugin id for which the builtin event specification is created",
+			},
+			BuiltinEventSpecificationFieldDescription: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description text of the builtin event specification",
+			},
+			BuiltinEventSpecificationFieldSeverity: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The severity (warning or critical) of the builtin event specification. The field is empty when the severity is neither warning nor critical",
+			},
+			BuiltinEventSpecificationFieldTriggering: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if an incident is triggered by the builtin event specification",
+			},
+			BuiltinEventSpecificationFieldEnabled: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if the builtin event specification is enabled or not",
+			},
+		},
+	}
+}
+
+func (ds *builtinEventSpecificationDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	name := d.Get(BuiltinEventSpecificationFieldName).(string)
+	shortPluginID := d.Get(BuiltinEventSpecificationFieldShortPluginID).(string)
+
+	specs, err := instanaAPI.BuiltinEventSpecifications().GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range specs {
+		spec := obj.(restapi.BuiltinEventSpecification)
+		if spec.Name == name && spec.ShortPluginID == shortPluginID {
+			updateStateForBuiltinEventSpecification(d, spec)
+			return nil
+		}
+	}
+	return fmt.Errorf("no builtin event specification found for plugin %s and name %s", shortPluginID, name)
+}
+
+func updateStateForBuiltinEventSpecification(d *schema.ResourceData, spec restapi.BuiltinEventSpecification) {
+	d.SetId(spec.ID)
+	d.Set(BuiltinEventSpecificationFieldName, spec.Name)
+	d.Set(BuiltinEventSpecificationFieldShortPluginID, spec.ShortPluginID)
+	d.Set(BuiltinEventSpecificationFieldDescription, spec.Description)
+	d.Set(BuiltinEventSpecificationFieldTriggering, spec.Triggering)
+	d.Set(BuiltinEventSpecificationFieldEnabled, spec.Enabled)
+
+	severity, err := ConvertSeverityFromInstanaAPIToTerraformRepresentation(spec.Severity)
+	if err != nil {
+		severity = ""
+	}
+	d.Set(BuiltinEventSpecificationFieldSeverity, severity)
+}
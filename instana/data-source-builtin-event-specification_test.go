@@ -0,0 +1,129 @@
+package instana_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuiltinEventSpecificationDataSourceShouldDefineSchema(t *testing.T) {
+	schemaMap := NewBuiltinEventSpecificationDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(BuiltinEventSpecificationFieldName)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(BuiltinEventSpecificationFieldShortPluginID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(BuiltinEventSpecificationFieldDescription)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(BuiltinEventSpecificationFieldSeverity)
+	assert.True(t, schemaMap[BuiltinEventSpecificationFieldTriggering].Computed)
+	assert.True(t, schemaMap[BuiltinEventSpecificationFieldEnabled].Computed)
+}
+
+func TestShouldReadBuiltinEventSpecificationByPluginAndName(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createBuiltinEventSpecificationDataSourceResourceData(t, "host", "System load too high")
+		mockRestResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(createTestBuiltinEventSpecifications(), nil).Times(1)
+
+		err := NewBuiltinEventSpecificationDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "id-host-load", resourceData.Id())
+		assert.Equal(t, "host", resourceData.Get(BuiltinEventSpecificationFieldShortPluginID))
+		assert.Equal(t, "System load too high", resourceData.Get(BuiltinEventSpecificationFieldName))
+		assert.Equal(t, "description", resourceData.Get(BuiltinEventSpecificationFieldDescription))
+		assert.Equal(t, restapi.SeverityCritical.GetTerraformRepresentation(), resourceData.Get(BuiltinEventSpecificationFieldSeverity))
+		assert.True(t, resourceData.Get(BuiltinEventSpecificationFieldTriggering).(bool))
+		assert.True(t, resourceData.Get(BuiltinEventSpecificationFieldEnabled).(bool))
+	})
+}
+
+func TestShouldReadBuiltinEventSpecificationWithEmptySeverityWhenSeverityIsNotSupported(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createBuiltinEventSpecificationDataSourceResourceData(t, "jvmRuntimePlatform", "System load too high")
+		mockRestResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(createTestBuiltinEventSpecifications(), nil).Times(1)
+
+		err := NewBuiltinEventSpecificationDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "id-jvm-load", resourceData.Id())
+		assert.Equal(t, "", resourceData.Get(BuiltinEventSpecificationFieldSeverity))
+		assert.False(t, resourceData.Get(BuiltinEventSpecificationFieldEnabled).(bool))
+	})
+}
+
+func TestShouldFailToReadBuiltinEventSpecificationWhenNoSpecificationMatches(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createBuiltinEventSpecificationDataSourceResourceData(t, "host", "invalid")
+		mockRestResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(createTestBuiltinEventSpecifications(), nil).Times(1)
+
+		err := NewBuiltinEventSpecificationDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "no builtin event specification found")
+	})
+}
+
+func TestShouldFailToReadBuiltinEventSpecificationWhenAPICallFails(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createBuiltinEventSpecificationDataSourceResourceData(t, "host", "System load too high")
+		expectedError := errors.New("test")
+		mockRestResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(nil, expectedError).Times(1)
+
+		err := NewBuiltinEventSpecificationDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.Equal(t, expectedError, err)
+	})
+}
+
+func createBuiltinEventSpecificationDataSourceResourceData(t *testing.T, shortPluginID string, name string) *schema.ResourceData {
+	data := map[string]interface{}{
+		BuiltinEventSpecificationFieldShortPluginID: shortPluginID,
+		BuiltinEventSpecificationFieldName:          name,
+	}
+	return schema.TestResourceDataRaw(t, NewBuiltinEventSpecificationDataSource().CreateResource().Schema, data)
+}
+
+func createTestBuiltinEventSpecifications() []restapi.InstanaDataObject {
+	description := "description"
+	return []restapi.InstanaDataObject{
+		restapi.BuiltinEventSpecification{
+			ID:            "id-host-load",
+			ShortPluginID: "host",
+			Name:          "System load too high",
+			Description:   &description,
+			Severity:      restapi.SeverityCritical.GetAPIRepresentation(),
+			Triggering:    true,
+			Enabled:       true,
+		},
+		restapi.BuiltinEventSpecification{
+			ID:            "id-jvm-load",
+			ShortPluginID: "jvmRuntimePlatform",
+			Name:          "System load too high",
+			Severity:      -1,
+			Triggering:    false,
+			Enabled:       false,
+		},
+	}
+}
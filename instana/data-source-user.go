@@ -0,0 +1,90 @@
+package instana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	//DataSourceUser the name of the terraform-provider-instana data source to read users
+	DataSourceUser = "instana_user"
+
+	//UserDataSourceFieldEmail constant value for the schema field email of the user data source
+	UserDataSourceFieldEmail = "email"
+	//UserDataSourceFieldFullName constant value for the schema field full_name of the user data source
+	UserDataSourceFieldFullName = "full_name"
+	//UserDataSourceFieldRoleID constant value for the schema field role_id of the user data source
+	UserDataSourceFieldRoleID = "role_id"
+)
+
+//NewUserDataSource creates a new DataSource for users
+func NewUserDataSource() DataSource {
+	return &userDataSource{}
+}
+
+type userDataSource struct{}
+
+//CreateResource creates the terraform resource of the data source for users
+func (ds *userDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		Read: WithOperationContext(schema.TimeoutRead, ds.read),
+		Schema: map[string]*schema.Schema{
+			UserDataSourceFieldEmail: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The email address of the user. The email address is compared case insensitive",
+			},
+			UserDataSourceFieldFullName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full name of the user",
+			},
+			UserDataSourceFieldRoleID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the role assigned to the user",
+			},
+		},
+	}
+}
+
+func (ds *userDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	email := d.Get(UserDataSourceFieldEmail).(string)
+
+	users, err := instanaAPI.Users().GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	matchingUsers := make([]restapi.User, 0)
+	for _, obj := range users {
+		user := obj.(restapi.User)
+		if strings.EqualFold(user.Email, email) {
+			matchingUsers = append(matchingUsers, user)
+		}
+	}
+
+	if len(matchingUsers) == 0 {
+		return errors.New("no user found with the given email address")
+	}
+	if len(matchingUsers) > 1 {
+		return fmt.Errorf("%d users found with the given email address; email address must match exactly one user", len(matchingUsers))
+	}
+	ds.updateState(d, matchingUsers[0])
+	return nil
+}
+
+func (ds *userDataSource) updateState(d *schema.ResourceData, user restapi.User) {
+	d.SetId(user.ID)
+	d.Set(UserDataSourceFieldEmail, user.Email)
+	d.Set(UserDataSourceFieldFullName, user.FullName)
+	d.Set(UserDataSourceFieldRoleID, user.RoleID)
+}
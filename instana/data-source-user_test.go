@@ -0,0 +1,102 @@
+package instana_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserDataSourceShouldDefineSchema(t *testing.T) {
+	schemaMap := NewUserDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(UserDataSourceFieldEmail)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(UserDataSourceFieldFullName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(UserDataSourceFieldRoleID)
+}
+
+func TestShouldReadUserByEmailIgnoringCase(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createUserDataSourceResourceData(t, map[string]interface{}{UserDataSourceFieldEmail: "User2@Example.com"})
+		mockRestResource := mocks.NewMockRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().Users().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(createTestUsersForDataSource(), nil).Times(1)
+
+		err := NewUserDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "user-id-2", resourceData.Id())
+		assert.Equal(t, "user2@example.com", resourceData.Get(UserDataSourceFieldEmail))
+		assert.Equal(t, "User 2", resourceData.Get(UserDataSourceFieldFullName))
+		assert.Equal(t, "role-id-2", resourceData.Get(UserDataSourceFieldRoleID))
+	})
+}
+
+func TestShouldFailToReadUserWhenNoUserMatches(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createUserDataSourceResourceData(t, map[string]interface{}{UserDataSourceFieldEmail: "unknown@example.com"})
+		mockRestResource := mocks.NewMockRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().Users().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(createTestUsersForDataSource(), nil).Times(1)
+
+		err := NewUserDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "no user found")
+	})
+}
+
+func TestShouldFailToReadUserWhenMultipleUsersMatch(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createUserDataSourceResourceData(t, map[string]interface{}{UserDataSourceFieldEmail: "user1@example.com"})
+		mockRestResource := mocks.NewMockRestResource(ctrl)
+		users := append(createTestUsersForDataSource(), restapi.User{ID: "user-id-3", Email: "USER1@example.com"})
+
+		mockInstanaAPI.EXPECT().Users().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(users, nil).Times(1)
+
+		err := NewUserDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "2 users found")
+	})
+}
+
+func TestShouldFailToReadUserWhenAPICallFails(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createUserDataSourceResourceData(t, map[string]interface{}{UserDataSourceFieldEmail: "user1@example.com"})
+		expectedError := errors.New("test")
+		mockRestResource := mocks.NewMockRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().Users().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetAll(gomock.Any()).Return(nil, expectedError).Times(1)
+
+		err := NewUserDataSource().CreateResource().Read(resourceData, providerMeta)
+
+		assert.Equal(t, expectedError, err)
+	})
+}
+
+func createUserDataSourceResourceData(t *testing.T, data map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, NewUserDataSource().CreateResource().Schema, data)
+}
+
+func createTestUsersForDataSource() []restapi.InstanaDataObject {
+	return []restapi.InstanaDataObject{
+		restapi.User{ID: "user-id-1", Email: "user1@example.com", FullName: "User 1", RoleID: "role-id-1"},
+		restapi.User{ID: "user-id-2", Email: "user2@example.com", FullName: "User 2", RoleID: "role-id-2"},
+	}
+}
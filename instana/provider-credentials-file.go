@@ -0,0 +1,139 @@
+package instana
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+//DefaultCredentialsFile the path of the credentials file which is used when no explicit credentials file is configured
+const DefaultCredentialsFile = "~/.instana/credentials"
+
+//supportedProfileKeys the provider configuration options which can be defined in a profile of the credentials file
+var supportedProfileKeys = []string{SchemaFieldAPIToken, SchemaFieldEndpoint, SchemaFieldDefaultNamePrefix, SchemaFieldDefaultNameSuffix}
+
+//CredentialsProfile a named profile of the credentials file which maps provider configuration options to their values
+type CredentialsProfile map[string]string
+
+//ReadCredentialsProfile reads the profile with the given name from the credentials file at the given path. A leading ~
+//in the path is replaced by the home directory of the current user
+func ReadCredentialsProfile(path string, name string) (CredentialsProfile, error) {
+	expandedPath, err := expandHomeDirectory(path)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(expandedPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials file %s; %s", path, err)
+	}
+	defer file.Close()
+	warnIfCredentialsFileIsAccessibleByOthers(file, path)
+
+	profiles, err := parseCredentialsFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse credentials file %s; %s", path, err)
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %s not found in credentials file %s", name, path)
+	}
+	return profile, nil
+}
+
+//warnIfCredentialsFileIsAccessibleByOthers logs a warning when the credentials file can be read or written by the group or
+//other users as the file contains API tokens
+func warnIfCredentialsFileIsAccessibleByOthers(file *os.File, path string) {
+	info, err := file.Stat()
+	if err != nil {
+		log.Warnf("failed to verify permissions of credentials file %s; %s", path, err)
+		return
+	}
+	if permissions := info.Mode().Perm(); permissions&0077 != 0 {
+		log.Warnf("credentials file %s is accessible by other users (permissions %s); restrict the permissions to 0600", path, permissions)
+	}
+}
+
+func expandHomeDirectory(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	homeDirectory, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory of credentials file %s; %s", path, err)
+	}
+	return filepath.Join(homeDirectory, strings.TrimPrefix(path, "~")), nil
+}
+
+//parseCredentialsFile parses the INI style credentials file. Each profile starts with its name in square brackets
+//followed by key = value pairs. Empty lines and lines starting with # or ; are ignored. Values can be enclosed in
+//double quotes to preserve leading or trailing whitespaces
+func parseCredentialsFile(reader io.Reader) (map[string]CredentialsProfile, error) {
+	profiles := make(map[string]CredentialsProfile)
+	var currentProfile CredentialsProfile
+	scanner := bufio.NewScanner(reader)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := profiles[name]; ok || name == "" {
+				return nil, fmt.Errorf("line %d: invalid or duplicate profile name '%s'", lineNumber, name)
+			}
+			currentProfile = make(CredentialsProfile)
+			profiles[name] = currentProfile
+			continue
+		}
+		if currentProfile == nil {
+			return nil, fmt.Errorf("line %d: key value pair defined outside of a profile", lineNumber)
+		}
+		key, value, err := parseCredentialsFileEntry(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", lineNumber, err)
+		}
+		currentProfile[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func parseCredentialsFileEntry(line string) (string, string, error) {
+	parts := strings.SplitN(line, "=", 2)
+	if len(parts) != 2 {
+		return "", "", errors.New("expected key = value pair")
+	}
+	key := strings.TrimSpace(parts[0])
+	if !isSupportedProfileKey(key) {
+		return "", "", fmt.Errorf("unsupported key '%s'; supported keys are %s", key, strings.Join(supportedProfileKeys, ", "))
+	}
+	value := strings.TrimSpace(parts[1])
+	if strings.HasPrefix(value, "\"") {
+		unquotedValue, err := strconv.Unquote(value)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid quoted value of key '%s'", key)
+		}
+		value = unquotedValue
+	}
+	return key, value, nil
+}
+
+func isSupportedProfileKey(key string) bool {
+	for _, supportedKey := range supportedProfileKeys {
+		if key == supportedKey {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package instana_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+)
+
+const credentialsFileWithMultipleProfiles = `
+# credentials of the production tenant
+[prod]
+api_token = prod-api-token
+endpoint = prod.instana.io
+
+; credentials of the development tenant
+[ dev ]
+api_token=dev-api-token
+endpoint = dev.instana.io
+default_name_prefix = "  [dev]"
+default_name_suffix = (TF managed) = dev
+`
+
+func TestShouldReadRequestedProfileFromCredentialsFile(t *testing.T) {
+	credentialsFile := createCredentialsFile(t, credentialsFileWithMultipleProfiles)
+	defer os.Remove(credentialsFile)
+
+	prodProfile, err := ReadCredentialsProfile(credentialsFile, "prod")
+
+	assert.Nil(t, err)
+	assert.Equal(t, CredentialsProfile{SchemaFieldAPIToken: "prod-api-token", SchemaFieldEndpoint: "prod.instana.io"}, prodProfile)
+
+	devProfile, err := ReadCredentialsProfile(credentialsFile, "dev")
+
+	assert.Nil(t, err)
+	assert.Equal(t, CredentialsProfile{
+		SchemaFieldAPIToken:          "dev-api-token",
+		SchemaFieldEndpoint:          "dev.instana.io",
+		SchemaFieldDefaultNamePrefix: "  [dev]",
+		SchemaFieldDefaultNameSuffix: "(TF managed) = dev",
+	}, devProfile)
+}
+
+func TestShouldReadCredentialsFileFromHomeDirectory(t *testing.T) {
+	credentialsFile := createCredentialsFile(t, credentialsFileWithMultipleProfiles)
+	defer os.Remove(credentialsFile)
+	homeDirectory := os.Getenv("HOME")
+	defer os.Setenv("HOME", homeDirectory)
+	setEnv(t, "HOME", filepath.Dir(credentialsFile))
+
+	profile, err := ReadCredentialsProfile("~/"+filepath.Base(credentialsFile), "prod")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "prod-api-token", profile[SchemaFieldAPIToken])
+}
+
+func TestShouldWarnWhenCredentialsFileIsAccessibleByOtherUsers(t *testing.T) {
+	credentialsFile := createCredentialsFile(t, credentialsFileWithMultipleProfiles)
+	defer os.Remove(credentialsFile)
+	assert.Nil(t, os.Chmod(credentialsFile, 0644))
+	logHook := logtest.NewGlobal()
+	defer logHook.Reset()
+
+	profile, err := ReadCredentialsProfile(credentialsFile, "prod")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "prod-api-token", profile[SchemaFieldAPIToken])
+	assert.NotNil(t, logHook.LastEntry())
+	assert.Equal(t, logrus.WarnLevel, logHook.LastEntry().Level)
+	assert.Contains(t, logHook.LastEntry().Message, "is accessible by other users (permissions -rw-r--r--)")
+}
+
+func TestShouldNotWarnWhenCredentialsFileIsOnlyAccessibleByOwner(t *testing.T) {
+	credentialsFile := createCredentialsFile(t, credentialsFileWithMultipleProfiles)
+	defer os.Remove(credentialsFile)
+	assert.Nil(t, os.Chmod(credentialsFile, 0600))
+	logHook := logtest.NewGlobal()
+	defer logHook.Reset()
+
+	_, err := ReadCredentialsProfile(credentialsFile, "prod")
+
+	assert.Nil(t, err)
+	assert.Empty(t, logHook.AllEntries())
+}
+
+func TestShouldFailToReadCredentialsProfileWhenCredentialsFileIsInvalid(t *testing.T) {
+	testCases := map[string]string{
+		"key value pair defined outside of a profile": "api_token = token\n[prod]\n",
+		"invalid or duplicate profile name 'prod'":    "[prod]\n[prod]\n",
+		"invalid or duplicate profile name ''":        "[]\n",
+		"expected key = value pair":                   "[prod]\napi_token\n",
+		"unsupported key 'token'":                     "[prod]\ntoken = value\n",
+		"invalid quoted value of key 'api_token'":     "[prod]\napi_token = \"token\n",
+	}
+
+	for expectedError, content := range testCases {
+		t.Run(expectedError, func(t *testing.T) {
+			credentialsFile := createCredentialsFile(t, content)
+			defer os.Remove(credentialsFile)
+
+			_, err := ReadCredentialsProfile(credentialsFile, "prod")
+
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), expectedError)
+		})
+	}
+}
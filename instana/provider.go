@@ -1,9 +1,21 @@
 package instana
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/utils"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 //SchemaFieldAPIToken the name of the provider configuration option for the api token
@@ -12,25 +24,92 @@ const SchemaFieldAPIToken = "api_token"
 //SchemaFieldEndpoint the name of the provider configuration option for the instana endpoint
 const SchemaFieldEndpoint = "endpoint"
 
+//SchemaFieldProfile the name of the provider configuration option for the profile of the credentials file
+const SchemaFieldProfile = "profile"
+
+//SchemaFieldCredentialsFile the name of the provider configuration option for the path of the credentials file
+const SchemaFieldCredentialsFile = "credentials_file"
+
+//EnvVarAPIToken the name of the environment variable which provides the default of the api token
+const EnvVarAPIToken = "INSTANA_API_TOKEN"
+
+//EnvVarEndpoint the name of the environment variable which provides the default of the instana endpoint
+const EnvVarEndpoint = "INSTANA_ENDPOINT"
+
+//EnvVarProfile the name of the environment variable which provides the default of the profile of the credentials file
+const EnvVarProfile = "INSTANA_PROFILE"
+
+//SchemaFieldBaseURL the name of the provider configuration option for the base URL of the instana backend including scheme and optional port
+const SchemaFieldBaseURL = "base_url"
+
+//SchemaFieldCACertificate the name of the provider configuration option for the PEM encoded certificate authority or the path to the PEM file
+const SchemaFieldCACertificate = "ca_certificate"
+
+//SchemaFieldInsecureSkipVerify the name of the provider configuration option to skip the verification of the server certificate
+const SchemaFieldInsecureSkipVerify = "insecure_skip_verify"
+
+//SchemaFieldProxyURL the name of the provider configuration option for the URL of the proxy server
+const SchemaFieldProxyURL = "proxy_url"
+
 //SchemaFieldDefaultNamePrefix the default prefix which should be added to all resource names/labels
 const SchemaFieldDefaultNamePrefix = "default_name_prefix"
 
 //SchemaFieldDefaultNameSuffix the default prefix which should be added to all resource names/labels
 const SchemaFieldDefaultNameSuffix = "default_name_suffix"
 
-//ProviderMeta data structure for the meta data which is configured and provided to the resources by this provider
+//SchemaFieldMaxRetries the maximum number of retries of temporarily failing requests to the Instana API
+const SchemaFieldMaxRetries = "max_retries"
+
+//SchemaFieldRetryMaxWait the maximum number of seconds to wait before a failed request to the Instana API is retried
+const SchemaFieldRetryMaxWait = "retry_max_wait"
+
+//SchemaFieldThrottleRate the maximum number of throttled requests per second which are sent to the Instana API
+const SchemaFieldThrottleRate = "throttle_rate"
+
+//SchemaFieldThrottleBurst the maximum number of throttled requests which are sent at once to the Instana API
+const SchemaFieldThrottleBurst = "throttle_burst"
+
+//SchemaFieldThrottleTimeout the maximum number of seconds a throttled request to the Instana API may take
+const SchemaFieldThrottleTimeout = "throttle_timeout"
+
+//SchemaFieldThrottleReadRequests flag to throttle read requests in addition to write requests
+const SchemaFieldThrottleReadRequests = "throttle_read_requests"
+
+//SchemaFieldAdaptiveThrottling flag to adapt the throttle rate to the rate limit reported by the Instana API
+const SchemaFieldAdaptiveThrottling = "adaptive_throttling"
+
+//supportedBaseURLSchemes the URL schemes which are supported for the base URL of the Instana backend
+var supportedBaseURLSchemes = []string{"http", "https"}
+
+//supportedProxyURLSchemes the URL schemes which are supported for the proxy URL
+var supportedProxyURLSchemes = []string{"http", "https", "socks5"}
+
+//ProviderMeta data structure for the meta data which is configured and provided to the resources by this provider.
+//StopContext is optional and is cancelled when terraform is interrupted. When not set the background context is used
 type ProviderMeta struct {
 	InstanaAPI            restapi.InstanaAPI
 	ResourceNameFormatter utils.ResourceNameFormatter
+	StopContext           context.Context
+}
+
+func (m *ProviderMeta) stopContext() context.Context {
+	if m.StopContext == nil {
+		return context.Background()
+	}
+	return m.StopContext
 }
 
 //Provider interface implementation of hashicorp terraform provider
 func Provider() *schema.Provider {
-	return &schema.Provider{
-		Schema:        providerSchema(),
-		ResourcesMap:  providerResources(),
-		ConfigureFunc: providerConfigure,
+	provider := &schema.Provider{
+		Schema:         providerSchema(),
+		ResourcesMap:   providerResources(),
+		DataSourcesMap: providerDataSources(),
+	}
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return providerConfigure(d, provider.StopContext())
 	}
+	return provider
 }
 
 func providerSchema() map[string]*schema.Schema {
@@ -38,26 +117,108 @@ func providerSchema() map[string]*schema.Schema {
 		SchemaFieldAPIToken: {
 			Type:        schema.TypeString,
 			Sensitive:   true,
-			Required:    true,
-			Description: "API token used to authenticate with the Instana Backend",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvVarAPIToken, nil),
+			Description: "API token used to authenticate with the Instana Backend. Can also be provided by the environment variable " + EnvVarAPIToken + " or the selected profile",
 		},
 		SchemaFieldEndpoint: {
 			Type:        schema.TypeString,
-			Required:    true,
-			Description: "The DNS Name of the Instana Endpoint (eg. saas-eu-west-1.instana.io)",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvVarEndpoint, nil),
+			Description: "The DNS Name of the Instana Endpoint (eg. saas-eu-west-1.instana.io). Can also be provided by the environment variable " + EnvVarEndpoint + " or the selected profile. Either endpoint or base_url must be configured",
+		},
+		SchemaFieldBaseURL: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateAbsoluteURL(supportedBaseURLSchemes),
+			Description:  "The base URL of the Instana backend including scheme and optional port (eg. https://instana.example.com:8443). Takes precedence over endpoint. Either endpoint or base_url must be configured",
+		},
+		SchemaFieldProfile: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvVarProfile, nil),
+			Description: "The name of the profile of the credentials file which provides the defaults of api_token, endpoint, default_name_prefix and default_name_suffix. Can also be provided by the environment variable " + EnvVarProfile,
+		},
+		SchemaFieldCredentialsFile: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     DefaultCredentialsFile,
+			Description: "The path of the credentials file which contains the profiles - default '" + DefaultCredentialsFile + "'",
+		},
+		SchemaFieldCACertificate: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The PEM encoded certificate authority or the path to a PEM file which is used to verify the server certificate of the Instana backend",
+		},
+		SchemaFieldInsecureSkipVerify: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "When set to true the server certificate of the Instana backend is not verified - default false",
+		},
+		SchemaFieldProxyURL: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateAbsoluteURL(supportedProxyURLSchemes),
+			Description:  "The URL of the proxy server which is used to access the Instana backend. The proxy is determined from the environment variables HTTPS_PROXY, HTTP_PROXY and NO_PROXY when not set",
 		},
 		SchemaFieldDefaultNamePrefix: {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Default:     "",
 			Description: "The default prefix which should be added to all resource names/labels",
 		},
 		SchemaFieldDefaultNameSuffix: {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Default:     "(TF managed)",
 			Description: "The default suffix which should be added to all resource names/labels - default '(TF managed)'",
 		},
+		SchemaFieldMaxRetries: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      restapi.DefaultRetryPolicy.MaxRetries,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The maximum number of retries of requests to the Instana API which failed temporarily (HTTP status 429, 502, 503 or 504 or transport errors) - default 3",
+		},
+		SchemaFieldRetryMaxWait: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      int(restapi.DefaultRetryPolicy.MaxWait / time.Second),
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of seconds to wait before a failed request to the Instana API is retried - default 30",
+		},
+		SchemaFieldThrottleRate: {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      restapi.DefaultThrottlingConfig.Rate,
+			ValidateFunc: validation.FloatBetween(0.01, math.MaxFloat64),
+			Description:  "The maximum number of throttled requests per second which are sent to the Instana API - default 5",
+		},
+		SchemaFieldThrottleBurst: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      restapi.DefaultThrottlingConfig.Burst,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of throttled requests which are sent at once to the Instana API after an idle period - default 1",
+		},
+		SchemaFieldThrottleTimeout: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      int(restapi.DefaultThrottlingConfig.Timeout / time.Second),
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of seconds a throttled request to the Instana API may take including the time waiting for the throttle - default 30",
+		},
+		SchemaFieldThrottleReadRequests: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "When set to true read requests are throttled in addition to write requests - default false",
+		},
+		SchemaFieldAdaptiveThrottling: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "When set to true the throttle rate is reduced when the Instana API reports that the rate limit is reached and increased again afterwards - default false",
+		},
 	}
 }
 
@@ -68,6 +229,7 @@ func providerResources() map[string]*schema.Resource {
 	bindResourceHandle(resources, NewCustomEventSpecificationWithSystemRuleResourceHandle())
 	bindResourceHandle(resources, NewCustomEventSpecificationWithThresholdRuleResourceHandle())
 	bindResourceHandle(resources, NewCustomEventSpecificationWithEntityVerificationRuleResourceHandle())
+	bindResourceHandle(resources, NewAlertingChannelResourceHandle())
 	bindResourceHandle(resources, NewAlertingChannelEmailResourceHandle())
 	bindResourceHandle(resources, NewAlertingChannelGoogleChatResourceHandle())
 	bindResourceHandle(resources, NewAlertingChannelOffice356ResourceHandle())
@@ -77,23 +239,211 @@ func providerResources() map[string]*schema.Resource {
 	bindResourceHandle(resources, NewAlertingChannelSplunkResourceHandle())
 	bindResourceHandle(resources, NewAlertingChannelVictorOpsResourceHandle())
 	bindResourceHandle(resources, NewAlertingChannelWebhookResourceHandle())
+	bindResourceHandle(resources, NewAlertingChannelPrometheusWebhookResourceHandle())
+	bindResourceHandle(resources, NewAlertingChannelWebexTeamsWebhookResourceHandle())
 	bindResourceHandle(resources, NewAlertingConfigResourceHandle())
+	bindResourceHandle(resources, NewMaintenanceWindowResourceHandle())
+	bindResourceHandle(resources, NewWebsiteMonitoringConfigResourceHandle())
+	bindResourceHandle(resources, NewWebsiteAlertConfigResourceHandle())
+	bindResourceHandle(resources, NewServiceConfigResourceHandle())
+	bindResourceHandle(resources, NewHTTPEndpointConfigResourceHandle())
+	bindResourceHandle(resources, NewSyntheticCallConfigResourceHandle())
+	bindResourceHandle(resources, NewAPITokenResourceHandle())
+	bindResourceHandle(resources, NewGroupResourceHandle())
+	bindResourceHandle(resources, NewUserResourceHandle())
+	bindResourceHandle(resources, NewReleaseResourceHandle())
+	resources[ResourceInstanaBuiltinEventSpecificationConfig] = NewBuiltinEventSpecificationConfigResource().ToSchemaResource()
+	resources[ResourceInstanaServiceConfigOrder] = NewServiceConfigOrderResource().ToSchemaResource()
 	return resources
 }
 
+func providerDataSources() map[string]*schema.Resource {
+	dataSources := make(map[string]*schema.Resource)
+	dataSources[DataSourceAlertingChannel] = NewAlertingChannelDataSource().CreateResource()
+	dataSources[DataSourceBuiltinEventSpecification] = NewBuiltinEventSpecificationDataSource().CreateResource()
+	dataSources[DataSourceUser] = NewUserDataSource().CreateResource()
+	return dataSources
+}
+
 func bindResourceHandle(resources map[string]*schema.Resource, resourceHandle *ResourceHandle) {
 	resources[resourceHandle.ResourceName] = NewTerraformResource(resourceHandle).ToSchemaResource()
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	apiToken := d.Get(SchemaFieldAPIToken).(string)
-	endpoint := d.Get(SchemaFieldEndpoint).(string)
-	defaultNamePrefix := d.Get(SchemaFieldDefaultNamePrefix).(string)
-	defaultNameSuffix := d.Get(SchemaFieldDefaultNameSuffix).(string)
-	instanaAPI := restapi.NewInstanaAPI(apiToken, endpoint)
-	formatter := utils.NewResourceNameFormatter(defaultNamePrefix, defaultNameSuffix)
+func providerConfigure(d *schema.ResourceData, stopContext context.Context) (interface{}, error) {
+	settings, err := newProviderSettings(d)
+	if err != nil {
+		return nil, err
+	}
+	apiToken := settings.get(SchemaFieldAPIToken)
+	if apiToken == "" {
+		return nil, fmt.Errorf("%s must be configured either in the provider configuration, the environment variable %s or the selected profile", SchemaFieldAPIToken, EnvVarAPIToken)
+	}
+	baseURL, err := getBaseURL(d.Get(SchemaFieldBaseURL).(string), settings.get(SchemaFieldEndpoint))
+	if err != nil {
+		return nil, err
+	}
+	clientConfig, err := createClientConfig(d)
+	if err != nil {
+		return nil, err
+	}
+	instanaAPI := restapi.NewInstanaAPI(apiToken, baseURL, clientConfig)
+	formatter := utils.NewResourceNameFormatter(settings.get(SchemaFieldDefaultNamePrefix), settings.get(SchemaFieldDefaultNameSuffix))
 	return &ProviderMeta{
 		InstanaAPI:            instanaAPI,
 		ResourceNameFormatter: formatter,
+		StopContext:           stopContext,
 	}, nil
 }
+
+//providerSettings resolves the provider configuration options which can be defined in a profile of the credentials file
+type providerSettings struct {
+	data    *schema.ResourceData
+	profile CredentialsProfile
+}
+
+func newProviderSettings(d *schema.ResourceData) (*providerSettings, error) {
+	settings := &providerSettings{data: d, profile: CredentialsProfile{}}
+	if profileName, ok := d.GetOk(SchemaFieldProfile); ok {
+		profile, err := ReadCredentialsProfile(d.Get(SchemaFieldCredentialsFile).(string), profileName.(string))
+		if err != nil {
+			return nil, err
+		}
+		settings.profile = profile
+	}
+	return settings, nil
+}
+
+//providerSettingDefaults the built-in default values of the provider settings which can be defined in a profile. The
+//defaults are applied when the setting is neither configured in the provider configuration or the environment variables
+//nor defined in the selected profile
+var providerSettingDefaults = map[string]string{
+	SchemaFieldDefaultNameSuffix: "(TF managed)",
+}
+
+//get returns the value of the given provider configuration option. Values which are configured in the provider
+//configuration or via environment variables take precedence over the values of the profile. The built-in default is
+//used when the option is neither configured nor defined in the profile. Empty values of the provider configuration are
+//treated as not configured
+func (s *providerSettings) get(key string) string {
+	if value, ok := s.data.GetOk(key); ok {
+		return value.(string)
+	}
+	if profileValue, ok := s.profile[key]; ok {
+		return profileValue
+	}
+	return providerSettingDefaults[key]
+}
+
+func getBaseURL(baseURL string, endpoint string) (string, error) {
+	if baseURL == "" {
+		if endpoint == "" {
+			return "", fmt.Errorf("either %s or %s must be configured", SchemaFieldEndpoint, SchemaFieldBaseURL)
+		}
+		return fmt.Sprintf("https://%s", endpoint), nil
+	}
+	if _, err := parseAbsoluteURL(SchemaFieldBaseURL, baseURL, supportedBaseURLSchemes); err != nil {
+		return "", err
+	}
+	return baseURL, nil
+}
+
+//parseAbsoluteURL parses the URL of the given provider setting and verifies that it is an absolute URL with one of the
+//given schemes
+func parseAbsoluteURL(key string, value string, schemes []string) (*url.URL, error) {
+	parsedURL, err := url.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a valid URL; %s", key, err)
+	}
+	if !isSupportedURLScheme(parsedURL.Scheme, schemes) || parsedURL.Host == "" {
+		return nil, fmt.Errorf("%s must be an absolute URL with one of the schemes %s", key, strings.Join(schemes, ", "))
+	}
+	return parsedURL, nil
+}
+
+func isSupportedURLScheme(scheme string, schemes []string) bool {
+	for _, supportedScheme := range schemes {
+		if scheme == supportedScheme {
+			return true
+		}
+	}
+	return false
+}
+
+//validateAbsoluteURL creates a ValidateFunc which verifies at plan time that the value is an absolute URL with one of the given schemes
+func validateAbsoluteURL(schemes []string) schema.SchemaValidateFunc {
+	return func(value interface{}, key string) ([]string, []error) {
+		if _, err := parseAbsoluteURL(key, value.(string), schemes); err != nil {
+			return nil, []error{err}
+		}
+		return nil, nil
+	}
+}
+
+func createClientConfig(d *schema.ResourceData) (restapi.ClientConfig, error) {
+	config := restapi.ClientConfig{
+		RetryPolicy: restapi.RetryPolicy{
+			MaxRetries: d.Get(SchemaFieldMaxRetries).(int),
+			MaxWait:    time.Duration(d.Get(SchemaFieldRetryMaxWait).(int)) * time.Second,
+		},
+		Throttling: restapi.ThrottlingConfig{
+			Rate:                 d.Get(SchemaFieldThrottleRate).(float64),
+			Burst:                d.Get(SchemaFieldThrottleBurst).(int),
+			Timeout:              time.Duration(d.Get(SchemaFieldThrottleTimeout).(int)) * time.Second,
+			Adaptive:             d.Get(SchemaFieldAdaptiveThrottling).(bool),
+			ThrottleReadRequests: d.Get(SchemaFieldThrottleReadRequests).(bool),
+		},
+	}
+
+	tlsConfig, err := createTLSConfig(d)
+	if err != nil {
+		return config, err
+	}
+	config.TLSConfig = tlsConfig
+
+	if proxyURL, ok := d.GetOk(SchemaFieldProxyURL); ok {
+		parsedURL, err := parseAbsoluteURL(SchemaFieldProxyURL, proxyURL.(string), supportedProxyURLSchemes)
+		if err != nil {
+			return config, err
+		}
+		config.ProxyURL = parsedURL
+	}
+	return config, nil
+}
+
+//createTLSConfig creates the TLS configuration for the provider settings. No TLS configuration is returned when the default TLS configuration should be used
+func createTLSConfig(d *schema.ResourceData) (*tls.Config, error) {
+	caCertificate, hasCACertificate := d.GetOk(SchemaFieldCACertificate)
+	insecureSkipVerify := d.Get(SchemaFieldInsecureSkipVerify).(bool)
+	if !hasCACertificate && !insecureSkipVerify {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: insecureSkipVerify}
+	if hasCACertificate {
+		pem, err := readPEM(caCertificate.(string))
+		if err != nil {
+			return nil, err
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no valid PEM encoded certificate found in " + SchemaFieldCACertificate)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+	return tlsConfig, nil
+}
+
+//readPEM returns the given value when it contains PEM encoded data. Otherwise the value is considered as a path and the content of the file is returned
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	data, err := ioutil.ReadFile(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from file %s; %s", SchemaFieldCACertificate, value, err)
+	}
+	return data, nil
+}
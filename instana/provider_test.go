@@ -1,6 +1,9 @@
 package instana_test
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
@@ -25,24 +28,59 @@ func TestValidConfigurationOfProvider(t *testing.T) {
 	assert.NotNil(t, config.ResourcesMap)
 	validateResourcesMap(config.ResourcesMap, t)
 
+	assert.NotNil(t, config.DataSourcesMap)
+	validateDataSourcesMap(config.DataSourcesMap, t)
+
 	assert.NotNil(t, config.ConfigureFunc)
 }
 
 func validateSchema(schemaMap map[string]*schema.Schema, t *testing.T) {
-	assert.Equal(t, 4, len(schemaMap))
+	assert.Equal(t, 17, len(schemaMap))
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
-	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SchemaFieldAPIToken)
-	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SchemaFieldEndpoint)
-	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(SchemaFieldDefaultNamePrefix, "")
-	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(SchemaFieldDefaultNameSuffix, "(TF managed)")
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldAPIToken)
+	assert.NotNil(t, schemaMap[SchemaFieldAPIToken].DefaultFunc)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldEndpoint)
+	assert.NotNil(t, schemaMap[SchemaFieldEndpoint].DefaultFunc)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldBaseURL)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldProfile)
+	assert.NotNil(t, schemaMap[SchemaFieldProfile].DefaultFunc)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(SchemaFieldCredentialsFile, DefaultCredentialsFile)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldCACertificate)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SchemaFieldInsecureSkipVerify, false)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldProxyURL)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldDefaultNamePrefix)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldDefaultNameSuffix)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SchemaFieldMaxRetries)
+	assert.Equal(t, 3, schemaMap[SchemaFieldMaxRetries].Default)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SchemaFieldRetryMaxWait)
+	assert.Equal(t, 30, schemaMap[SchemaFieldRetryMaxWait].Default)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeFloat(SchemaFieldThrottleRate)
+	assert.Equal(t, float64(5), schemaMap[SchemaFieldThrottleRate].Default)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SchemaFieldThrottleBurst)
+	assert.Equal(t, 1, schemaMap[SchemaFieldThrottleBurst].Default)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SchemaFieldThrottleTimeout)
+	assert.Equal(t, 30, schemaMap[SchemaFieldThrottleTimeout].Default)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SchemaFieldThrottleReadRequests, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SchemaFieldAdaptiveThrottling, false)
 }
 
 func validateResourcesMap(resourceMap map[string]*schema.Resource, t *testing.T) {
-	assert.Equal(t, 15, len(resourceMap))
+	assert.Equal(t, 30, len(resourceMap))
 
 	assert.NotNil(t, resourceMap[ResourceInstanaUserRole])
 	assert.NotNil(t, resourceMap[ResourceInstanaApplicationConfig])
+	assert.NotNil(t, resourceMap[ResourceInstanaMaintenanceWindow])
+	assert.NotNil(t, resourceMap[ResourceInstanaWebsiteMonitoringConfig])
+	assert.NotNil(t, resourceMap[ResourceInstanaWebsiteAlertConfig])
+	assert.NotNil(t, resourceMap[ResourceInstanaServiceConfig])
+	assert.NotNil(t, resourceMap[ResourceInstanaServiceConfigOrder])
+	assert.NotNil(t, resourceMap[ResourceInstanaHTTPEndpointConfig])
+	assert.NotNil(t, resourceMap[ResourceInstanaSyntheticCallConfig])
+	assert.NotNil(t, resourceMap[ResourceInstanaAPIToken])
+	assert.NotNil(t, resourceMap[ResourceInstanaGroup])
+	assert.NotNil(t, resourceMap[ResourceInstanaUser])
+	assert.NotNil(t, resourceMap[ResourceInstanaRelease])
 
 	validateResourcesMapForCustomEvents(resourceMap, t)
 	validateResourcesMapForAlerting(resourceMap, t)
@@ -52,9 +90,11 @@ func validateResourcesMapForCustomEvents(resourceMap map[string]*schema.Resource
 	assert.NotNil(t, resourceMap[ResourceInstanaCustomEventSpecificationSystemRule])
 	assert.NotNil(t, resourceMap[ResourceInstanaCustomEventSpecificationThresholdRule])
 	assert.NotNil(t, resourceMap[ResourceInstanaCustomEventSpecificationEntityVerificationRule])
+	assert.NotNil(t, resourceMap[ResourceInstanaBuiltinEventSpecificationConfig])
 }
 
 func validateResourcesMapForAlerting(resourceMap map[string]*schema.Resource, t *testing.T) {
+	assert.NotNil(t, resourceMap[ResourceInstanaAlertingChannel])
 	assert.NotNil(t, resourceMap[ResourceInstanaAlertingChannelEmail])
 	assert.NotNil(t, resourceMap[ResourceInstanaAlertingChannelGoogleChat])
 	assert.NotNil(t, resourceMap[ResourceInstanaAlertingChannelSlack])
@@ -64,9 +104,19 @@ func validateResourcesMapForAlerting(resourceMap map[string]*schema.Resource, t
 	assert.NotNil(t, resourceMap[ResourceInstanaAlertingChannelSplunk])
 	assert.NotNil(t, resourceMap[ResourceInstanaAlertingChannelVictorOps])
 	assert.NotNil(t, resourceMap[ResourceInstanaAlertingChannelWebhook])
+	assert.NotNil(t, resourceMap[ResourceInstanaAlertingChannelPrometheusWebhook])
+	assert.NotNil(t, resourceMap[ResourceInstanaAlertingChannelWebexTeamsWebhook])
 	assert.NotNil(t, resourceMap[ResourceInstanaAlertingConfig])
 }
 
+func validateDataSourcesMap(dataSourceMap map[string]*schema.Resource, t *testing.T) {
+	assert.Equal(t, 3, len(dataSourceMap))
+
+	assert.NotNil(t, dataSourceMap[DataSourceAlertingChannel])
+	assert.NotNil(t, dataSourceMap[DataSourceBuiltinEventSpecification])
+	assert.NotNil(t, dataSourceMap[DataSourceUser])
+}
+
 func validateConfigureFunc(schemaMap map[string]*schema.Schema, configureFunc func(*schema.ResourceData) (interface{}, error), t *testing.T) {
 	data := make(map[string]interface{})
 	data[SchemaFieldAPIToken] = "api-token"
@@ -79,3 +129,269 @@ func validateConfigureFunc(schemaMap map[string]*schema.Schema, configureFunc fu
 	_, ok := result.(restapi.InstanaAPI)
 	assert.True(t, ok)
 }
+
+func TestShouldConfigureProviderWithEndpoint(t *testing.T) {
+	result, err := configureProvider(t, map[string]interface{}{SchemaFieldEndpoint: "instana.io"})
+
+	assert.Nil(t, err)
+	assert.NotNil(t, result.(*ProviderMeta).InstanaAPI)
+}
+
+func TestShouldConfigureProviderWithBaseURL(t *testing.T) {
+	result, err := configureProvider(t, map[string]interface{}{SchemaFieldBaseURL: "http://localhost:8080"})
+
+	assert.Nil(t, err)
+	assert.NotNil(t, result.(*ProviderMeta).InstanaAPI)
+}
+
+func TestShouldFailToConfigureProviderWhenNeitherEndpointNorBaseURLIsProvided(t *testing.T) {
+	_, err := configureProvider(t, map[string]interface{}{})
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), SchemaFieldEndpoint)
+	assert.Contains(t, err.Error(), SchemaFieldBaseURL)
+}
+
+func TestShouldFailToConfigureProviderWhenBaseURLIsNotAnAbsoluteHttpURL(t *testing.T) {
+	for _, baseURL := range []string{"instana.io", "ftp://instana.io", "https://", "://instana.io"} {
+		t.Run(baseURL, func(t *testing.T) {
+			_, err := configureProvider(t, map[string]interface{}{SchemaFieldBaseURL: baseURL})
+
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), SchemaFieldBaseURL)
+		})
+	}
+}
+
+func TestShouldConfigureProviderWithCACertificateFromFile(t *testing.T) {
+	rootFolder, err := testutils.GetRootFolder()
+	assert.Nil(t, err)
+
+	_, err = configureProvider(t, map[string]interface{}{
+		SchemaFieldEndpoint:      "instana.io",
+		SchemaFieldCACertificate: filepath.Join(rootFolder, "testutils", "test-server.pem"),
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestShouldConfigureProviderWithPEMEncodedCACertificate(t *testing.T) {
+	rootFolder, err := testutils.GetRootFolder()
+	assert.Nil(t, err)
+	pem, err := ioutil.ReadFile(filepath.Join(rootFolder, "testutils", "test-server.pem"))
+	assert.Nil(t, err)
+
+	_, err = configureProvider(t, map[string]interface{}{
+		SchemaFieldEndpoint:      "instana.io",
+		SchemaFieldCACertificate: string(pem),
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestShouldFailToConfigureProviderWhenCACertificateFileDoesNotExist(t *testing.T) {
+	_, err := configureProvider(t, map[string]interface{}{
+		SchemaFieldEndpoint:      "instana.io",
+		SchemaFieldCACertificate: "/does/not/exist.pem",
+	})
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), SchemaFieldCACertificate)
+}
+
+func TestShouldFailToConfigureProviderWhenCACertificateIsNotAValidPEM(t *testing.T) {
+	_, err := configureProvider(t, map[string]interface{}{
+		SchemaFieldEndpoint:      "instana.io",
+		SchemaFieldCACertificate: "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----",
+	})
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), SchemaFieldCACertificate)
+}
+
+func TestShouldConfigureProviderWithInsecureSkipVerifyAndProxyURL(t *testing.T) {
+	_, err := configureProvider(t, map[string]interface{}{
+		SchemaFieldEndpoint:           "instana.io",
+		SchemaFieldInsecureSkipVerify: true,
+		SchemaFieldProxyURL:           "http://proxy.example.com:3128",
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestShouldFailToConfigureProviderWhenProxyURLIsNotAnAbsoluteURL(t *testing.T) {
+	for _, proxyURL := range []string{"://proxy.example.com", "proxy:8080", "proxy.example.com", "ftp://proxy.example.com", "http://"} {
+		t.Run(proxyURL, func(t *testing.T) {
+			_, err := configureProvider(t, map[string]interface{}{
+				SchemaFieldEndpoint: "instana.io",
+				SchemaFieldProxyURL: proxyURL,
+			})
+
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), SchemaFieldProxyURL)
+		})
+	}
+}
+
+func TestShouldValidateBaseURLAndProxyURLAtPlanTime(t *testing.T) {
+	schemaMap := Provider().Schema
+	testCases := map[string]map[string]bool{
+		SchemaFieldBaseURL:  {"https://instana.example.com:8443": true, "http://localhost:8080": true, "instana.io": false, "ftp://instana.io": false, "https://": false},
+		SchemaFieldProxyURL: {"http://proxy.example.com:3128": true, "socks5://proxy.example.com:1080": true, "proxy:8080": false, "proxy.example.com": false},
+	}
+	for key, values := range testCases {
+		for value, valid := range values {
+			t.Run(key+" "+value, func(t *testing.T) {
+				_, errs := schemaMap[key].ValidateFunc(value, key)
+
+				if valid {
+					assert.Len(t, errs, 0)
+				} else {
+					assert.Len(t, errs, 1)
+					assert.Contains(t, errs[0].Error(), key)
+				}
+			})
+		}
+	}
+}
+
+func TestShouldConfigureProviderFromEnvironmentVariables(t *testing.T) {
+	setEnv(t, EnvVarAPIToken, "env-api-token")
+	setEnv(t, EnvVarEndpoint, "env.instana.io")
+	defer os.Unsetenv(EnvVarAPIToken)
+	defer os.Unsetenv(EnvVarEndpoint)
+
+	result, err := configureProviderWithData(t, map[string]interface{}{})
+
+	assert.Nil(t, err)
+	assert.NotNil(t, result.(*ProviderMeta).InstanaAPI)
+}
+
+func TestShouldFailToConfigureProviderWhenApiTokenIsNotProvided(t *testing.T) {
+	_, err := configureProviderWithData(t, map[string]interface{}{SchemaFieldEndpoint: "instana.io"})
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), SchemaFieldAPIToken)
+	assert.Contains(t, err.Error(), EnvVarAPIToken)
+}
+
+func TestShouldConfigureProviderFromProfileOfCredentialsFile(t *testing.T) {
+	credentialsFile := createCredentialsFile(t, "[prod]\napi_token = profile-api-token\nendpoint = prod.instana.io\ndefault_name_prefix = [prod]\ndefault_name_suffix = (managed)\n")
+	defer os.Remove(credentialsFile)
+
+	result, err := configureProviderWithData(t, map[string]interface{}{
+		SchemaFieldProfile:         "prod",
+		SchemaFieldCredentialsFile: credentialsFile,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "[prod] name (managed)", result.(*ProviderMeta).ResourceNameFormatter.Format("name"))
+}
+
+func TestShouldPreferProviderConfigurationOverProfileOfCredentialsFile(t *testing.T) {
+	credentialsFile := createCredentialsFile(t, "[prod]\ndefault_name_prefix = profile-prefix\ndefault_name_suffix = profile-suffix\n")
+	defer os.Remove(credentialsFile)
+
+	result, err := configureProvider(t, map[string]interface{}{
+		SchemaFieldEndpoint:          "instana.io",
+		SchemaFieldProfile:           "prod",
+		SchemaFieldCredentialsFile:   credentialsFile,
+		SchemaFieldDefaultNamePrefix: "config-prefix",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "config-prefix name profile-suffix", result.(*ProviderMeta).ResourceNameFormatter.Format("name"))
+}
+
+func TestShouldPreferExplicitlyConfiguredDefaultValueOverProfileOfCredentialsFile(t *testing.T) {
+	credentialsFile := createCredentialsFile(t, "[prod]\ndefault_name_prefix = profile-prefix\ndefault_name_suffix = profile-suffix\n")
+	defer os.Remove(credentialsFile)
+
+	result, err := configureProvider(t, map[string]interface{}{
+		SchemaFieldEndpoint:          "instana.io",
+		SchemaFieldProfile:           "prod",
+		SchemaFieldCredentialsFile:   credentialsFile,
+		SchemaFieldDefaultNameSuffix: "(TF managed)",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "profile-prefix name (TF managed)", result.(*ProviderMeta).ResourceNameFormatter.Format("name"))
+}
+
+func TestShouldUseEmptyValueOfProfileOfCredentialsFileInsteadOfBuiltInDefault(t *testing.T) {
+	credentialsFile := createCredentialsFile(t, "[prod]\ndefault_name_suffix = \"\"\n")
+	defer os.Remove(credentialsFile)
+
+	result, err := configureProvider(t, map[string]interface{}{
+		SchemaFieldEndpoint:        "instana.io",
+		SchemaFieldProfile:         "prod",
+		SchemaFieldCredentialsFile: credentialsFile,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, " name ", result.(*ProviderMeta).ResourceNameFormatter.Format("name"))
+}
+
+func TestShouldUseBuiltInDefaultValuesWhenNeitherConfiguredNorDefinedInProfile(t *testing.T) {
+	credentialsFile := createCredentialsFile(t, "[prod]\napi_token = profile-api-token\n")
+	defer os.Remove(credentialsFile)
+
+	result, err := configureProvider(t, map[string]interface{}{
+		SchemaFieldEndpoint:        "instana.io",
+		SchemaFieldProfile:         "prod",
+		SchemaFieldCredentialsFile: credentialsFile,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, " name (TF managed)", result.(*ProviderMeta).ResourceNameFormatter.Format("name"))
+}
+
+func TestShouldFailToConfigureProviderWhenProfileDoesNotExistInCredentialsFile(t *testing.T) {
+	credentialsFile := createCredentialsFile(t, "[prod]\napi_token = profile-api-token\n")
+	defer os.Remove(credentialsFile)
+
+	_, err := configureProvider(t, map[string]interface{}{
+		SchemaFieldEndpoint:        "instana.io",
+		SchemaFieldProfile:         "dev",
+		SchemaFieldCredentialsFile: credentialsFile,
+	})
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "profile dev not found")
+}
+
+func TestShouldFailToConfigureProviderWhenCredentialsFileDoesNotExist(t *testing.T) {
+	_, err := configureProvider(t, map[string]interface{}{
+		SchemaFieldEndpoint:        "instana.io",
+		SchemaFieldProfile:         "prod",
+		SchemaFieldCredentialsFile: "/does/not/exist",
+	})
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "failed to read credentials file /does/not/exist")
+}
+
+func configureProvider(t *testing.T, data map[string]interface{}) (interface{}, error) {
+	data[SchemaFieldAPIToken] = "api-token"
+	return configureProviderWithData(t, data)
+}
+
+func configureProviderWithData(t *testing.T, data map[string]interface{}) (interface{}, error) {
+	provider := Provider()
+	return provider.ConfigureFunc(schema.TestResourceDataRaw(t, provider.Schema, data))
+}
+
+func setEnv(t *testing.T, key string, value string) {
+	err := os.Setenv(key, value)
+	assert.Nil(t, err)
+}
+
+func createCredentialsFile(t *testing.T, content string) string {
+	file, err := ioutil.TempFile("", "instana-credentials")
+	assert.Nil(t, err)
+	defer file.Close()
+
+	_, err = file.WriteString(content)
+	assert.Nil(t, err)
+	return file.Name()
+}
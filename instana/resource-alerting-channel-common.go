@@ -1,6 +1,10 @@
 package instana
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/utils"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -10,6 +14,8 @@ const (
 	AlertingChannelFieldName = "name"
 	//AlertingChannelFieldFullName constant value for the schema field full_name
 	AlertingChannelFieldFullName = "full_name"
+	//AlertingChannelFieldVerifyOnApply constant value for the schema field verify_on_apply
+	AlertingChannelFieldVerifyOnApply = "verify_on_apply"
 )
 
 var alertingChannelNameSchemaField = &schema.Schema{
@@ -24,9 +30,26 @@ var alertingChannelFullNameSchemaField = &schema.Schema{
 	Description: "The the full name field of the alerting channel. The field is computed and contains the name which is sent to instana. The computation depends on the configured default_name_prefix and default_name_suffix at provider level",
 }
 
+var alertingChannelVerifyOnApplySchemaField = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     false,
+	Description: "Configures if a test notification is sent through the alerting channel before it is created or updated. When the test notification cannot be delivered the apply fails",
+}
+
 func computeFullAlertingChannelNameString(d *schema.ResourceData, formatter utils.ResourceNameFormatter) string {
 	if d.HasChange(AlertingChannelFieldName) {
 		return formatter.Format(d.Get(AlertingChannelFieldName).(string))
 	}
 	return d.Get(AlertingChannelFieldFullName).(string)
 }
+
+func verifyAlertingChannelOnApply(ctx context.Context, d *schema.ResourceData, obj restapi.InstanaDataObject, api restapi.InstanaAPI) error {
+	if !d.Get(AlertingChannelFieldVerifyOnApply).(bool) {
+		return nil
+	}
+	if err := api.AlertingChannels().Test(ctx, obj.(restapi.AlertingChannel)); err != nil {
+		return fmt.Errorf("failed to send test notification through alerting channel %s; %s", obj.(restapi.AlertingChannel).Name, err)
+	}
+	return nil
+}
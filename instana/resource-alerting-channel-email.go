@@ -29,9 +29,10 @@ func NewAlertingChannelEmailResourceHandle() *ResourceHandle {
 	return &ResourceHandle{
 		ResourceName: ResourceInstanaAlertingChannelEmail,
 		Schema: map[string]*schema.Schema{
-			AlertingChannelFieldName:        alertingChannelNameSchemaField,
-			AlertingChannelFieldFullName:    alertingChannelFullNameSchemaField,
-			AlertingChannelEmailFieldEmails: AlertingChannelEmailEmailsSchemaField,
+			AlertingChannelFieldName:          alertingChannelNameSchemaField,
+			AlertingChannelFieldFullName:      alertingChannelFullNameSchemaField,
+			AlertingChannelFieldVerifyOnApply: alertingChannelVerifyOnApplySchemaField,
+			AlertingChannelEmailFieldEmails:   AlertingChannelEmailEmailsSchemaField,
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -43,7 +44,10 @@ func NewAlertingChannelEmailResourceHandle() *ResourceHandle {
 				Version: 0,
 			},
 		},
+		NameField:            AlertingChannelFieldName,
+		FullNameField:        AlertingChannelFieldFullName,
 		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.AlertingChannels() },
+		BeforeUpsert:         verifyAlertingChannelOnApply,
 		UpdateState:          updateStateForAlertingChannelEmail,
 		MapStateToDataObject: mapStateToDataObjectForAlertingChannelEmail,
 	}
@@ -107,6 +107,7 @@ func TestResourceAlertingChannelEmailDefinition(t *testing.T) {
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelFieldName)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelFieldFullName)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(AlertingChannelFieldVerifyOnApply, false)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeSetOfStrings(AlertingChannelEmailFieldEmails)
 }
 
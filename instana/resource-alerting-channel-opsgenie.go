@@ -28,8 +28,9 @@ func NewAlertingChannelOpsGenieResourceHandle() *ResourceHandle {
 	return &ResourceHandle{
 		ResourceName: ResourceInstanaAlertingChannelOpsGenie,
 		Schema: map[string]*schema.Schema{
-			AlertingChannelFieldName:     alertingChannelNameSchemaField,
-			AlertingChannelFieldFullName: alertingChannelFullNameSchemaField,
+			AlertingChannelFieldName:          alertingChannelNameSchemaField,
+			AlertingChannelFieldFullName:      alertingChannelFullNameSchemaField,
+			AlertingChannelFieldVerifyOnApply: alertingChannelVerifyOnApplySchemaField,
 			AlertingChannelOpsGenieFieldAPIKey: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -51,7 +52,10 @@ func NewAlertingChannelOpsGenieResourceHandle() *ResourceHandle {
 				Description:  fmt.Sprintf("The OpsGenie region (%s) of the OpsGenie alerting channel", strings.Join(opsGenieRegions, "/")),
 			},
 		},
+		NameField:            AlertingChannelFieldName,
+		FullNameField:        AlertingChannelFieldFullName,
 		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.AlertingChannels() },
+		BeforeUpsert:         verifyAlertingChannelOnApply,
 		UpdateState:          updateStateForAlertingChannelOpsGenie,
 		MapStateToDataObject: mapStateToDataObjectForAlertingChannelOpsGenie,
 	}
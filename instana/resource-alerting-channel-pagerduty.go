@@ -18,15 +18,19 @@ func NewAlertingChannelPagerDutyResourceHandle() *ResourceHandle {
 	return &ResourceHandle{
 		ResourceName: ResourceInstanaAlertingChannelPagerDuty,
 		Schema: map[string]*schema.Schema{
-			AlertingChannelFieldName:     alertingChannelNameSchemaField,
-			AlertingChannelFieldFullName: alertingChannelFullNameSchemaField,
+			AlertingChannelFieldName:          alertingChannelNameSchemaField,
+			AlertingChannelFieldFullName:      alertingChannelFullNameSchemaField,
+			AlertingChannelFieldVerifyOnApply: alertingChannelVerifyOnApplySchemaField,
 			AlertingChannelPagerDutyFieldServiceIntegrationKey: {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "The Service Integration Key of the PagerDuty alerting channel",
 			},
 		},
+		NameField:            AlertingChannelFieldName,
+		FullNameField:        AlertingChannelFieldFullName,
 		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.AlertingChannels() },
+		BeforeUpsert:         verifyAlertingChannelOnApply,
 		UpdateState:          updateStateForAlertingChannelPagerDuty,
 		MapStateToDataObject: mapStateToDataObjectForAlertingChannelPagerDuty,
 	}
@@ -0,0 +1,48 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	//AlertingChannelPrometheusWebhookFieldReceiver const for the receiver field of the Prometheus Webhook alerting channel
+	AlertingChannelPrometheusWebhookFieldReceiver = "receiver"
+	//ResourceInstanaAlertingChannelPrometheusWebhook the name of the terraform-provider-instana resource to manage alerting channels of type Prometheus Webhook
+	ResourceInstanaAlertingChannelPrometheusWebhook = "instana_alerting_channel_prometheus_webhook"
+)
+
+//NewAlertingChannelPrometheusWebhookResourceHandle creates the terraform resource for Alerting Channels of type Prometheus Webhook.
+//The resource extends the webhook based alerting channels by the name of the receiver of the Prometheus Alertmanager
+func NewAlertingChannelPrometheusWebhookResourceHandle() *ResourceHandle {
+	resourceHandle := newAlertingChannelWebhookBasedResourceHandle(restapi.PrometheusWebhookChannelType, ResourceInstanaAlertingChannelPrometheusWebhook)
+	resourceHandle.Schema[AlertingChannelPrometheusWebhookFieldReceiver] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The name of the receiver of the Prometheus Alertmanager to which the alerts are sent",
+	}
+	resourceHandle.UpdateState = updateStateForPrometheusWebhookAlertingChannel
+	resourceHandle.MapStateToDataObject = mapStateToDataObjectForPrometheusWebhookAlertingChannel
+	return resourceHandle
+}
+
+func updateStateForPrometheusWebhookAlertingChannel(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
+	if err := updateStateForWebhhookBasedAlertingChannel(d, obj); err != nil {
+		return err
+	}
+	d.Set(AlertingChannelPrometheusWebhookFieldReceiver, obj.(restapi.AlertingChannel).Receiver)
+	return nil
+}
+
+func mapStateToDataObjectForPrometheusWebhookAlertingChannel(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	obj, err := mapStateToDataObjectForWebhhookBasedAlertingChannel(d, formatter, restapi.PrometheusWebhookChannelType)
+	if err != nil {
+		return obj, err
+	}
+	alertingChannel := obj.(restapi.AlertingChannel)
+	alertingChannel.Receiver = GetStringPointerFromResourceData(d, AlertingChannelPrometheusWebhookFieldReceiver)
+	return alertingChannel, nil
+}
@@ -0,0 +1,196 @@
+package instana_test
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+var testAlertingChannelPrometheusWebhookProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceAlertingChannelPrometheusWebhookDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+  default_name_prefix = "prefix"
+  default_name_suffix = "suffix"
+}
+
+resource "instana_alerting_channel_prometheus_webhook" "example" {
+  name        = "name {{ITERATOR}}"
+  webhook_url = "webhook url"
+  receiver    = "receiver"
+}
+`
+
+const alertingChannelPrometheusWebhookServerResponseTemplate = `
+{
+	"id"     	 : "{{id}}",
+	"name"   	 : "prefix name suffix",
+	"kind"   	 : "PROMETHEUS_WEBHOOK",
+	"webhookUrl" : "webhook url",
+	"receiver"   : "receiver"
+}
+`
+
+const alertingChannelPrometheusWebhookApiPath = restapi.AlertingChannelsResourcePath + "/{id}"
+const testAlertingChannelPrometheusWebhookDefinition = "instana_alerting_channel_prometheus_webhook.example"
+const testAlertingChannelPrometheusWebhookWebhookURL = "webhook url"
+const testAlertingChannelPrometheusWebhookReceiver = "receiver"
+
+func TestCRUDOfAlertingChannelPrometheusWebhookResourceWithMockServer(t *testing.T) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, alertingChannelPrometheusWebhookApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodDelete, alertingChannelPrometheusWebhookApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, alertingChannelPrometheusWebhookApiPath, func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		json := strings.ReplaceAll(alertingChannelPrometheusWebhookServerResponseTemplate, "{{id}}", vars["id"])
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(json))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinitionWithoutName := strings.ReplaceAll(resourceAlertingChannelPrometheusWebhookDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+	resourceDefinitionWithoutName0 := strings.ReplaceAll(resourceDefinitionWithoutName, iteratorPlaceholder, "0")
+	resourceDefinitionWithoutName1 := strings.ReplaceAll(resourceDefinitionWithoutName, iteratorPlaceholder, "1")
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAlertingChannelPrometheusWebhookProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinitionWithoutName0,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testAlertingChannelPrometheusWebhookDefinition, "id"),
+					resource.TestCheckResourceAttr(testAlertingChannelPrometheusWebhookDefinition, AlertingChannelFieldName, "name 0"),
+					resource.TestCheckResourceAttr(testAlertingChannelPrometheusWebhookDefinition, AlertingChannelFieldFullName, "prefix name 0 suffix"),
+					resource.TestCheckResourceAttr(testAlertingChannelPrometheusWebhookDefinition, AlertingChannelWebhookBasedFieldWebhookURL, testAlertingChannelPrometheusWebhookWebhookURL),
+					resource.TestCheckResourceAttr(testAlertingChannelPrometheusWebhookDefinition, AlertingChannelPrometheusWebhookFieldReceiver, testAlertingChannelPrometheusWebhookReceiver),
+				),
+			},
+			{
+				Config: resourceDefinitionWithoutName1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testAlertingChannelPrometheusWebhookDefinition, "id"),
+					resource.TestCheckResourceAttr(testAlertingChannelPrometheusWebhookDefinition, AlertingChannelFieldName, "name 1"),
+					resource.TestCheckResourceAttr(testAlertingChannelPrometheusWebhookDefinition, AlertingChannelFieldFullName, "prefix name 1 suffix"),
+					resource.TestCheckResourceAttr(testAlertingChannelPrometheusWebhookDefinition, AlertingChannelWebhookBasedFieldWebhookURL, testAlertingChannelPrometheusWebhookWebhookURL),
+					resource.TestCheckResourceAttr(testAlertingChannelPrometheusWebhookDefinition, AlertingChannelPrometheusWebhookFieldReceiver, testAlertingChannelPrometheusWebhookReceiver),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAlertingChannelPrometheusWebhookDefinition(t *testing.T) {
+	schemaMap := NewAlertingChannelPrometheusWebhookResourceHandle().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelFieldFullName)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(AlertingChannelFieldVerifyOnApply, false)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelPrometheusWebhookFieldReceiver)
+}
+
+func TestShouldNotAddReceiverToSchemaOfOtherWebhookBasedAlertingChannels(t *testing.T) {
+	NewAlertingChannelPrometheusWebhookResourceHandle()
+
+	_, ok := NewAlertingChannelWebexTeamsWebhookResourceHandle().Schema[AlertingChannelPrometheusWebhookFieldReceiver]
+
+	assert.False(t, ok)
+}
+
+func TestShouldReturnCorrectResourceNameForAlertingChannelPrometheusWebhook(t *testing.T) {
+	name := NewAlertingChannelPrometheusWebhookResourceHandle().ResourceName
+
+	assert.Equal(t, "instana_alerting_channel_prometheus_webhook", name)
+}
+
+func TestAlertingChannelPrometheusWebhookShouldHaveSchemaVersionZero(t *testing.T) {
+	assert.Equal(t, 0, NewAlertingChannelPrometheusWebhookResourceHandle().SchemaVersion)
+}
+
+func TestShouldUpdateResourceStateForAlertingChannelPrometheusWebhook(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewAlertingChannelPrometheusWebhookResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	webhookURL := testAlertingChannelPrometheusWebhookWebhookURL
+	receiver := testAlertingChannelPrometheusWebhookReceiver
+	data := restapi.AlertingChannel{
+		ID:         "id",
+		Name:       "name",
+		Kind:       restapi.PrometheusWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Receiver:   &receiver,
+	}
+
+	err := resourceHandle.UpdateState(resourceData, data)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "id", resourceData.Id(), "id should be equal")
+	assert.Equal(t, "name", resourceData.Get(AlertingChannelFieldFullName), "name should be equal to full name")
+	assert.Equal(t, webhookURL, resourceData.Get(AlertingChannelWebhookBasedFieldWebhookURL), "webhook url should be equal")
+	assert.Equal(t, receiver, resourceData.Get(AlertingChannelPrometheusWebhookFieldReceiver), "receiver should be equal")
+}
+
+func TestShouldConvertStateOfAlertingChannelPrometheusWebhookToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewAlertingChannelPrometheusWebhookResourceHandle()
+	webhookURL := testAlertingChannelPrometheusWebhookWebhookURL
+	receiver := testAlertingChannelPrometheusWebhookReceiver
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	resourceData.Set(AlertingChannelFieldName, "name")
+	resourceData.Set(AlertingChannelFieldFullName, "prefix name suffix")
+	resourceData.Set(AlertingChannelWebhookBasedFieldWebhookURL, webhookURL)
+	resourceData.Set(AlertingChannelPrometheusWebhookFieldReceiver, receiver)
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.Nil(t, err)
+	assert.IsType(t, restapi.AlertingChannel{}, model, "Model should be an alerting channel")
+	assert.Equal(t, "id", model.GetID())
+	assert.Equal(t, "prefix name suffix", model.(restapi.AlertingChannel).Name, "name should be equal to full name")
+	assert.Equal(t, restapi.PrometheusWebhookChannelType, model.(restapi.AlertingChannel).Kind)
+	assert.Equal(t, webhookURL, *model.(restapi.AlertingChannel).WebhookURL, "webhook url should be equal")
+	assert.Equal(t, receiver, *model.(restapi.AlertingChannel).Receiver, "receiver should be equal")
+}
+
+func TestShouldConvertStateOfAlertingChannelPrometheusWebhookWithoutReceiverToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewAlertingChannelPrometheusWebhookResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	resourceData.Set(AlertingChannelFieldName, "name")
+	resourceData.Set(AlertingChannelWebhookBasedFieldWebhookURL, testAlertingChannelPrometheusWebhookWebhookURL)
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.Nil(t, err)
+	assert.Nil(t, model.(restapi.AlertingChannel).Receiver)
+}
+
+func TestShouldRejectEmptyReceiverOfAlertingChannelPrometheusWebhook(t *testing.T) {
+	receiverSchema := NewAlertingChannelPrometheusWebhookResourceHandle().Schema[AlertingChannelPrometheusWebhookFieldReceiver]
+
+	_, errs := receiverSchema.ValidateFunc("", AlertingChannelPrometheusWebhookFieldReceiver)
+	assert.Len(t, errs, 1)
+	_, errs = receiverSchema.ValidateFunc(testAlertingChannelPrometheusWebhookReceiver, AlertingChannelPrometheusWebhookFieldReceiver)
+	assert.Len(t, errs, 0)
+}
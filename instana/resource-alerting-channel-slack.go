@@ -22,8 +22,9 @@ func NewAlertingChannelSlackResourceHandle() *ResourceHandle {
 	return &ResourceHandle{
 		ResourceName: ResourceInstanaAlertingChannelSlack,
 		Schema: map[string]*schema.Schema{
-			AlertingChannelFieldName:     alertingChannelNameSchemaField,
-			AlertingChannelFieldFullName: alertingChannelFullNameSchemaField,
+			AlertingChannelFieldName:          alertingChannelNameSchemaField,
+			AlertingChannelFieldFullName:      alertingChannelFullNameSchemaField,
+			AlertingChannelFieldVerifyOnApply: alertingChannelVerifyOnApplySchemaField,
 			AlertingChannelSlackFieldWebhookURL: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -40,7 +41,10 @@ func NewAlertingChannelSlackResourceHandle() *ResourceHandle {
 				Description: "The Slack channel of the Slack alerting channel",
 			},
 		},
+		NameField:            AlertingChannelFieldName,
+		FullNameField:        AlertingChannelFieldFullName,
 		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.AlertingChannels() },
+		BeforeUpsert:         verifyAlertingChannelOnApply,
 		UpdateState:          updateStateForAlertingChannelSlack,
 		MapStateToDataObject: mapStateToDataObjectForAlertingChannelSlack,
 	}
@@ -2,6 +2,7 @@ package instana_test
 
 import (
 	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -102,6 +103,95 @@ func TestCRUDOfAlertingChannelSlackResourceWithMockServer(t *testing.T) {
 	})
 }
 
+const resourceAlertingChannelSlackWithVerifyOnApplyDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+  default_name_prefix = "prefix"
+  default_name_suffix = "suffix"
+}
+
+resource "instana_alerting_channel_slack" "example" {
+  name            = "name"
+  webhook_url     = "webhook url"
+  icon_url        = "icon url"
+  channel         = "channel"
+  verify_on_apply = true
+}
+`
+
+const alertingChannelSlackTestApiPath = restapi.AlertingChannelsResourcePath + "/" + restapi.AlertingChannelTestPathElement
+
+func TestShouldFailToCreateAlertingChannelSlackWhenTestNotificationCannotBeDeliveredAndVerifyOnApplyIsEnabled(t *testing.T) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	createCalls := 0
+	httpServer.AddRoute(http.MethodPut, alertingChannelSlackTestApiPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errors":["webhook not reachable"]}`))
+	})
+	httpServer.AddRoute(http.MethodPut, alertingChannelSlackApiPath, func(w http.ResponseWriter, r *http.Request) {
+		createCalls++
+		testutils.EchoHandlerFunc(w, r)
+	})
+	httpServer.AddRoute(http.MethodGet, alertingChannelSlackApiPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceAlertingChannelSlackWithVerifyOnApplyDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAlertingChannelSlackProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      resourceDefinition,
+				ExpectError: regexp.MustCompile("failed to send test notification through alerting channel prefix name suffix(.|\\n)*webhook not reachable"),
+			},
+		},
+	})
+	assert.Equal(t, 0, createCalls)
+}
+
+func TestShouldCreateAlertingChannelSlackWhenTestNotificationIsDeliveredAndVerifyOnApplyIsEnabled(t *testing.T) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	testCalls := 0
+	httpServer.AddRoute(http.MethodPut, alertingChannelSlackTestApiPath, func(w http.ResponseWriter, r *http.Request) {
+		testCalls++
+		w.WriteHeader(http.StatusOK)
+	})
+	httpServer.AddRoute(http.MethodPut, alertingChannelSlackApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodDelete, alertingChannelSlackApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, alertingChannelSlackApiPath, func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		json := strings.ReplaceAll(alertingChannelSlackServerResponseTemplate, "{{id}}", vars["id"])
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(json))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceAlertingChannelSlackWithVerifyOnApplyDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAlertingChannelSlackProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinition,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testAlertingChannelSlackDefinition, "id"),
+					resource.TestCheckResourceAttr(testAlertingChannelSlackDefinition, AlertingChannelFieldVerifyOnApply, "true"),
+				),
+			},
+		},
+	})
+	assert.Equal(t, 1, testCalls)
+}
+
 func TestResourceAlertingChannelSlackDefinition(t *testing.T) {
 	resource := NewAlertingChannelSlackResourceHandle()
 
@@ -110,6 +200,7 @@ func TestResourceAlertingChannelSlackDefinition(t *testing.T) {
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelFieldName)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelFieldFullName)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(AlertingChannelFieldVerifyOnApply, false)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelSlackFieldWebhookURL)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelSlackFieldIconURL)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelSlackFieldChannel)
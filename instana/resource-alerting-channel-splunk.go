@@ -20,8 +20,9 @@ func NewAlertingChannelSplunkResourceHandle() *ResourceHandle {
 	return &ResourceHandle{
 		ResourceName: ResourceInstanaAlertingChannelSplunk,
 		Schema: map[string]*schema.Schema{
-			AlertingChannelFieldName:     alertingChannelNameSchemaField,
-			AlertingChannelFieldFullName: alertingChannelFullNameSchemaField,
+			AlertingChannelFieldName:          alertingChannelNameSchemaField,
+			AlertingChannelFieldFullName:      alertingChannelFullNameSchemaField,
+			AlertingChannelFieldVerifyOnApply: alertingChannelVerifyOnApplySchemaField,
 			AlertingChannelSplunkFieldURL: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -33,7 +34,10 @@ func NewAlertingChannelSplunkResourceHandle() *ResourceHandle {
 				Description: "The token of the Splunk alerting channel",
 			},
 		},
+		NameField:            AlertingChannelFieldName,
+		FullNameField:        AlertingChannelFieldFullName,
 		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.AlertingChannels() },
+		BeforeUpsert:         verifyAlertingChannelOnApply,
 		UpdateState:          updateStateForAlertingChannelSplunk,
 		MapStateToDataObject: monvertStateToDataObjectForAlertingChannelSplunk,
 	}
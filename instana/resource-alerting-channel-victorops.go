@@ -20,8 +20,9 @@ func NewAlertingChannelVictorOpsResourceHandle() *ResourceHandle {
 	return &ResourceHandle{
 		ResourceName: ResourceInstanaAlertingChannelVictorOps,
 		Schema: map[string]*schema.Schema{
-			AlertingChannelFieldName:     alertingChannelNameSchemaField,
-			AlertingChannelFieldFullName: alertingChannelFullNameSchemaField,
+			AlertingChannelFieldName:          alertingChannelNameSchemaField,
+			AlertingChannelFieldFullName:      alertingChannelFullNameSchemaField,
+			AlertingChannelFieldVerifyOnApply: alertingChannelVerifyOnApplySchemaField,
 			AlertingChannelVictorOpsFieldAPIKey: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -33,7 +34,10 @@ func NewAlertingChannelVictorOpsResourceHandle() *ResourceHandle {
 				Description: "The Routing Key of the VictorOps alerting channel",
 			},
 		},
+		NameField:            AlertingChannelFieldName,
+		FullNameField:        AlertingChannelFieldFullName,
 		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.AlertingChannels() },
+		BeforeUpsert:         verifyAlertingChannelOnApply,
 		UpdateState:          updateStateForAlertingChannelVictorOps,
 		MapStateToDataObject: mapStateToDataObjectForAlertingChannelVictorOps,
 	}
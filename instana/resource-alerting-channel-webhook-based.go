@@ -15,6 +15,8 @@ const (
 	ResourceInstanaAlertingChannelOffice365 = "instana_alerting_channel_office_365"
 	//ResourceInstanaAlertingChannelGoogleChat the name of the terraform-provider-instana resource to manage alerting channels of type Google Chat
 	ResourceInstanaAlertingChannelGoogleChat = "instana_alerting_channel_google_chat"
+	//ResourceInstanaAlertingChannelWebexTeamsWebhook the name of the terraform-provider-instana resource to manage alerting channels of type Webex Teams Webhook
+	ResourceInstanaAlertingChannelWebexTeamsWebhook = "instana_alerting_channel_webex_teams_webhook"
 )
 
 //NewAlertingChannelGoogleChatResourceHandle creates the terraform resource for Alerting Channels of type Google Chat
@@ -27,19 +29,24 @@ func NewAlertingChannelOffice356ResourceHandle() *ResourceHandle {
 	return newAlertingChannelWebhookBasedResourceHandle(restapi.Office365ChannelType, ResourceInstanaAlertingChannelOffice365)
 }
 
+//NewAlertingChannelWebexTeamsWebhookResourceHandle creates the terraform resource for Alerting Channels of type Webex Teams Webhook
+func NewAlertingChannelWebexTeamsWebhookResourceHandle() *ResourceHandle {
+	return newAlertingChannelWebhookBasedResourceHandle(restapi.WebexTeamsWebhookChannelType, ResourceInstanaAlertingChannelWebexTeamsWebhook)
+}
+
 func newAlertingChannelWebhookBasedResourceHandle(channelType restapi.AlertingChannelType, resourceName string) *ResourceHandle {
 	return &ResourceHandle{
 		ResourceName: resourceName,
 		Schema: map[string]*schema.Schema{
-			AlertingChannelFieldName:     alertingChannelNameSchemaField,
-			AlertingChannelFieldFullName: alertingChannelFullNameSchemaField,
-			AlertingChannelWebhookBasedFieldWebhookURL: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: fmt.Sprintf("The webhook URL of the %s alerting channel", channelType),
-			},
+			AlertingChannelFieldName:                   alertingChannelNameSchemaField,
+			AlertingChannelFieldFullName:               alertingChannelFullNameSchemaField,
+			AlertingChannelFieldVerifyOnApply:          alertingChannelVerifyOnApplySchemaField,
+			AlertingChannelWebhookBasedFieldWebhookURL: newAlertingChannelWebhookURLSchemaField(channelType),
 		},
+		NameField:           AlertingChannelFieldName,
+		FullNameField:       AlertingChannelFieldFullName,
 		RestResourceFactory: func(api restapi.InstanaAPI) restapi.RestResource { return api.AlertingChannels() },
+		BeforeUpsert:        verifyAlertingChannelOnApply,
 		UpdateState:         updateStateForWebhhookBasedAlertingChannel,
 		MapStateToDataObject: func(d *schema.ResourceData, f utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
 			return mapStateToDataObjectForWebhhookBasedAlertingChannel(d, f, channelType)
@@ -47,6 +54,14 @@ func newAlertingChannelWebhookBasedResourceHandle(channelType restapi.AlertingCh
 	}
 }
 
+func newAlertingChannelWebhookURLSchemaField(channelType restapi.AlertingChannelType) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: fmt.Sprintf("The webhook URL of the %s alerting channel", channelType),
+	}
+}
+
 func updateStateForWebhhookBasedAlertingChannel(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
 	alertingChannel := obj.(restapi.AlertingChannel)
 	d.Set(AlertingChannelFieldFullName, alertingChannel.Name)
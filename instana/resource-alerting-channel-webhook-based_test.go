@@ -49,7 +49,7 @@ const alertingChannelWebhookBasedApiPath = restapi.AlertingChannelsResourcePath
 const testAlertingChannelWebhookBasedDefinition = "instana_alerting_channel_%s.example"
 const alertingChannelWebhookBasedWebhookUrl = "webhook url"
 
-var supportedAlertingChannelWebhookTypes = []restapi.AlertingChannelType{restapi.GoogleChatChannelType, restapi.Office365ChannelType}
+var supportedAlertingChannelWebhookTypes = []restapi.AlertingChannelType{restapi.GoogleChatChannelType, restapi.Office365ChannelType, restapi.WebexTeamsWebhookChannelType}
 
 func TestCRUDOfAlertingChannelWebhookBasedResourceWithMockServer(t *testing.T) {
 	for _, channelType := range supportedAlertingChannelWebhookTypes {
@@ -109,12 +109,17 @@ func TestResourceAlertingChannelOffice365Definition(t *testing.T) {
 	testResourceAlertingChannelWebhookBasedDefinition(t, NewAlertingChannelOffice356ResourceHandle())
 }
 
+func TestResourceAlertingChannelWebexTeamsWebhookDefinition(t *testing.T) {
+	testResourceAlertingChannelWebhookBasedDefinition(t, NewAlertingChannelWebexTeamsWebhookResourceHandle())
+}
+
 func testResourceAlertingChannelWebhookBasedDefinition(t *testing.T, resourceHandle *ResourceHandle) {
 	schemaMap := resourceHandle.Schema
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelFieldName)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelFieldFullName)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(AlertingChannelFieldVerifyOnApply, false)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
 }
 
@@ -175,3 +180,23 @@ func TestShouldReturnCorrectResourceNameForAlertingChannelOffice365(t *testing.T
 
 	assert.Equal(t, name, "instana_alerting_channel_office_365")
 }
+
+func TestShouldReturnCorrectResourceNameForAlertingChannelWebexTeamsWebhook(t *testing.T) {
+	name := NewAlertingChannelWebexTeamsWebhookResourceHandle().ResourceName
+
+	assert.Equal(t, "instana_alerting_channel_webex_teams_webhook", name)
+}
+
+func TestShouldConvertStateOfAlertingChannelWebexTeamsWebhookToDataModelWithWebexTeamsWebhookKind(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewAlertingChannelWebexTeamsWebhookResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	resourceData.Set(AlertingChannelFieldName, "name")
+	resourceData.Set(AlertingChannelWebhookBasedFieldWebhookURL, alertingChannelWebhookBasedWebhookUrl)
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, restapi.WebexTeamsWebhookChannelType, model.(restapi.AlertingChannel).Kind)
+}
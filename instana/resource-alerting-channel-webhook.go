@@ -46,6 +46,7 @@ func NewAlertingChannelWebhookResourceHandle() *ResourceHandle {
 		Schema: map[string]*schema.Schema{
 			AlertingChannelFieldName:               alertingChannelNameSchemaField,
 			AlertingChannelFieldFullName:           alertingChannelFullNameSchemaField,
+			AlertingChannelFieldVerifyOnApply:      alertingChannelVerifyOnApplySchemaField,
 			AlertingChannelWebhookFieldWebhookURLs: AlertingChannelWebhookWebhookURLsSchemaField,
 			AlertingChannelWebhookFieldHTTPHeaders: AlertingChannelWebhookHTTPHeadersSchemaField,
 		},
@@ -59,7 +60,10 @@ func NewAlertingChannelWebhookResourceHandle() *ResourceHandle {
 				Version: 0,
 			},
 		},
+		NameField:            AlertingChannelFieldName,
+		FullNameField:        AlertingChannelFieldFullName,
 		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.AlertingChannels() },
+		BeforeUpsert:         verifyAlertingChannelOnApply,
 		UpdateState:          updateStateForAlertingChannelWebhook,
 		MapStateToDataObject: mapStateToDataObjectForAlertingChannelWebhook,
 	}
@@ -103,20 +107,22 @@ func mapStateToDataObjectForAlertingChannelWebhook(d *schema.ResourceData, forma
 
 func createHTTPHeaderListFromMap(d *schema.ResourceData) []string {
 	if attr, ok := d.GetOk(AlertingChannelWebhookFieldHTTPHeaders); ok {
-		headerMap := attr.(map[string]interface{})
-		result := make([]string, len(headerMap))
-		i := 0
-		for key, value := range headerMap {
-			header := fmt.Sprintf("%s: %s", key, value)
-			result[i] = header
-			i++
-		}
-
-		return result
+		return convertHTTPHeaderMapToList(attr.(map[string]interface{}))
 	}
 	return []string{}
 }
 
+func convertHTTPHeaderMapToList(headerMap map[string]interface{}) []string {
+	result := make([]string, len(headerMap))
+	i := 0
+	for key, value := range headerMap {
+		header := fmt.Sprintf("%s: %s", key, value)
+		result[i] = header
+		i++
+	}
+	return result
+}
+
 func alertingChannelWebhookSchemaV0() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -0,0 +1,365 @@
+package instana
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+//ResourceInstanaAlertingChannel the name of the terraform-provider-instana resource to manage alerting channels of any kind
+const ResourceInstanaAlertingChannel = "instana_alerting_channel"
+
+const (
+	//AlertingChannelFieldKind constant value for the schema field kind
+	AlertingChannelFieldKind = "kind"
+	//AlertingChannelFieldEmail constant value for the schema field email which contains the configuration of alerting channels of kind EMAIL
+	AlertingChannelFieldEmail = "email"
+	//AlertingChannelFieldGoogleChat constant value for the schema field google_chat which contains the configuration of alerting channels of kind GOOGLE_CHAT
+	AlertingChannelFieldGoogleChat = "google_chat"
+	//AlertingChannelFieldOffice365 constant value for the schema field office_365 which contains the configuration of alerting channels of kind OFFICE_365
+	AlertingChannelFieldOffice365 = "office_365"
+	//AlertingChannelFieldOpsGenie constant value for the schema field ops_genie which contains the configuration of alerting channels of kind OPS_GENIE
+	AlertingChannelFieldOpsGenie = "ops_genie"
+	//AlertingChannelFieldPagerDuty constant value for the schema field pager_duty which contains the configuration of alerting channels of kind PAGER_DUTY
+	AlertingChannelFieldPagerDuty = "pager_duty"
+	//AlertingChannelFieldPrometheusWebhook constant value for the schema field prometheus_webhook which contains the configuration of alerting channels of kind PROMETHEUS_WEBHOOK
+	AlertingChannelFieldPrometheusWebhook = "prometheus_webhook"
+	//AlertingChannelFieldSlack constant value for the schema field slack which contains the configuration of alerting channels of kind SLACK
+	AlertingChannelFieldSlack = "slack"
+	//AlertingChannelFieldSplunk constant value for the schema field splunk which contains the configuration of alerting channels of kind SPLUNK
+	AlertingChannelFieldSplunk = "splunk"
+	//AlertingChannelFieldVictorOps constant value for the schema field victor_ops which contains the configuration of alerting channels of kind VICTOR_OPS
+	AlertingChannelFieldVictorOps = "victor_ops"
+	//AlertingChannelFieldWebexTeamsWebhook constant value for the schema field webex_teams_webhook which contains the configuration of alerting channels of kind WEBEX_TEAMS_WEBHOOK
+	AlertingChannelFieldWebexTeamsWebhook = "webex_teams_webhook"
+	//AlertingChannelFieldWebhook constant value for the schema field webhook which contains the configuration of alerting channels of kind WEB_HOOK
+	AlertingChannelFieldWebhook = "webhook"
+)
+
+//AlertingChannelKindFields maps the supported alerting channel kinds to the schema field of the nested block which contains the kind specific configuration
+var AlertingChannelKindFields = map[restapi.AlertingChannelType]string{
+	restapi.EmailChannelType:             AlertingChannelFieldEmail,
+	restapi.GoogleChatChannelType:        AlertingChannelFieldGoogleChat,
+	restapi.Office365ChannelType:         AlertingChannelFieldOffice365,
+	restapi.OpsGenieChannelType:          AlertingChannelFieldOpsGenie,
+	restapi.PagerDutyChannelType:         AlertingChannelFieldPagerDuty,
+	restapi.PrometheusWebhookChannelType: AlertingChannelFieldPrometheusWebhook,
+	restapi.SlackChannelType:             AlertingChannelFieldSlack,
+	restapi.SplunkChannelType:            AlertingChannelFieldSplunk,
+	restapi.VictorOpsChannelType:         AlertingChannelFieldVictorOps,
+	restapi.WebexTeamsWebhookChannelType: AlertingChannelFieldWebexTeamsWebhook,
+	restapi.WebhookChannelType:           AlertingChannelFieldWebhook,
+}
+
+//NewAlertingChannelResourceHandle creates the resource handle for Alerting Channels of any kind. The kind specific configuration is provided
+//as nested block. Exactly the block of the configured kind is required which is verified at plan time. The kind specific values are verified by
+//the validation of the alerting channel in the REST API layer so that the rules are kept in one place for all alerting channel resources
+func NewAlertingChannelResourceHandle() *ResourceHandle {
+	return &ResourceHandle{
+		ResourceName:         ResourceInstanaAlertingChannel,
+		Schema:               alertingChannelSchema(),
+		SchemaVersion:        0,
+		NameField:            AlertingChannelFieldName,
+		FullNameField:        AlertingChannelFieldFullName,
+		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.AlertingChannels() },
+		BeforeUpsert:         verifyAlertingChannelOnApply,
+		UpdateState:          updateStateForAlertingChannel,
+		MapStateToDataObject: mapStateToDataObjectForAlertingChannel,
+		CustomizeDiff:        validateKindBlockOfAlertingChannel,
+	}
+}
+
+func alertingChannelSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		AlertingChannelFieldName:          alertingChannelNameSchemaField,
+		AlertingChannelFieldFullName:      alertingChannelFullNameSchemaField,
+		AlertingChannelFieldVerifyOnApply: alertingChannelVerifyOnApplySchemaField,
+		AlertingChannelFieldKind: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(convertSupportedAlertingChannelTypesToStringSlice(), false),
+			Description:  "The kind of the alerting channel. The kind specific configuration must be provided in the corresponding nested block",
+		},
+		AlertingChannelFieldEmail: newAlertingChannelKindSchemaField(restapi.EmailChannelType, map[string]*schema.Schema{
+			AlertingChannelEmailFieldEmails: AlertingChannelEmailEmailsSchemaField,
+		}),
+		AlertingChannelFieldGoogleChat: newAlertingChannelKindSchemaField(restapi.GoogleChatChannelType, map[string]*schema.Schema{
+			AlertingChannelWebhookBasedFieldWebhookURL: newAlertingChannelWebhookURLSchemaField(restapi.GoogleChatChannelType),
+		}),
+		AlertingChannelFieldOffice365: newAlertingChannelKindSchemaField(restapi.Office365ChannelType, map[string]*schema.Schema{
+			AlertingChannelWebhookBasedFieldWebhookURL: newAlertingChannelWebhookURLSchemaField(restapi.Office365ChannelType),
+		}),
+		AlertingChannelFieldOpsGenie: newAlertingChannelKindSchemaField(restapi.OpsGenieChannelType, map[string]*schema.Schema{
+			AlertingChannelOpsGenieFieldAPIKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The OpsGenie API Key of the OpsGenie alerting channel",
+			},
+			AlertingChannelOpsGenieFieldTags: {
+				Type:     schema.TypeList,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required:    true,
+				Description: "The OpsGenie tags of the OpsGenie alerting channel",
+			},
+			AlertingChannelOpsGenieFieldRegion: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(opsGenieRegions, false),
+				Description:  fmt.Sprintf("The OpsGenie region (%s) of the OpsGenie alerting channel", strings.Join(opsGenieRegions, "/")),
+			},
+		}),
+		AlertingChannelFieldPagerDuty: newAlertingChannelKindSchemaField(restapi.PagerDutyChannelType, map[string]*schema.Schema{
+			AlertingChannelPagerDutyFieldServiceIntegrationKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The Service Integration Key of the PagerDuty alerting channel",
+			},
+		}),
+		AlertingChannelFieldPrometheusWebhook: newAlertingChannelKindSchemaField(restapi.PrometheusWebhookChannelType, map[string]*schema.Schema{
+			AlertingChannelWebhookBasedFieldWebhookURL: newAlertingChannelWebhookURLSchemaField(restapi.PrometheusWebhookChannelType),
+			AlertingChannelPrometheusWebhookFieldReceiver: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The name of the receiver of the Prometheus Alertmanager to which the alerts are sent",
+			},
+		}),
+		AlertingChannelFieldSlack: newAlertingChannelKindSchemaField(restapi.SlackChannelType, map[string]*schema.Schema{
+			AlertingChannelSlackFieldWebhookURL: newAlertingChannelWebhookURLSchemaField(restapi.SlackChannelType),
+			AlertingChannelSlackFieldIconURL: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The icon URL of the Slack alerting channel",
+			},
+			AlertingChannelSlackFieldChannel: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Slack channel of the Slack alerting channel",
+			},
+		}),
+		AlertingChannelFieldSplunk: newAlertingChannelKindSchemaField(restapi.SplunkChannelType, map[string]*schema.Schema{
+			AlertingChannelSplunkFieldURL: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The URL of the Splunk alerting channel",
+			},
+			AlertingChannelSplunkFieldToken: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The token of the Splunk alerting channel",
+			},
+		}),
+		AlertingChannelFieldVictorOps: newAlertingChannelKindSchemaField(restapi.VictorOpsChannelType, map[string]*schema.Schema{
+			AlertingChannelVictorOpsFieldAPIKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The API Key of the VictorOps alerting channel",
+			},
+			AlertingChannelVictorOpsFieldRoutingKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Routing Key of the VictorOps alerting channel",
+			},
+		}),
+		AlertingChannelFieldWebexTeamsWebhook: newAlertingChannelKindSchemaField(restapi.WebexTeamsWebhookChannelType, map[string]*schema.Schema{
+			AlertingChannelWebhookBasedFieldWebhookURL: newAlertingChannelWebhookURLSchemaField(restapi.WebexTeamsWebhookChannelType),
+		}),
+		AlertingChannelFieldWebhook: newAlertingChannelKindSchemaField(restapi.WebhookChannelType, map[string]*schema.Schema{
+			AlertingChannelWebhookFieldWebhookURLs: AlertingChannelWebhookWebhookURLsSchemaField,
+			AlertingChannelWebhookFieldHTTPHeaders: AlertingChannelWebhookHTTPHeadersSchemaField,
+		}),
+	}
+}
+
+func newAlertingChannelKindSchemaField(channelType restapi.AlertingChannelType, blockSchema map[string]*schema.Schema) *schema.Schema {
+	conflictingFields := make([]string, 0, len(AlertingChannelKindFields)-1)
+	for t, field := range AlertingChannelKindFields {
+		if t != channelType {
+			conflictingFields = append(conflictingFields, field)
+		}
+	}
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: conflictingFields,
+		Elem: &schema.Resource{
+			Schema: blockSchema,
+		},
+		Description: fmt.Sprintf("The configuration of the alerting channel when the kind %s is used", channelType),
+	}
+}
+
+//validateKindBlockOfAlertingChannel ensures at plan time that the nested block of the configured kind is provided and no block of another kind
+//is configured. The verification is skipped as long as the kind or the blocks are not known, e.g. when they depend on other resources
+func validateKindBlockOfAlertingChannel(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(AlertingChannelFieldKind) {
+		return nil
+	}
+	kind := restapi.AlertingChannelType(d.Get(AlertingChannelFieldKind).(string))
+	kindField, ok := AlertingChannelKindFields[kind]
+	if !ok {
+		return nil
+	}
+	for channelType, field := range AlertingChannelKindFields {
+		if !d.NewValueKnown(field) {
+			return nil
+		}
+		if channelType != kind && len(d.Get(field).([]interface{})) > 0 {
+			return fmt.Errorf("%s is not supported for alerting channels of kind %s; only %s is allowed", field, kind, kindField)
+		}
+	}
+	if len(d.Get(kindField).([]interface{})) == 0 {
+		return fmt.Errorf("%s must be configured for alerting channels of kind %s", kindField, kind)
+	}
+	return nil
+}
+
+func updateStateForAlertingChannel(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
+	alertingChannel := obj.(restapi.AlertingChannel)
+	d.Set(AlertingChannelFieldFullName, alertingChannel.Name)
+	d.Set(AlertingChannelFieldKind, string(alertingChannel.Kind))
+	for channelType, field := range AlertingChannelKindFields {
+		if channelType == alertingChannel.Kind {
+			d.Set(field, []interface{}{mapAlertingChannelKindConfigurationToBlock(alertingChannel)})
+		} else {
+			d.Set(field, []interface{}{})
+		}
+	}
+	d.SetId(alertingChannel.ID)
+	return nil
+}
+
+func mapAlertingChannelKindConfigurationToBlock(alertingChannel restapi.AlertingChannel) map[string]interface{} {
+	switch alertingChannel.Kind {
+	case restapi.EmailChannelType:
+		return map[string]interface{}{
+			AlertingChannelEmailFieldEmails: alertingChannel.Emails,
+		}
+	case restapi.GoogleChatChannelType, restapi.Office365ChannelType, restapi.WebexTeamsWebhookChannelType:
+		return map[string]interface{}{
+			AlertingChannelWebhookBasedFieldWebhookURL: derefString(alertingChannel.WebhookURL),
+		}
+	case restapi.OpsGenieChannelType:
+		region := ""
+		if alertingChannel.Region != nil {
+			region = string(*alertingChannel.Region)
+		}
+		return map[string]interface{}{
+			AlertingChannelOpsGenieFieldAPIKey: derefString(alertingChannel.APIKey),
+			AlertingChannelOpsGenieFieldTags:   convertCommaSeparatedListToSlice(derefString(alertingChannel.Tags)),
+			AlertingChannelOpsGenieFieldRegion: region,
+		}
+	case restapi.PagerDutyChannelType:
+		return map[string]interface{}{
+			AlertingChannelPagerDutyFieldServiceIntegrationKey: derefString(alertingChannel.ServiceIntegrationKey),
+		}
+	case restapi.PrometheusWebhookChannelType:
+		return map[string]interface{}{
+			AlertingChannelWebhookBasedFieldWebhookURL:    derefString(alertingChannel.WebhookURL),
+			AlertingChannelPrometheusWebhookFieldReceiver: derefString(alertingChannel.Receiver),
+		}
+	case restapi.SlackChannelType:
+		return map[string]interface{}{
+			AlertingChannelSlackFieldWebhookURL: derefString(alertingChannel.WebhookURL),
+			AlertingChannelSlackFieldIconURL:    derefString(alertingChannel.IconURL),
+			AlertingChannelSlackFieldChannel:    derefString(alertingChannel.Channel),
+		}
+	case restapi.SplunkChannelType:
+		return map[string]interface{}{
+			AlertingChannelSplunkFieldURL:   derefString(alertingChannel.URL),
+			AlertingChannelSplunkFieldToken: derefString(alertingChannel.Token),
+		}
+	case restapi.VictorOpsChannelType:
+		return map[string]interface{}{
+			AlertingChannelVictorOpsFieldAPIKey:     derefString(alertingChannel.APIKey),
+			AlertingChannelVictorOpsFieldRoutingKey: derefString(alertingChannel.RoutingKey),
+		}
+	default:
+		return map[string]interface{}{
+			AlertingChannelWebhookFieldWebhookURLs: alertingChannel.WebhookURLs,
+			AlertingChannelWebhookFieldHTTPHeaders: createHTTPHeaderMapFromList(alertingChannel.Headers),
+		}
+	}
+}
+
+func mapStateToDataObjectForAlertingChannel(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	kind := restapi.AlertingChannelType(d.Get(AlertingChannelFieldKind).(string))
+	alertingChannel := restapi.AlertingChannel{
+		ID:   d.Id(),
+		Name: computeFullAlertingChannelNameString(d, formatter),
+		Kind: kind,
+	}
+	block := readAlertingChannelKindBlock(d, kind)
+	switch kind {
+	case restapi.EmailChannelType:
+		alertingChannel.Emails = readStringSetFromMap(block, AlertingChannelEmailFieldEmails)
+	case restapi.GoogleChatChannelType, restapi.Office365ChannelType, restapi.WebexTeamsWebhookChannelType:
+		alertingChannel.WebhookURL = readStringPointerFromMap(block, AlertingChannelWebhookBasedFieldWebhookURL)
+	case restapi.OpsGenieChannelType:
+		region := restapi.OpsGenieRegionType(derefString(readStringPointerFromMap(block, AlertingChannelOpsGenieFieldRegion)))
+		tags := strings.Join(readStringListFromMap(block, AlertingChannelOpsGenieFieldTags), ",")
+		alertingChannel.APIKey = readStringPointerFromMap(block, AlertingChannelOpsGenieFieldAPIKey)
+		alertingChannel.Region = &region
+		alertingChannel.Tags = &tags
+	case restapi.PagerDutyChannelType:
+		alertingChannel.ServiceIntegrationKey = readStringPointerFromMap(block, AlertingChannelPagerDutyFieldServiceIntegrationKey)
+	case restapi.PrometheusWebhookChannelType:
+		alertingChannel.WebhookURL = readStringPointerFromMap(block, AlertingChannelWebhookBasedFieldWebhookURL)
+		alertingChannel.Receiver = readOptionalStringFromMap(block, AlertingChannelPrometheusWebhookFieldReceiver)
+	case restapi.SlackChannelType:
+		alertingChannel.WebhookURL = readStringPointerFromMap(block, AlertingChannelSlackFieldWebhookURL)
+		alertingChannel.IconURL = readStringPointerFromMap(block, AlertingChannelSlackFieldIconURL)
+		alertingChannel.Channel = readStringPointerFromMap(block, AlertingChannelSlackFieldChannel)
+	case restapi.SplunkChannelType:
+		alertingChannel.URL = readStringPointerFromMap(block, AlertingChannelSplunkFieldURL)
+		alertingChannel.Token = readStringPointerFromMap(block, AlertingChannelSplunkFieldToken)
+	case restapi.VictorOpsChannelType:
+		alertingChannel.APIKey = readStringPointerFromMap(block, AlertingChannelVictorOpsFieldAPIKey)
+		alertingChannel.RoutingKey = readStringPointerFromMap(block, AlertingChannelVictorOpsFieldRoutingKey)
+	case restapi.WebhookChannelType:
+		alertingChannel.WebhookURLs = readStringSetFromMap(block, AlertingChannelWebhookFieldWebhookURLs)
+		headers, _ := block[AlertingChannelWebhookFieldHTTPHeaders].(map[string]interface{})
+		alertingChannel.Headers = convertHTTPHeaderMapToList(headers)
+	}
+	return alertingChannel, nil
+}
+
+func readAlertingChannelKindBlock(d *schema.ResourceData, kind restapi.AlertingChannelType) map[string]interface{} {
+	if field, ok := AlertingChannelKindFields[kind]; ok {
+		blocks := d.Get(field).([]interface{})
+		if len(blocks) == 1 {
+			if block, ok := blocks[0].(map[string]interface{}); ok {
+				return block
+			}
+		}
+	}
+	return map[string]interface{}{}
+}
+
+func readStringPointerFromMap(data map[string]interface{}, key string) *string {
+	value, _ := data[key].(string)
+	return &value
+}
+
+func readStringListFromMap(data map[string]interface{}, key string) []string {
+	result := make([]string, 0)
+	if list, ok := data[key].([]interface{}); ok {
+		for _, item := range list {
+			result = append(result, item.(string))
+		}
+	}
+	return result
+}
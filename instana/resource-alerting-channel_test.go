@@ -0,0 +1,295 @@
+package instana_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+var testAlertingChannelProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceAlertingChannelDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+  default_name_prefix = "prefix"
+  default_name_suffix = "suffix"
+}
+
+resource "instana_alerting_channel" "example" {
+  name = "name {{ITERATOR}}"
+  kind = "OPS_GENIE"
+
+  ops_genie {
+    api_key = "api key"
+    tags    = [ "tag1", "tag2" ]
+    region  = "EU"
+  }
+}
+`
+
+const alertingChannelServerResponseTemplate = `
+{
+	"id"     : "{{id}}",
+	"name"   : "prefix name {{ITERATOR}} suffix",
+	"kind"   : "OPS_GENIE",
+	"apiKey" : "api key",
+	"tags"   : "tag1, tag2",
+	"region" : "EU"
+}
+`
+
+const alertingChannelApiPath = restapi.AlertingChannelsResourcePath + "/{id}"
+const testAlertingChannelDefinition = "instana_alerting_channel.example"
+const alertingChannelOpsGenieBlockPrefix = AlertingChannelFieldOpsGenie + ".0."
+
+func TestCRUDOfAlertingChannelResourceWithMockServer(t *testing.T) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	iteration := "0"
+	httpServer.AddRoute(http.MethodPut, alertingChannelApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodDelete, alertingChannelApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, alertingChannelApiPath, func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		json := strings.ReplaceAll(strings.ReplaceAll(alertingChannelServerResponseTemplate, "{{id}}", vars["id"]), iteratorPlaceholder, iteration)
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(json))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinitionWithoutName := strings.ReplaceAll(resourceAlertingChannelDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+	resourceDefinitionWithoutName0 := strings.ReplaceAll(resourceDefinitionWithoutName, iteratorPlaceholder, "0")
+	resourceDefinitionWithoutName1 := strings.ReplaceAll(resourceDefinitionWithoutName, iteratorPlaceholder, "1")
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAlertingChannelProviders,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { iteration = "0" },
+				Config:    resourceDefinitionWithoutName0,
+				Check:     createAlertingChannelResourceTestCheckFunctions("0"),
+			},
+			{
+				PreConfig: func() { iteration = "1" },
+				Config:    resourceDefinitionWithoutName1,
+				Check:     createAlertingChannelResourceTestCheckFunctions("1"),
+			},
+		},
+	})
+}
+
+func createAlertingChannelResourceTestCheckFunctions(iteration string) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrSet(testAlertingChannelDefinition, "id"),
+		resource.TestCheckResourceAttr(testAlertingChannelDefinition, AlertingChannelFieldName, "name "+iteration),
+		resource.TestCheckResourceAttr(testAlertingChannelDefinition, AlertingChannelFieldFullName, "prefix name "+iteration+" suffix"),
+		resource.TestCheckResourceAttr(testAlertingChannelDefinition, AlertingChannelFieldKind, string(restapi.OpsGenieChannelType)),
+		resource.TestCheckResourceAttr(testAlertingChannelDefinition, AlertingChannelFieldOpsGenie+".#", "1"),
+		resource.TestCheckResourceAttr(testAlertingChannelDefinition, alertingChannelOpsGenieBlockPrefix+AlertingChannelOpsGenieFieldAPIKey, "api key"),
+		resource.TestCheckResourceAttr(testAlertingChannelDefinition, alertingChannelOpsGenieBlockPrefix+AlertingChannelOpsGenieFieldRegion, "EU"),
+		resource.TestCheckResourceAttr(testAlertingChannelDefinition, alertingChannelOpsGenieBlockPrefix+AlertingChannelOpsGenieFieldTags+".0", "tag1"),
+		resource.TestCheckResourceAttr(testAlertingChannelDefinition, alertingChannelOpsGenieBlockPrefix+AlertingChannelOpsGenieFieldTags+".1", "tag2"),
+		resource.TestCheckResourceAttr(testAlertingChannelDefinition, AlertingChannelFieldSlack+".#", "0"),
+	)
+}
+
+func TestResourceAlertingChannelDefinition(t *testing.T) {
+	schemaMap := NewAlertingChannelResourceHandle().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelFieldFullName)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(AlertingChannelFieldVerifyOnApply, false)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelFieldKind)
+	assert.True(t, schemaMap[AlertingChannelFieldKind].ForceNew)
+	assert.Len(t, schemaMap, len(AlertingChannelKindFields)+4)
+
+	for _, field := range AlertingChannelKindFields {
+		assert.Equal(t, schema.TypeList, schemaMap[field].Type, "block %s should be a list", field)
+		assert.True(t, schemaMap[field].Optional, "block %s should be optional", field)
+		assert.Equal(t, 1, schemaMap[field].MaxItems, "block %s should support only one item", field)
+		assert.Len(t, schemaMap[field].ConflictsWith, len(AlertingChannelKindFields)-1, "block %s should conflict with all other blocks", field)
+		assert.NotContains(t, schemaMap[field].ConflictsWith, field)
+	}
+}
+
+func TestShouldProvideOneNestedBlockForEachSupportedAlertingChannelKind(t *testing.T) {
+	assert.Len(t, AlertingChannelKindFields, len(restapi.SupportedAlertingChannels))
+	for _, channelType := range restapi.SupportedAlertingChannels {
+		assert.Contains(t, AlertingChannelKindFields, channelType)
+	}
+}
+
+func TestShouldReturnCorrectResourceNameForAlertingChannel(t *testing.T) {
+	name := NewAlertingChannelResourceHandle().ResourceName
+
+	assert.Equal(t, "instana_alerting_channel", name)
+}
+
+func TestAlertingChannelShouldHaveSchemaVersionZero(t *testing.T) {
+	assert.Equal(t, 0, NewAlertingChannelResourceHandle().SchemaVersion)
+}
+
+func TestShouldUpdateStateAndConvertStateBackToDataModelForAllKindsOfAlertingChannels(t *testing.T) {
+	for _, channel := range createTestAlertingChannelsOfAllKinds() {
+		t.Run(fmt.Sprintf("TestShouldUpdateStateAndConvertStateBackToDataModelForAlertingChannelOfKind%s", channel.Kind), func(t *testing.T) {
+			testHelper := NewTestHelper(t)
+			resourceHandle := NewAlertingChannelResourceHandle()
+			resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+			err := resourceHandle.UpdateState(resourceData, channel)
+
+			assert.Nil(t, err)
+			assert.Equal(t, channel.ID, resourceData.Id())
+			assert.Equal(t, channel.Name, resourceData.Get(AlertingChannelFieldFullName))
+			assert.Equal(t, string(channel.Kind), resourceData.Get(AlertingChannelFieldKind))
+			for channelType, field := range AlertingChannelKindFields {
+				if channelType == channel.Kind {
+					assert.Len(t, resourceData.Get(field), 1, "block %s should be set", field)
+				} else {
+					assert.Len(t, resourceData.Get(field), 0, "block %s should not be set", field)
+				}
+			}
+
+			model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+			assert.Nil(t, err)
+			assert.Equal(t, channel, model)
+			assert.Nil(t, model.Validate())
+		})
+	}
+}
+
+func TestShouldFailToPlanAlertingChannelWhenBlockDoesNotMatchKind(t *testing.T) {
+	testCases := map[string]struct {
+		block         string
+		expectedError string
+	}{
+		"block of other kind": {
+			block:         "email {\n emails = [ \"test@example.com\" ]\n }",
+			expectedError: "email is not supported for alerting channels of kind SLACK; only slack is allowed",
+		},
+		"missing block of kind": {
+			block:         "",
+			expectedError: "slack must be configured for alerting channels of kind SLACK",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resourceDefinition := `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:8080"
+}
+
+resource "instana_alerting_channel" "example" {
+  name = "name"
+  kind = "SLACK"
+  ` + testCase.block + `
+}
+`
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAlertingChannelProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      resourceDefinition,
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(testCase.expectedError),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestShouldConvertStateOfAlertingChannelToInvalidDataModelWhenBlockOfKindIsMissing(t *testing.T) {
+	for _, channelType := range restapi.SupportedAlertingChannels {
+		t.Run(fmt.Sprintf("TestShouldConvertStateOfAlertingChannelToInvalidDataModelWhenBlockOfKind%sIsMissing", channelType), func(t *testing.T) {
+			testHelper := NewTestHelper(t)
+			resourceHandle := NewAlertingChannelResourceHandle()
+			resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+			resourceData.SetId("id")
+			resourceData.Set(AlertingChannelFieldName, "name")
+			resourceData.Set(AlertingChannelFieldKind, string(channelType))
+
+			model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+			assert.Nil(t, err)
+			assert.Equal(t, channelType, model.(restapi.AlertingChannel).Kind)
+			assert.NotNil(t, model.Validate())
+		})
+	}
+}
+
+func TestShouldNotSendReceiverOfPrometheusWebhookAlertingChannelWhenReceiverIsNotConfigured(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewAlertingChannelResourceHandle()
+	webhookURL := "webhook url"
+	channel := restapi.AlertingChannel{ID: "id", Name: "name", Kind: restapi.PrometheusWebhookChannelType, WebhookURL: &webhookURL}
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	err := resourceHandle.UpdateState(resourceData, channel)
+	assert.Nil(t, err)
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, channel, model)
+	assert.Nil(t, model.(restapi.AlertingChannel).Receiver)
+}
+
+func TestShouldRejectEmptyReceiverOfPrometheusWebhookAlertingChannel(t *testing.T) {
+	prometheusWebhookSchema := NewAlertingChannelResourceHandle().Schema[AlertingChannelFieldPrometheusWebhook].Elem.(*schema.Resource).Schema
+	receiverSchema := prometheusWebhookSchema[AlertingChannelPrometheusWebhookFieldReceiver]
+
+	_, errs := receiverSchema.ValidateFunc("", AlertingChannelPrometheusWebhookFieldReceiver)
+	assert.Len(t, errs, 1)
+	_, errs = receiverSchema.ValidateFunc("receiver", AlertingChannelPrometheusWebhookFieldReceiver)
+	assert.Len(t, errs, 0)
+}
+
+func createTestAlertingChannelsOfAllKinds() []restapi.AlertingChannel {
+	webhookURL := "webhook url"
+	apiKey := "api key"
+	tags := "tag1,tag2"
+	region := restapi.EuOpsGenieRegion
+	serviceIntegrationKey := "service integration key"
+	receiver := "receiver"
+	iconURL := "icon url"
+	channel := "channel"
+	url := "url"
+	token := "token"
+	routingKey := "routing key"
+	return []restapi.AlertingChannel{
+		{ID: "id", Name: "name", Kind: restapi.EmailChannelType, Emails: []string{"email1"}},
+		{ID: "id", Name: "name", Kind: restapi.GoogleChatChannelType, WebhookURL: &webhookURL},
+		{ID: "id", Name: "name", Kind: restapi.Office365ChannelType, WebhookURL: &webhookURL},
+		{ID: "id", Name: "name", Kind: restapi.OpsGenieChannelType, APIKey: &apiKey, Tags: &tags, Region: &region},
+		{ID: "id", Name: "name", Kind: restapi.PagerDutyChannelType, ServiceIntegrationKey: &serviceIntegrationKey},
+		{ID: "id", Name: "name", Kind: restapi.PrometheusWebhookChannelType, WebhookURL: &webhookURL, Receiver: &receiver},
+		{ID: "id", Name: "name", Kind: restapi.SlackChannelType, WebhookURL: &webhookURL, IconURL: &iconURL, Channel: &channel},
+		{ID: "id", Name: "name", Kind: restapi.SplunkChannelType, URL: &url, Token: &token},
+		{ID: "id", Name: "name", Kind: restapi.VictorOpsChannelType, APIKey: &apiKey, RoutingKey: &routingKey},
+		{ID: "id", Name: "name", Kind: restapi.WebexTeamsWebhookChannelType, WebhookURL: &webhookURL},
+		{ID: "id", Name: "name", Kind: restapi.WebhookChannelType, WebhookURLs: []string{"url1"}, Headers: []string{"key: value"}},
+	}
+}
@@ -1,7 +1,10 @@
 package instana
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/utils"
@@ -25,8 +28,19 @@ const (
 	AlertingConfigFieldEventFilterEventTypes = "event_filter_event_types"
 	//AlertingConfigFieldEventFilterRuleIDs constant value for the schema field event_filter_rule_ids
 	AlertingConfigFieldEventFilterRuleIDs = "event_filter_rule_ids"
+	//AlertingConfigFieldCustomPayloadField constant value for the schema field custom_payload_field
+	AlertingConfigFieldCustomPayloadField = "custom_payload_field"
+	//AlertingConfigFieldCustomPayloadFieldKey constant value for the schema field key of a custom payload field
+	AlertingConfigFieldCustomPayloadFieldKey = "key"
+	//AlertingConfigFieldCustomPayloadFieldValue constant value for the schema field value of a custom payload field
+	AlertingConfigFieldCustomPayloadFieldValue = "value"
+	//AlertingConfigFieldMuteUntil constant value for the schema field mute_until
+	AlertingConfigFieldMuteUntil = "mute_until"
 )
 
+//customPayloadKeyValueSeparator the separator of key and value of a custom payload field in the custom payload sent to Instana. Each field is sent as a separate line
+const customPayloadKeyValueSeparator = ": "
+
 var supportedEventTypes = convertSupportedEventTypesToStringSlice()
 
 //AlertingConfigSchemaAlertName schema field definition of instana_alerting_config field alert_name
@@ -94,6 +108,38 @@ var AlertingConfigSchemaEventFilterRuleIDs = &schema.Schema{
 	Description:   "Configures the list of Rule IDs which should trigger an alert.",
 }
 
+//AlertingConfigSchemaCustomPayloadField schema field definition of instana_alerting_config field custom_payload_field
+var AlertingConfigSchemaCustomPayloadField = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			AlertingConfigFieldCustomPayloadFieldKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^:\s]([^:\r\n]*[^:\s])?$`), "key must not be empty, must not contain colons or line breaks and must not start or end with whitespaces"),
+				Description:  "The key of the custom payload field",
+			},
+			AlertingConfigFieldCustomPayloadFieldValue: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([^\s]([^\r\n]*[^\s])?)?$`), "value must not contain line breaks and must not start or end with whitespaces"),
+				Description:  "The static value of the custom payload field",
+			},
+		},
+	},
+	Description: "Configures the list of static key/value fields which are added as custom payload to the alerts",
+}
+
+//AlertingConfigSchemaMuteUntil schema field definition of instana_alerting_config field mute_until
+var AlertingConfigSchemaMuteUntil = &schema.Schema{
+	Type:             schema.TypeString,
+	Optional:         true,
+	ValidateFunc:     validation.ValidateRFC3339TimeString,
+	DiffSuppressFunc: suppressEqualRFC3339TimeStrings,
+	Description:      "Configures the RFC3339 timestamp until the alerting configuration is muted",
+}
+
 //NewAlertingConfigResourceHandle creates the resource handle for Alerting Configuration
 func NewAlertingConfigResourceHandle() *ResourceHandle {
 	return &ResourceHandle{
@@ -105,6 +151,8 @@ func NewAlertingConfigResourceHandle() *ResourceHandle {
 			AlertingConfigFieldEventFilterQuery:      AlertingConfigSchemaEventFilterQuery,
 			AlertingConfigFieldEventFilterEventTypes: AlertingConfigSchemaEventFilterEventTypes,
 			AlertingConfigFieldEventFilterRuleIDs:    AlertingConfigSchemaEventFilterRuleIDs,
+			AlertingConfigFieldCustomPayloadField:    AlertingConfigSchemaCustomPayloadField,
+			AlertingConfigFieldMuteUntil:             AlertingConfigSchemaMuteUntil,
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -116,6 +164,8 @@ func NewAlertingConfigResourceHandle() *ResourceHandle {
 				Version: 0,
 			},
 		},
+		NameField:            AlertingConfigFieldAlertName,
+		FullNameField:        AlertingConfigFieldFullAlertName,
 		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.AlertingConfigurations() },
 		UpdateState:          updateStateForAlertingConfig,
 		MapStateToDataObject: mapStateToDataObjectForAlertingConfig,
@@ -129,10 +179,38 @@ func updateStateForAlertingConfig(d *schema.ResourceData, obj restapi.InstanaDat
 	d.Set(AlertingConfigFieldEventFilterQuery, config.EventFilteringConfiguration.Query)
 	d.Set(AlertingConfigFieldEventFilterEventTypes, convertEventTypesToHarmonizedStringRepresentation(config.EventFilteringConfiguration.EventTypes))
 	d.Set(AlertingConfigFieldEventFilterRuleIDs, config.EventFilteringConfiguration.RuleIDs)
+	d.Set(AlertingConfigFieldCustomPayloadField, convertCustomPayloadToCustomPayloadFields(config.CustomPayload))
+	if config.MuteUntil != nil {
+		d.Set(AlertingConfigFieldMuteUntil, convertUnixMillisToRFC3339TimeString(*config.MuteUntil))
+	} else {
+		d.Set(AlertingConfigFieldMuteUntil, "")
+	}
 	d.SetId(config.ID)
 	return nil
 }
 
+func convertCustomPayloadToCustomPayloadFields(customPayload *string) []interface{} {
+	fields := make([]interface{}, 0)
+	if customPayload == nil {
+		return fields
+	}
+	for _, line := range strings.Split(*customPayload, "\n") {
+		if len(strings.TrimSpace(line)) == 0 {
+			continue
+		}
+		keyValue := strings.SplitN(line, ":", 2)
+		value := ""
+		if len(keyValue) == 2 {
+			value = strings.TrimSpace(keyValue[1])
+		}
+		fields = append(fields, map[string]interface{}{
+			AlertingConfigFieldCustomPayloadFieldKey:   strings.TrimSpace(keyValue[0]),
+			AlertingConfigFieldCustomPayloadFieldValue: value,
+		})
+	}
+	return fields
+}
+
 func convertEventTypesToHarmonizedStringRepresentation(input []restapi.AlertEventType) []string {
 	result := make([]string, len(input))
 	for i, v := range input {
@@ -145,6 +223,10 @@ func convertEventTypesToHarmonizedStringRepresentation(input []restapi.AlertEven
 func mapStateToDataObjectForAlertingConfig(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
 	name := computeFullAlertingConfigAlertNameString(d, formatter)
 	query := GetStringPointerFromResourceData(d, AlertingConfigFieldEventFilterQuery)
+	muteUntil, err := readMuteUntilFromResourceData(d)
+	if err != nil {
+		return restapi.AlertingConfiguration{}, err
+	}
 
 	return restapi.AlertingConfiguration{
 		ID:             d.Id(),
@@ -155,9 +237,38 @@ func mapStateToDataObjectForAlertingConfig(d *schema.ResourceData, formatter uti
 			RuleIDs:    ReadStringSetParameterFromResource(d, AlertingConfigFieldEventFilterRuleIDs),
 			EventTypes: readEventTypesFromResourceData(d),
 		},
+		CustomPayload: readCustomPayloadFromResourceData(d),
+		MuteUntil:     muteUntil,
 	}, nil
 }
 
+func readCustomPayloadFromResourceData(d *schema.ResourceData) *string {
+	rawFields := d.Get(AlertingConfigFieldCustomPayloadField).([]interface{})
+	if len(rawFields) == 0 {
+		return nil
+	}
+	lines := make([]string, len(rawFields))
+	for i, rawField := range rawFields {
+		field := rawField.(map[string]interface{})
+		lines[i] = fmt.Sprintf("%s%s%s", field[AlertingConfigFieldCustomPayloadFieldKey], customPayloadKeyValueSeparator, field[AlertingConfigFieldCustomPayloadFieldValue])
+	}
+	customPayload := strings.Join(lines, "\n")
+	return &customPayload
+}
+
+func readMuteUntilFromResourceData(d *schema.ResourceData) (*int64, error) {
+	value, ok := d.GetOk(AlertingConfigFieldMuteUntil)
+	if !ok {
+		return nil, nil
+	}
+	muteUntil, err := time.Parse(time.RFC3339, value.(string))
+	if err != nil {
+		return nil, err
+	}
+	millis := convertTimeToUnixMillis(muteUntil)
+	return &millis, nil
+}
+
 func readEventTypesFromResourceData(d *schema.ResourceData) []restapi.AlertEventType {
 	rawData := ReadStringSetParameterFromResource(d, AlertingConfigFieldEventFilterEventTypes)
 	result := make([]restapi.AlertEventType, len(rawData))
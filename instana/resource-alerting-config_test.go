@@ -199,6 +199,51 @@ func TestResourceAlertingConfigDefinition(t *testing.T) {
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingConfigFieldEventFilterQuery)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(AlertingConfigFieldEventFilterEventTypes)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(AlertingConfigFieldEventFilterRuleIDs)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingConfigFieldMuteUntil)
+	assert.Equal(t, schema.TypeList, schemaMap[AlertingConfigFieldCustomPayloadField].Type)
+	assert.True(t, schemaMap[AlertingConfigFieldCustomPayloadField].Optional)
+
+	customPayloadFieldSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[AlertingConfigFieldCustomPayloadField].Elem.(*schema.Resource).Schema, t)
+	customPayloadFieldSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingConfigFieldCustomPayloadFieldKey)
+	customPayloadFieldSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingConfigFieldCustomPayloadFieldValue)
+}
+
+func TestShouldRejectCustomPayloadFieldKeysContainingColonsLineBreaksOrSurroundingWhitespaces(t *testing.T) {
+	keySchema := NewAlertingConfigResourceHandle().Schema[AlertingConfigFieldCustomPayloadField].Elem.(*schema.Resource).Schema[AlertingConfigFieldCustomPayloadFieldKey]
+
+	_, errs := keySchema.ValidateFunc("team", AlertingConfigFieldCustomPayloadFieldKey)
+	assert.Len(t, errs, 0)
+	_, errs = keySchema.ValidateFunc("team:name", AlertingConfigFieldCustomPayloadFieldKey)
+	assert.Len(t, errs, 1)
+	_, errs = keySchema.ValidateFunc("team\nname", AlertingConfigFieldCustomPayloadFieldKey)
+	assert.Len(t, errs, 1)
+	_, errs = keySchema.ValidateFunc("team name", AlertingConfigFieldCustomPayloadFieldKey)
+	assert.Len(t, errs, 0)
+	_, errs = keySchema.ValidateFunc("t", AlertingConfigFieldCustomPayloadFieldKey)
+	assert.Len(t, errs, 0)
+	_, errs = keySchema.ValidateFunc(" team", AlertingConfigFieldCustomPayloadFieldKey)
+	assert.Len(t, errs, 1)
+	_, errs = keySchema.ValidateFunc("team\t", AlertingConfigFieldCustomPayloadFieldKey)
+	assert.Len(t, errs, 1)
+	_, errs = keySchema.ValidateFunc("", AlertingConfigFieldCustomPayloadFieldKey)
+	assert.Len(t, errs, 1)
+}
+
+func TestShouldRejectCustomPayloadFieldValuesContainingLineBreaksOrSurroundingWhitespaces(t *testing.T) {
+	valueSchema := NewAlertingConfigResourceHandle().Schema[AlertingConfigFieldCustomPayloadField].Elem.(*schema.Resource).Schema[AlertingConfigFieldCustomPayloadFieldValue]
+
+	_, errs := valueSchema.ValidateFunc("https://example.com:8080", AlertingConfigFieldCustomPayloadFieldValue)
+	assert.Len(t, errs, 0)
+	_, errs = valueSchema.ValidateFunc("line1\nline2", AlertingConfigFieldCustomPayloadFieldValue)
+	assert.Len(t, errs, 1)
+	_, errs = valueSchema.ValidateFunc("", AlertingConfigFieldCustomPayloadFieldValue)
+	assert.Len(t, errs, 0)
+	_, errs = valueSchema.ValidateFunc("value with spaces", AlertingConfigFieldCustomPayloadFieldValue)
+	assert.Len(t, errs, 0)
+	_, errs = valueSchema.ValidateFunc(" value", AlertingConfigFieldCustomPayloadFieldValue)
+	assert.Len(t, errs, 1)
+	_, errs = valueSchema.ValidateFunc("value ", AlertingConfigFieldCustomPayloadFieldValue)
+	assert.Len(t, errs, 1)
 }
 
 func TestShouldReturnCorrectResourceNameForAlertingConfig(t *testing.T) {
@@ -391,3 +436,106 @@ func assertSliceValuesMatchesToValues(t *testing.T, data []string, values ...str
 		assert.Contains(t, data, v)
 	}
 }
+
+func TestShouldUpdateResourceStateForAlertingConfigWithCustomPayloadAndMuteUntil(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewAlertingConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	customPayload := "team: ops\nowner: jane.doe@example.com\n\nurl: https://example.com:8080\nflag"
+	muteUntil := int64(1609459200000)
+	data := restapi.AlertingConfiguration{
+		ID:             alertingConfigID,
+		AlertName:      alertingConfigName,
+		IntegrationIDs: []string{alertingConfigIntegrationId1},
+		EventFilteringConfiguration: restapi.EventFilteringConfiguration{
+			RuleIDs: []string{alertingConfigRuleId1},
+		},
+		CustomPayload: &customPayload,
+		MuteUntil:     &muteUntil,
+	}
+
+	err := resourceHandle.UpdateState(resourceData, data)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "2021-01-01T00:00:00Z", resourceData.Get(AlertingConfigFieldMuteUntil))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{AlertingConfigFieldCustomPayloadFieldKey: "team", AlertingConfigFieldCustomPayloadFieldValue: "ops"},
+		map[string]interface{}{AlertingConfigFieldCustomPayloadFieldKey: "owner", AlertingConfigFieldCustomPayloadFieldValue: "jane.doe@example.com"},
+		map[string]interface{}{AlertingConfigFieldCustomPayloadFieldKey: "url", AlertingConfigFieldCustomPayloadFieldValue: "https://example.com:8080"},
+		map[string]interface{}{AlertingConfigFieldCustomPayloadFieldKey: "flag", AlertingConfigFieldCustomPayloadFieldValue: ""},
+	}, resourceData.Get(AlertingConfigFieldCustomPayloadField))
+}
+
+func TestShouldUpdateResourceStateForAlertingConfigWithoutCustomPayloadAndMuteUntil(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewAlertingConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	data := restapi.AlertingConfiguration{
+		ID:             alertingConfigID,
+		AlertName:      alertingConfigName,
+		IntegrationIDs: []string{alertingConfigIntegrationId1},
+		EventFilteringConfiguration: restapi.EventFilteringConfiguration{
+			RuleIDs: []string{alertingConfigRuleId1},
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, data)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "", resourceData.Get(AlertingConfigFieldMuteUntil))
+	assert.Len(t, resourceData.Get(AlertingConfigFieldCustomPayloadField), 0)
+}
+
+func TestShouldConvertStateOfAlertingConfigToDataModelWithCustomPayloadAndMuteUntil(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewAlertingConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(alertingConfigID)
+	resourceData.Set(AlertingConfigFieldAlertName, alertingConfigName)
+	resourceData.Set(AlertingConfigFieldIntegrationIds, []string{alertingConfigIntegrationId1})
+	resourceData.Set(AlertingConfigFieldEventFilterRuleIDs, []string{alertingConfigRuleId1})
+	resourceData.Set(AlertingConfigFieldMuteUntil, "2021-01-01T01:00:00+01:00")
+	resourceData.Set(AlertingConfigFieldCustomPayloadField, []interface{}{
+		map[string]interface{}{AlertingConfigFieldCustomPayloadFieldKey: "team", AlertingConfigFieldCustomPayloadFieldValue: "ops"},
+		map[string]interface{}{AlertingConfigFieldCustomPayloadFieldKey: "owner", AlertingConfigFieldCustomPayloadFieldValue: "jane.doe@example.com"},
+	})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.Nil(t, err)
+	config := model.(restapi.AlertingConfiguration)
+	assert.Equal(t, "team: ops\nowner: jane.doe@example.com", *config.CustomPayload)
+	assert.Equal(t, int64(1609459200000), *config.MuteUntil)
+}
+
+func TestShouldConvertStateOfAlertingConfigToDataModelWithoutCustomPayloadAndMuteUntil(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewAlertingConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(alertingConfigID)
+	resourceData.Set(AlertingConfigFieldAlertName, alertingConfigName)
+	resourceData.Set(AlertingConfigFieldIntegrationIds, []string{alertingConfigIntegrationId1})
+	resourceData.Set(AlertingConfigFieldEventFilterRuleIDs, []string{alertingConfigRuleId1})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.Nil(t, err)
+	config := model.(restapi.AlertingConfiguration)
+	assert.Nil(t, config.CustomPayload)
+	assert.Nil(t, config.MuteUntil)
+}
+
+func TestShouldFailToConvertStateOfAlertingConfigToDataModelWhenMuteUntilIsNotAValidTimestamp(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewAlertingConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(alertingConfigID)
+	resourceData.Set(AlertingConfigFieldAlertName, alertingConfigName)
+	resourceData.Set(AlertingConfigFieldMuteUntil, "invalid")
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.NotNil(t, err)
+}
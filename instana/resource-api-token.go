@@ -0,0 +1,98 @@
+package instana
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//ResourceInstanaAPIToken the name of the terraform-provider-instana resource to manage API tokens
+const ResourceInstanaAPIToken = "instana_api_token"
+
+//apiTokenAccessGrantingTokenLength the number of random bytes of a generated access granting token
+const apiTokenAccessGrantingTokenLength = 32
+
+const (
+	//APITokenFieldName constant value for the schema field name
+	APITokenFieldName = "name"
+	//APITokenFieldAccessGrantingToken constant value for the computed schema field access_granting_token
+	APITokenFieldAccessGrantingToken = "access_granting_token"
+)
+
+var (
+	apiTokenSchemaName = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the API token",
+	}
+	apiTokenSchemaAccessGrantingToken = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The token value which is used to access the Instana API",
+	}
+)
+
+//NewAPITokenResourceHandle creates a ResourceHandle instance for the terraform resource API token.
+//The permissions of API tokens are defined by the same fields as the permissions of user roles
+func NewAPITokenResourceHandle() *ResourceHandle {
+	return &ResourceHandle{
+		ResourceName:         ResourceInstanaAPIToken,
+		Schema:               apiTokenSchema(),
+		SchemaVersion:        0,
+		SkipIDGeneration:     true,
+		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.APITokens() },
+		UpdateState:          updateStateForAPIToken,
+		MapStateToDataObject: mapStateToDataObjectForAPIToken,
+		SetComputedFields:    setComputedFieldsForAPIToken,
+	}
+}
+
+func apiTokenSchema() map[string]*schema.Schema {
+	apiTokenSchema := newPermissionSchemas()
+	apiTokenSchema[APITokenFieldName] = apiTokenSchemaName
+	apiTokenSchema[APITokenFieldAccessGrantingToken] = apiTokenSchemaAccessGrantingToken
+	return apiTokenSchema
+}
+
+//setComputedFieldsForAPIToken sets the ID and the access granting token of a new API token. The ID is not secret and
+//only identifies the API token while the access granting token is generated from a cryptographically secure source
+func setComputedFieldsForAPIToken(d *schema.ResourceData) error {
+	accessGrantingToken, err := generateAccessGrantingToken()
+	if err != nil {
+		return err
+	}
+	d.SetId(RandomID())
+	return d.Set(APITokenFieldAccessGrantingToken, accessGrantingToken)
+}
+
+func generateAccessGrantingToken() (string, error) {
+	data := make([]byte, apiTokenAccessGrantingTokenLength)
+	if _, err := rand.Read(data); err != nil {
+		return "", fmt.Errorf("failed to generate access granting token; %s", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func updateStateForAPIToken(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
+	apiToken := obj.(restapi.APIToken)
+	d.Set(APITokenFieldName, apiToken.Name)
+	d.Set(APITokenFieldAccessGrantingToken, apiToken.AccessGrantingToken)
+	updateStateForPermissions(d, apiToken.Permissions)
+
+	d.SetId(apiToken.ID)
+	return nil
+}
+
+func mapStateToDataObjectForAPIToken(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	return restapi.APIToken{
+		ID:                  d.Id(),
+		AccessGrantingToken: d.Get(APITokenFieldAccessGrantingToken).(string),
+		Name:                d.Get(APITokenFieldName).(string),
+		Permissions:         readPermissionsFromState(d),
+	}, nil
+}
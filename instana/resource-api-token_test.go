@@ -0,0 +1,322 @@
+package instana_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+var testAPITokenProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceAPITokenDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+}
+
+resource "instana_api_token" "example" {
+  name = "name"
+  can_configure_service_mapping = true
+  can_configure_eum_applications = true
+  can_configure_mobile_app_monitoring = true
+  can_configure_users = true
+  can_install_new_agents = true
+  can_see_usage_information = true
+  can_configure_integrations = true
+  can_see_on_premise_license_information = true
+  can_configure_roles = true
+  can_configure_custom_alerts = true
+  can_configure_api_tokens = true
+  can_configure_agent_run_mode = true
+  can_view_audit_log = true
+  can_configure_objectives = true
+  can_configure_agents = true
+  can_configure_authentication_methods = true
+  can_configure_applications = true
+  can_configure_teams = true
+  can_configure_releases = true
+  can_configure_log_management = true
+  can_create_public_custom_dashboards = true
+  can_view_logs = true
+  can_view_trace_details = true
+}
+`
+
+const apiTokenServerResponseTemplate = `
+{
+	"id" : "{{id}}",
+	"accessGrantingToken" : "access-granting-token",
+	"name" : "name",
+	"canConfigureServiceMapping" : true,
+	"canConfigureEumApplications" : true,
+	"canConfigureMobileAppMonitoring" : true,
+	"canConfigureUsers" : true,
+	"canInstallNewAgents" : true,
+	"canSeeUsageInformation" : true,
+	"canConfigureIntegrations" : true,
+	"canSeeOnPremLicenseInformation" : true,
+	"canConfigureRoles" : true,
+	"canConfigureCustomAlerts" : true,
+	"canConfigureApiTokens" : true,
+	"canConfigureAgentRunMode" : true,
+	"canViewAuditLog" : true,
+	"canConfigureObjectives" : true,
+	"canConfigureAgents" : true,
+	"canConfigureAuthenticationMethods" : true,
+	"canConfigureApplications" : true,
+	"canConfigureTeams" : true,
+	"canConfigureReleases" : true,
+	"canConfigureLogManagement" : true,
+	"canCreatePublicCustomDashboards" : true,
+	"canViewLogs" : true,
+	"canViewTraceDetails" : true
+}
+`
+
+const apiTokenApiPath = restapi.APITokensResourcePath + "/{id}"
+const testAPITokenDefinition = "instana_api_token.example"
+const apiTokenID = "api-token-id"
+const apiTokenAccessGrantingToken = "api-token-access-granting-token"
+const apiTokenNameFieldValue = "name"
+
+var apiTokenPermissionFields = []string{
+	UserRoleFieldCanConfigureServiceMapping,
+	UserRoleFieldCanConfigureEumApplications,
+	UserRoleFieldCanConfigureMobileAppMonitoring,
+	UserRoleFieldCanConfigureUsers,
+	UserRoleFieldCanInstallNewAgents,
+	UserRoleFieldCanSeeUsageInformation,
+	UserRoleFieldCanConfigureIntegrations,
+	UserRoleFieldCanSeeOnPremiseLicenseInformation,
+	UserRoleFieldCanConfigureRoles,
+	UserRoleFieldCanConfigureCustomAlerts,
+	UserRoleFieldCanConfigureAPITokens,
+	UserRoleFieldCanConfigureAgentRunMode,
+	UserRoleFieldCanViewAuditLog,
+	UserRoleFieldCanConfigureObjectives,
+	UserRoleFieldCanConfigureAgents,
+	UserRoleFieldCanConfigureAuthenticationMethods,
+	UserRoleFieldCanConfigureApplications,
+	UserRoleFieldCanConfigureTeams,
+	UserRoleFieldCanConfigureReleases,
+	UserRoleFieldCanConfigureLogManagement,
+	UserRoleFieldCanCreatePublicCustomDashboards,
+	UserRoleFieldCanViewLogs,
+	UserRoleFieldCanViewTraceDetails,
+}
+
+func TestCRUDOfAPITokenResourceWithMockServer(t *testing.T) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, apiTokenApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodDelete, apiTokenApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, apiTokenApiPath, func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		json := strings.ReplaceAll(apiTokenServerResponseTemplate, "{{id}}", vars["id"])
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(json))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceAPITokenDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrSet(testAPITokenDefinition, "id"),
+		resource.TestCheckResourceAttrSet(testAPITokenDefinition, APITokenFieldAccessGrantingToken),
+		testCheckAPITokenIDDiffersFromAccessGrantingToken,
+		resource.TestCheckResourceAttr(testAPITokenDefinition, APITokenFieldName, apiTokenNameFieldValue),
+	}
+	for _, field := range apiTokenPermissionFields {
+		checks = append(checks, resource.TestCheckResourceAttr(testAPITokenDefinition, field, valueTrue))
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAPITokenProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinition,
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func testCheckAPITokenIDDiffersFromAccessGrantingToken(s *terraform.State) error {
+	attributes := s.RootModule().Resources[testAPITokenDefinition].Primary.Attributes
+	if attributes["id"] == attributes[APITokenFieldAccessGrantingToken] {
+		return fmt.Errorf("%s: id must not be equal to %s", testAPITokenDefinition, APITokenFieldAccessGrantingToken)
+	}
+	return nil
+}
+
+func TestAPITokenSchemaDefinitionIsValid(t *testing.T) {
+	schemaMap := NewAPITokenResourceHandle().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(APITokenFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(APITokenFieldAccessGrantingToken)
+	assert.True(t, schemaMap[APITokenFieldAccessGrantingToken].Sensitive)
+	for _, field := range apiTokenPermissionFields {
+		schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(field, false)
+	}
+	assert.Len(t, schemaMap, len(apiTokenPermissionFields)+2)
+}
+
+func TestAPITokenShouldShareThePermissionSchemaDefinitionsWithUserRoles(t *testing.T) {
+	apiTokenSchema := NewAPITokenResourceHandle().Schema
+	userRoleSchema := NewUserRoleResourceHandle().Schema
+
+	for _, field := range apiTokenPermissionFields {
+		assert.True(t, apiTokenSchema[field] == userRoleSchema[field], "schema of field %s should be shared", field)
+	}
+}
+
+func TestAPITokenResourceShouldHaveSchemaVersionZero(t *testing.T) {
+	assert.Equal(t, 0, NewAPITokenResourceHandle().SchemaVersion)
+}
+
+func TestShouldReturnCorrectResourceNameForAPITokenResource(t *testing.T) {
+	name := NewAPITokenResourceHandle().ResourceName
+
+	assert.Equal(t, "instana_api_token", name)
+}
+
+func TestShouldSetRandomIDAndSecureRandomAccessGrantingTokenWhenAPITokenIsCreated(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	sut := NewAPITokenResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.SetComputedFields(resourceData)
+
+	assert.Nil(t, err)
+	assert.True(t, sut.SkipIDGeneration)
+	id := resourceData.Id()
+	accessGrantingToken := resourceData.Get(APITokenFieldAccessGrantingToken).(string)
+	assert.NotEmpty(t, id)
+	assert.Len(t, accessGrantingToken, 43)
+	assert.NotEqual(t, id, accessGrantingToken)
+
+	otherResourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+	err = sut.SetComputedFields(otherResourceData)
+
+	assert.Nil(t, err)
+	assert.NotEqual(t, accessGrantingToken, otherResourceData.Get(APITokenFieldAccessGrantingToken))
+}
+
+func TestShouldUpdateBasicFieldsOfTerraformResourceStateFromModelForAPIToken(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	sut := NewAPITokenResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+	apiToken := restapi.APIToken{
+		ID:                  apiTokenID,
+		AccessGrantingToken: apiTokenAccessGrantingToken,
+		Name:                apiTokenNameFieldValue,
+	}
+
+	err := sut.UpdateState(resourceData, apiToken)
+
+	assert.Nil(t, err)
+	assert.Equal(t, apiTokenID, resourceData.Id())
+	assert.Equal(t, apiTokenAccessGrantingToken, resourceData.Get(APITokenFieldAccessGrantingToken))
+	assert.Equal(t, apiTokenNameFieldValue, resourceData.Get(APITokenFieldName))
+	for _, field := range apiTokenPermissionFields {
+		assert.False(t, resourceData.Get(field).(bool), "permission %s should not be set", field)
+	}
+}
+
+func TestShouldUpdateAllPermissionsOfTerraformResourceStateFromModelForAPIToken(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	sut := NewAPITokenResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, createAPITokenWithAllPermissions())
+
+	assert.Nil(t, err)
+	for _, field := range apiTokenPermissionFields {
+		assert.True(t, resourceData.Get(field).(bool), "permission %s should be set", field)
+	}
+}
+
+func TestShouldConvertStateOfAPITokenTerraformResourceToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewAPITokenResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(apiTokenID)
+	resourceData.Set(APITokenFieldAccessGrantingToken, apiTokenAccessGrantingToken)
+	resourceData.Set(APITokenFieldName, apiTokenNameFieldValue)
+	for _, field := range apiTokenPermissionFields {
+		resourceData.Set(field, true)
+	}
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, createAPITokenWithAllPermissions(), model)
+}
+
+func TestShouldConvertStateOfAPITokenTerraformResourceWithoutPermissionsToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewAPITokenResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(apiTokenID)
+	resourceData.Set(APITokenFieldAccessGrantingToken, apiTokenAccessGrantingToken)
+	resourceData.Set(APITokenFieldName, apiTokenNameFieldValue)
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, restapi.APIToken{ID: apiTokenID, AccessGrantingToken: apiTokenAccessGrantingToken, Name: apiTokenNameFieldValue}, model)
+}
+
+func createAPITokenWithAllPermissions() restapi.APIToken {
+	return restapi.APIToken{
+		ID:                  apiTokenID,
+		AccessGrantingToken: apiTokenAccessGrantingToken,
+		Name:                apiTokenNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureServiceMapping:        true,
+			CanConfigureEumApplications:       true,
+			CanConfigureMobileAppMonitoring:   true,
+			CanConfigureUsers:                 true,
+			CanInstallNewAgents:               true,
+			CanSeeUsageInformation:            true,
+			CanConfigureIntegrations:          true,
+			CanSeeOnPremiseLicenseInformation: true,
+			CanConfigureRoles:                 true,
+			CanConfigureCustomAlerts:          true,
+			CanConfigureAPITokens:             true,
+			CanConfigureAgentRunMode:          true,
+			CanViewAuditLog:                   true,
+			CanConfigureObjectives:            true,
+			CanConfigureAgents:                true,
+			CanConfigureAuthenticationMethods: true,
+			CanConfigureApplications:          true,
+			CanConfigureTeams:                 true,
+			CanConfigureReleases:              true,
+			CanConfigureLogManagement:         true,
+			CanCreatePublicCustomDashboards:   true,
+			CanViewLogs:                       true,
+			CanViewTraceDetails:               true,
+		},
+	}
+}
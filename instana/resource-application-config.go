@@ -82,6 +82,8 @@ func NewApplicationConfigResourceHandle() *ResourceHandle {
 				Version: 0,
 			},
 		},
+		NameField:            ApplicationConfigFieldLabel,
+		FullNameField:        ApplicationConfigFieldFullLabel,
 		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.ApplicationConfigs() },
 		UpdateState:          updateStateForApplicationConfig,
 		MapStateToDataObject: mapStateToDataObjectForApplicationConfig,
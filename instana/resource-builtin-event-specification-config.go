@@ -0,0 +1,188 @@
+package instana
+
+import (
+	"context"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//ResourceInstanaBuiltinEventSpecificationConfig the name of the terraform-provider-instana resource to manage the configuration of builtin event specifications
+const ResourceInstanaBuiltinEventSpecificationConfig = "instana_builtin_event_spec_config"
+
+const (
+	//BuiltinEventSpecificationConfigFieldEventSpecificationID constant value for the schema field event_specification_id
+	BuiltinEventSpecificationConfigFieldEventSpecificationID = "event_specification_id"
+	//BuiltinEventSpecificationConfigFieldOriginalEnabled constant value for the schema field original_enabled. The field is computed and contains the enabled flag of the builtin event specification before it was adopted by terraform
+	BuiltinEventSpecificationConfigFieldOriginalEnabled = "original_enabled"
+)
+
+//NewBuiltinEventSpecificationConfigResource creates a new TerraformResource to manage the configuration of existing builtin event specifications.
+//Builtin event specifications cannot be created or deleted. Therefore the resource adopts an existing builtin event specification and restores the original state on delete.
+//The Instana API only supports to enable and disable builtin event specifications. Triggering and severity are provided as computed fields.
+func NewBuiltinEventSpecificationConfigResource() TerraformResource {
+	return &builtinEventSpecificationConfigResource{}
+}
+
+type builtinEventSpecificationConfigResource struct{}
+
+//Create adopts the builtin event specification and applies the configured enabled flag
+func (r *builtinEventSpecificationConfigResource) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	resource := r.getRestResource(meta)
+	id := d.Get(BuiltinEventSpecificationConfigFieldEventSpecificationID).(string)
+
+	obj, err := resource.GetOne(ctx, id)
+	if err != nil {
+		return err
+	}
+	spec := obj.(restapi.BuiltinEventSpecification)
+	d.SetId(spec.ID)
+	d.Set(BuiltinEventSpecificationConfigFieldOriginalEnabled, spec.Enabled)
+	return r.applyEnabledFlag(ctx, d, resource, spec)
+}
+
+//Read reads the current state of the builtin event specification
+func (r *builtinEventSpecificationConfigResource) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	obj, err := r.getRestResource(meta).GetOne(ctx, d.Id())
+	if err != nil {
+		if err == restapi.ErrEntityNotFound {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	r.updateState(d, obj.(restapi.BuiltinEventSpecification))
+	return nil
+}
+
+//Update applies the configured enabled flag to the builtin event specification
+func (r *builtinEventSpecificationConfigResource) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	resource := r.getRestResource(meta)
+	obj, err := resource.GetOne(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	return r.applyEnabledFlag(ctx, d, resource, obj.(restapi.BuiltinEventSpecification))
+}
+
+//Delete restores the original enabled flag of the builtin event specification
+func (r *builtinEventSpecificationConfigResource) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	resource := r.getRestResource(meta)
+	obj, err := resource.GetOne(ctx, d.Id())
+	if err != nil {
+		if err == restapi.ErrEntityNotFound {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	spec := obj.(restapi.BuiltinEventSpecification)
+	originalEnabled := d.Get(BuiltinEventSpecificationConfigFieldOriginalEnabled).(bool)
+	if spec.Enabled != originalEnabled {
+		if _, err = r.toggle(ctx, resource, spec.ID, originalEnabled); err != nil {
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+//Import adopts the builtin event specification with the given ID. The current enabled flag is considered as the original state
+func (r *builtinEventSpecificationConfigResource) Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	obj, err := r.getRestResource(meta).GetOne(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	spec := obj.(restapi.BuiltinEventSpecification)
+	d.Set(BuiltinEventSpecificationConfigFieldEventSpecificationID, spec.ID)
+	d.Set(BuiltinEventSpecificationConfigFieldOriginalEnabled, spec.Enabled)
+	r.updateState(d, spec)
+	return []*schema.ResourceData{d}, nil
+}
+
+//ToSchemaResource creates the terraform schema resource for the configuration of builtin event specifications
+func (r *builtinEventSpecificationConfigResource) ToSchemaResource() *schema.Resource {
+	return &schema.Resource{
+		Create: WithOperationContext(schema.TimeoutCreate, r.Create),
+		Read:   WithOperationContext(schema.TimeoutRead, r.Read),
+		Update: WithOperationContext(schema.TimeoutUpdate, r.Update),
+		Delete: WithOperationContext(schema.TimeoutDelete, r.Delete),
+		Importer: &schema.ResourceImporter{
+			State: WithImportContext(r.Import),
+		},
+		Timeouts: newDefaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			BuiltinEventSpecificationConfigFieldEventSpecificationID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the builtin event specification which is managed by the resource",
+			},
+			BuiltinEventSpecificationFieldEnabled: {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Configures if the builtin event specification is enabled or not",
+			},
+			BuiltinEventSpecificationConfigFieldOriginalEnabled: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "The computed enabled flag of the builtin event specification before it was adopted by terraform. The flag is restored when the resource is deleted",
+			},
+			BuiltinEventSpecificationFieldName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the builtin event specification",
+			},
+			BuiltinEventSpecificationFieldShortPluginID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The plugin id for which the builtin event specification is created",
+			},
+			BuiltinEventSpecificationFieldDescription: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description text of the builtin event specification",
+			},
+			BuiltinEventSpecificationFieldSeverity: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The severity (warning or critical) of the builtin event specification. The severity cannot be changed through the Instana API",
+			},
+			BuiltinEventSpecificationFieldTriggering: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if an incident is triggered by the builtin event specification. The flag cannot be changed through the Instana API",
+			},
+		},
+	}
+}
+
+func (r *builtinEventSpecificationConfigResource) getRestResource(meta interface{}) restapi.BuiltinEventSpecificationResource {
+	providerMeta := meta.(*ProviderMeta)
+	return providerMeta.InstanaAPI.BuiltinEventSpecifications()
+}
+
+func (r *builtinEventSpecificationConfigResource) applyEnabledFlag(ctx context.Context, d *schema.ResourceData, resource restapi.BuiltinEventSpecificationResource, spec restapi.BuiltinEventSpecification) error {
+	enabled := d.Get(BuiltinEventSpecificationFieldEnabled).(bool)
+	if spec.Enabled != enabled {
+		obj, err := r.toggle(ctx, resource, spec.ID, enabled)
+		if err != nil {
+			return err
+		}
+		spec = obj.(restapi.BuiltinEventSpecification)
+	}
+	r.updateState(d, spec)
+	return nil
+}
+
+func (r *builtinEventSpecificationConfigResource) toggle(ctx context.Context, resource restapi.BuiltinEventSpecificationResource, id string, enabled bool) (restapi.InstanaDataObject, error) {
+	if enabled {
+		return resource.Enable(ctx, id)
+	}
+	return resource.Disable(ctx, id)
+}
+
+func (r *builtinEventSpecificationConfigResource) updateState(d *schema.ResourceData, spec restapi.BuiltinEventSpecification) {
+	updateStateForBuiltinEventSpecification(d, spec)
+	d.Set(BuiltinEventSpecificationConfigFieldEventSpecificationID, spec.ID)
+}
@@ -0,0 +1,331 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+var testBuiltinEventSpecificationConfigProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceBuiltinEventSpecificationConfigDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+  default_name_prefix = "prefix"
+  default_name_suffix = "suffix"
+}
+
+resource "instana_builtin_event_spec_config" "example" {
+  event_specification_id = "builtin-event-id"
+  enabled                = {{ENABLED}}
+}
+`
+
+const builtinEventSpecificationServerResponseTemplate = `
+{
+	"id"            : "builtin-event-id",
+	"shortPluginId" : "host",
+	"name"          : "System load too high",
+	"description"   : "description",
+	"severity"      : 5,
+	"triggering"    : false,
+	"enabled"       : {{ENABLED}}
+}
+`
+
+const builtinEventSpecificationConfigApiPath = restapi.BuiltinEventSpecificationResourcePath + "/{id}"
+const testBuiltinEventSpecificationConfigDefinition = "instana_builtin_event_spec_config.example"
+const builtinEventSpecificationID = "builtin-event-id"
+
+func TestCRUDOfBuiltinEventSpecificationConfigResourceWithMockServer(t *testing.T) {
+	enabled := true
+	renderResponse := func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		json := strings.ReplaceAll(builtinEventSpecificationServerResponseTemplate, "{{ENABLED}}", strconv.FormatBool(enabled))
+		json = strings.ReplaceAll(json, builtinEventSpecificationID, vars["id"])
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(json))
+	}
+
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, builtinEventSpecificationConfigApiPath, renderResponse)
+	httpServer.AddRoute(http.MethodPost, builtinEventSpecificationConfigApiPath+"/enable", func(w http.ResponseWriter, r *http.Request) {
+		enabled = true
+		renderResponse(w, r)
+	})
+	httpServer.AddRoute(http.MethodPost, builtinEventSpecificationConfigApiPath+"/disable", func(w http.ResponseWriter, r *http.Request) {
+		enabled = false
+		renderResponse(w, r)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceBuiltinEventSpecificationConfigDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+	resourceDefinitionDisabled := strings.ReplaceAll(resourceDefinition, "{{ENABLED}}", "false")
+	resourceDefinitionEnabled := strings.ReplaceAll(resourceDefinition, "{{ENABLED}}", "true")
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testBuiltinEventSpecificationConfigProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinitionDisabled,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testBuiltinEventSpecificationConfigDefinition, "id", builtinEventSpecificationID),
+					resource.TestCheckResourceAttr(testBuiltinEventSpecificationConfigDefinition, BuiltinEventSpecificationConfigFieldEventSpecificationID, builtinEventSpecificationID),
+					resource.TestCheckResourceAttr(testBuiltinEventSpecificationConfigDefinition, BuiltinEventSpecificationFieldEnabled, "false"),
+					resource.TestCheckResourceAttr(testBuiltinEventSpecificationConfigDefinition, BuiltinEventSpecificationConfigFieldOriginalEnabled, "true"),
+					resource.TestCheckResourceAttr(testBuiltinEventSpecificationConfigDefinition, BuiltinEventSpecificationFieldName, "System load too high"),
+					resource.TestCheckResourceAttr(testBuiltinEventSpecificationConfigDefinition, BuiltinEventSpecificationFieldShortPluginID, "host"),
+					resource.TestCheckResourceAttr(testBuiltinEventSpecificationConfigDefinition, BuiltinEventSpecificationFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation()),
+					resource.TestCheckResourceAttr(testBuiltinEventSpecificationConfigDefinition, BuiltinEventSpecificationFieldTriggering, "false"),
+				),
+			},
+			{
+				Config: resourceDefinitionEnabled,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testBuiltinEventSpecificationConfigDefinition, "id", builtinEventSpecificationID),
+					resource.TestCheckResourceAttr(testBuiltinEventSpecificationConfigDefinition, BuiltinEventSpecificationFieldEnabled, "true"),
+					resource.TestCheckResourceAttr(testBuiltinEventSpecificationConfigDefinition, BuiltinEventSpecificationConfigFieldOriginalEnabled, "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuiltinEventSpecificationConfigResourceShouldDefineSchema(t *testing.T) {
+	schemaMap := NewBuiltinEventSpecificationConfigResource().ToSchemaResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(BuiltinEventSpecificationConfigFieldEventSpecificationID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(BuiltinEventSpecificationFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(BuiltinEventSpecificationFieldShortPluginID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(BuiltinEventSpecificationFieldDescription)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(BuiltinEventSpecificationFieldSeverity)
+	assert.True(t, schemaMap[BuiltinEventSpecificationConfigFieldEventSpecificationID].ForceNew)
+	assert.Equal(t, schema.TypeBool, schemaMap[BuiltinEventSpecificationFieldEnabled].Type)
+	assert.True(t, schemaMap[BuiltinEventSpecificationFieldEnabled].Required)
+	assert.True(t, schemaMap[BuiltinEventSpecificationConfigFieldOriginalEnabled].Computed)
+	assert.True(t, schemaMap[BuiltinEventSpecificationFieldTriggering].Computed)
+}
+
+func TestShouldAdoptBuiltinEventSpecificationAndDisableItOnCreate(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createBuiltinEventSpecificationConfigResourceData(t, false)
+		mockResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockResource).Times(1)
+		mockResource.EXPECT().GetOne(gomock.Any(), builtinEventSpecificationID).Return(createTestBuiltinEventSpecification(true), nil).Times(1)
+		mockResource.EXPECT().Disable(gomock.Any(), builtinEventSpecificationID).Return(createTestBuiltinEventSpecification(false), nil).Times(1)
+
+		err := NewBuiltinEventSpecificationConfigResource().Create(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, builtinEventSpecificationID, resourceData.Id())
+		assert.False(t, resourceData.Get(BuiltinEventSpecificationFieldEnabled).(bool))
+		assert.True(t, resourceData.Get(BuiltinEventSpecificationConfigFieldOriginalEnabled).(bool))
+		assert.Equal(t, "host", resourceData.Get(BuiltinEventSpecificationFieldShortPluginID))
+	})
+}
+
+func TestShouldAdoptBuiltinEventSpecificationWithoutToggleOnCreateWhenEnabledFlagIsAlreadyApplied(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createBuiltinEventSpecificationConfigResourceData(t, true)
+		mockResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockResource).Times(1)
+		mockResource.EXPECT().GetOne(gomock.Any(), builtinEventSpecificationID).Return(createTestBuiltinEventSpecification(true), nil).Times(1)
+
+		err := NewBuiltinEventSpecificationConfigResource().Create(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, builtinEventSpecificationID, resourceData.Id())
+		assert.True(t, resourceData.Get(BuiltinEventSpecificationFieldEnabled).(bool))
+		assert.True(t, resourceData.Get(BuiltinEventSpecificationConfigFieldOriginalEnabled).(bool))
+	})
+}
+
+func TestShouldFailToCreateBuiltinEventSpecificationConfigWhenToggleFails(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createBuiltinEventSpecificationConfigResourceData(t, true)
+		mockResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+		expectedError := errors.New("test")
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockResource).Times(1)
+		mockResource.EXPECT().GetOne(gomock.Any(), builtinEventSpecificationID).Return(createTestBuiltinEventSpecification(false), nil).Times(1)
+		mockResource.EXPECT().Enable(gomock.Any(), builtinEventSpecificationID).Return(nil, expectedError).Times(1)
+
+		err := NewBuiltinEventSpecificationConfigResource().Create(context.Background(), resourceData, providerMeta)
+
+		assert.Equal(t, expectedError, err)
+	})
+}
+
+func TestShouldFailToCreateBuiltinEventSpecificationConfigWhenSpecificationDoesNotExist(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createBuiltinEventSpecificationConfigResourceData(t, true)
+		mockResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockResource).Times(1)
+		mockResource.EXPECT().GetOne(gomock.Any(), builtinEventSpecificationID).Return(nil, restapi.ErrEntityNotFound).Times(1)
+
+		err := NewBuiltinEventSpecificationConfigResource().Create(context.Background(), resourceData, providerMeta)
+
+		assert.Equal(t, restapi.ErrEntityNotFound, err)
+		assert.Equal(t, "", resourceData.Id())
+	})
+}
+
+func TestShouldReadBuiltinEventSpecificationConfig(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createBuiltinEventSpecificationConfigResourceData(t, true)
+		resourceData.SetId(builtinEventSpecificationID)
+		mockResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockResource).Times(1)
+		mockResource.EXPECT().GetOne(gomock.Any(), builtinEventSpecificationID).Return(createTestBuiltinEventSpecification(false), nil).Times(1)
+
+		err := NewBuiltinEventSpecificationConfigResource().Read(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.False(t, resourceData.Get(BuiltinEventSpecificationFieldEnabled).(bool))
+		assert.Equal(t, "System load too high", resourceData.Get(BuiltinEventSpecificationFieldName))
+	})
+}
+
+func TestShouldRemoveBuiltinEventSpecificationConfigFromStateOnReadWhenSpecificationDoesNotExist(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createBuiltinEventSpecificationConfigResourceData(t, true)
+		resourceData.SetId(builtinEventSpecificationID)
+		mockResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockResource).Times(1)
+		mockResource.EXPECT().GetOne(gomock.Any(), builtinEventSpecificationID).Return(nil, restapi.ErrEntityNotFound).Times(1)
+
+		err := NewBuiltinEventSpecificationConfigResource().Read(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "", resourceData.Id())
+	})
+}
+
+func TestShouldEnableBuiltinEventSpecificationOnUpdate(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createBuiltinEventSpecificationConfigResourceData(t, true)
+		resourceData.SetId(builtinEventSpecificationID)
+		mockResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockResource).Times(1)
+		mockResource.EXPECT().GetOne(gomock.Any(), builtinEventSpecificationID).Return(createTestBuiltinEventSpecification(false), nil).Times(1)
+		mockResource.EXPECT().Enable(gomock.Any(), builtinEventSpecificationID).Return(createTestBuiltinEventSpecification(true), nil).Times(1)
+
+		err := NewBuiltinEventSpecificationConfigResource().Update(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.True(t, resourceData.Get(BuiltinEventSpecificationFieldEnabled).(bool))
+	})
+}
+
+func TestShouldRestoreOriginalEnabledFlagOfBuiltinEventSpecificationOnDelete(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createBuiltinEventSpecificationConfigResourceData(t, false)
+		resourceData.SetId(builtinEventSpecificationID)
+		resourceData.Set(BuiltinEventSpecificationConfigFieldOriginalEnabled, true)
+		mockResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockResource).Times(1)
+		mockResource.EXPECT().GetOne(gomock.Any(), builtinEventSpecificationID).Return(createTestBuiltinEventSpecification(false), nil).Times(1)
+		mockResource.EXPECT().Enable(gomock.Any(), builtinEventSpecificationID).Return(createTestBuiltinEventSpecification(true), nil).Times(1)
+
+		err := NewBuiltinEventSpecificationConfigResource().Delete(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "", resourceData.Id())
+	})
+}
+
+func TestShouldNotToggleBuiltinEventSpecificationOnDeleteWhenOriginalStateIsAlreadyApplied(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createBuiltinEventSpecificationConfigResourceData(t, false)
+		resourceData.SetId(builtinEventSpecificationID)
+		resourceData.Set(BuiltinEventSpecificationConfigFieldOriginalEnabled, false)
+		mockResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockResource).Times(1)
+		mockResource.EXPECT().GetOne(gomock.Any(), builtinEventSpecificationID).Return(createTestBuiltinEventSpecification(false), nil).Times(1)
+
+		err := NewBuiltinEventSpecificationConfigResource().Delete(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "", resourceData.Id())
+	})
+}
+
+func TestShouldImportBuiltinEventSpecificationConfigAndUseCurrentStateAsOriginalState(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := schema.TestResourceDataRaw(t, NewBuiltinEventSpecificationConfigResource().ToSchemaResource().Schema, map[string]interface{}{})
+		resourceData.SetId(builtinEventSpecificationID)
+		mockResource := mocks.NewMockBuiltinEventSpecificationResource(ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Return(mockResource).Times(1)
+		mockResource.EXPECT().GetOne(gomock.Any(), builtinEventSpecificationID).Return(createTestBuiltinEventSpecification(false), nil).Times(1)
+
+		result, err := NewBuiltinEventSpecificationConfigResource().Import(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, builtinEventSpecificationID, resourceData.Get(BuiltinEventSpecificationConfigFieldEventSpecificationID))
+		assert.False(t, resourceData.Get(BuiltinEventSpecificationFieldEnabled).(bool))
+		assert.False(t, resourceData.Get(BuiltinEventSpecificationConfigFieldOriginalEnabled).(bool))
+	})
+}
+
+func createBuiltinEventSpecificationConfigResourceData(t *testing.T, enabled bool) *schema.ResourceData {
+	data := map[string]interface{}{
+		BuiltinEventSpecificationConfigFieldEventSpecificationID: builtinEventSpecificationID,
+		BuiltinEventSpecificationFieldEnabled:                    enabled,
+	}
+	return schema.TestResourceDataRaw(t, NewBuiltinEventSpecificationConfigResource().ToSchemaResource().Schema, data)
+}
+
+func createTestBuiltinEventSpecification(enabled bool) restapi.BuiltinEventSpecification {
+	description := "description"
+	return restapi.BuiltinEventSpecification{
+		ID:            builtinEventSpecificationID,
+		ShortPluginID: "host",
+		Name:          "System load too high",
+		Description:   &description,
+		Severity:      restapi.SeverityWarning.GetAPIRepresentation(),
+		Triggering:    false,
+		Enabled:       enabled,
+	}
+}
@@ -80,11 +80,13 @@ func NewCustomEventSpecificationWithEntityVerificationRuleResourceHandle() *Reso
 				Version: 2,
 			},
 		},
+		NameField:            CustomEventSpecificationFieldName,
+		FullNameField:        CustomEventSpecificationFieldFullName,
 		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.CustomEventSpecifications() },
 		UpdateState:          updateStateForCustomEventSpecificationWithEntityVerificationRule,
 		MapStateToDataObject: mapStateToDataObjectForCustomEventSpecificationWithEntityVerificationRule,
-		SetComputedFields: func(d *schema.ResourceData) {
-			d.Set(CustomEventSpecificationFieldEntityType, EntityVerificationRuleEntityType)
+		SetComputedFields: func(d *schema.ResourceData) error {
+			return d.Set(CustomEventSpecificationFieldEntityType, EntityVerificationRuleEntityType)
 		},
 	}
 }
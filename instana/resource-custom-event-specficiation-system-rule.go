@@ -48,11 +48,13 @@ func NewCustomEventSpecificationWithSystemRuleResourceHandle() *ResourceHandle {
 				Version: 1,
 			},
 		},
+		NameField:            CustomEventSpecificationFieldName,
+		FullNameField:        CustomEventSpecificationFieldFullName,
 		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.CustomEventSpecifications() },
 		UpdateState:          updateStateForCustomEventSpecificationWithSystemRule,
 		MapStateToDataObject: mapStateToDataObjectForCustomEventSpecificationWithSystemRule,
-		SetComputedFields: func(d *schema.ResourceData) {
-			d.Set(CustomEventSpecificationFieldEntityType, SystemRuleEntityType)
+		SetComputedFields: func(d *schema.ResourceData) error {
+			return d.Set(CustomEventSpecificationFieldEntityType, SystemRuleEntityType)
 		},
 	}
 }
@@ -131,6 +131,8 @@ func NewCustomEventSpecificationWithThresholdRuleResourceHandle() *ResourceHandl
 				Version: 2,
 			},
 		},
+		NameField:            CustomEventSpecificationFieldName,
+		FullNameField:        CustomEventSpecificationFieldFullName,
 		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.CustomEventSpecifications() },
 		UpdateState:          updateStateForCustomEventSpecificationWithThresholdRule,
 		MapStateToDataObject: mapStateToDataObjectForCustomEventSpecificationWithThresholdRule,
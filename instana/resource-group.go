@@ -0,0 +1,228 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//ResourceInstanaGroup the name of the terraform-provider-instana resource to manage groups
+const ResourceInstanaGroup = "instana_group"
+
+const (
+	//GroupFieldName constant value for the schema field name
+	GroupFieldName = "name"
+	//GroupFieldMember constant value for the schema field member
+	GroupFieldMember = "member"
+	//GroupFieldMemberUserID constant value for the schema field user_id of a member
+	GroupFieldMemberUserID = "user_id"
+	//GroupFieldMemberEmail constant value for the schema field email of a member
+	GroupFieldMemberEmail = "email"
+	//GroupFieldPermissionSet constant value for the schema field permission_set
+	GroupFieldPermissionSet = "permission_set"
+	//GroupFieldPermissionSetID constant value for the computed schema field id of the permission set
+	GroupFieldPermissionSetID = "id"
+	//GroupFieldPermissionSetPermissions constant value for the schema field permissions of the permission set
+	GroupFieldPermissionSetPermissions = "permissions"
+	//GroupFieldPermissionSetApplicationIDs constant value for the schema field application_ids of the permission set
+	GroupFieldPermissionSetApplicationIDs = "application_ids"
+	//GroupFieldPermissionSetKubernetesClusterUUIDs constant value for the schema field kubernetes_cluster_uuids of the permission set
+	GroupFieldPermissionSetKubernetesClusterUUIDs = "kubernetes_cluster_uuids"
+	//GroupFieldPermissionSetKubernetesNamespaceUIDs constant value for the schema field kubernetes_namespace_uids of the permission set
+	GroupFieldPermissionSetKubernetesNamespaceUIDs = "kubernetes_namespace_uids"
+	//GroupFieldPermissionSetWebsiteIDs constant value for the schema field website_ids of the permission set
+	GroupFieldPermissionSetWebsiteIDs = "website_ids"
+	//GroupFieldPermissionSetMobileAppIDs constant value for the schema field mobile_app_ids of the permission set
+	GroupFieldPermissionSetMobileAppIDs = "mobile_app_ids"
+	//GroupFieldPermissionSetInfraDFQFilter constant value for the schema field infra_dfq_filter of the permission set
+	GroupFieldPermissionSetInfraDFQFilter = "infra_dfq_filter"
+)
+
+//groupMaxPermissionSetItems the maximum number of items of each list of the permission set
+const groupMaxPermissionSetItems = 1024
+
+var (
+	//GroupSchemaName schema field definition of instana_group field name
+	GroupSchemaName = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the group",
+	}
+	//GroupSchemaMember schema field definition of instana_group field member
+	GroupSchemaMember = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: restapi.GroupMaxMembers,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				GroupFieldMemberUserID: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The id of the user which is a member of the group",
+				},
+				GroupFieldMemberEmail: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The email address of the user which is a member of the group",
+				},
+			},
+		},
+		Description: "The set of users which are members of the group",
+	}
+	//GroupSchemaPermissionSet schema field definition of instana_group field permission_set
+	GroupSchemaPermissionSet = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				GroupFieldPermissionSetID: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The id of the permission set which is assigned by Instana",
+				},
+				GroupFieldPermissionSetPermissions:             newGroupPermissionSetStringSetSchema("The permissions granted to the members of the group"),
+				GroupFieldPermissionSetApplicationIDs:          newGroupPermissionSetStringSetSchema("The ids of the applications the members of the group get access to"),
+				GroupFieldPermissionSetKubernetesClusterUUIDs:  newGroupPermissionSetStringSetSchema("The UUIDs of the kubernetes clusters the members of the group get access to"),
+				GroupFieldPermissionSetKubernetesNamespaceUIDs: newGroupPermissionSetStringSetSchema("The UIDs of the kubernetes namespaces the members of the group get access to"),
+				GroupFieldPermissionSetWebsiteIDs:              newGroupPermissionSetStringSetSchema("The ids of the websites the members of the group get access to"),
+				GroupFieldPermissionSetMobileAppIDs:            newGroupPermissionSetStringSetSchema("The ids of the mobile apps the members of the group get access to"),
+				GroupFieldPermissionSetInfraDFQFilter: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The dynamic focus query filter which restricts the infrastructure entities the members of the group get access to",
+				},
+			},
+		},
+		Description: "The permission set of the group which defines the permissions of the members and the scopes the permissions apply to",
+	}
+)
+
+func newGroupPermissionSetStringSetSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		MaxItems:    groupMaxPermissionSetItems,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: description,
+	}
+}
+
+//NewGroupResourceHandle creates a ResourceHandle instance for the terraform resource group. The ID of a group is assigned by Instana
+func NewGroupResourceHandle() *ResourceHandle {
+	return &ResourceHandle{
+		ResourceName: ResourceInstanaGroup,
+		Schema: map[string]*schema.Schema{
+			GroupFieldName:          GroupSchemaName,
+			GroupFieldMember:        GroupSchemaMember,
+			GroupFieldPermissionSet: GroupSchemaPermissionSet,
+		},
+		SchemaVersion:        0,
+		SkipIDGeneration:     true,
+		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.Groups() },
+		UpdateState:          updateStateForGroup,
+		MapStateToDataObject: mapStateToDataObjectForGroup,
+	}
+}
+
+func updateStateForGroup(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
+	group := obj.(restapi.Group)
+
+	members := make([]interface{}, len(group.Members))
+	for i, member := range group.Members {
+		members[i] = map[string]interface{}{
+			GroupFieldMemberUserID: member.UserID,
+			GroupFieldMemberEmail:  member.Email,
+		}
+	}
+
+	d.Set(GroupFieldName, group.Name)
+	d.Set(GroupFieldMember, members)
+	d.Set(GroupFieldPermissionSet, convertGroupPermissionSetToState(group.PermissionSet))
+
+	d.SetId(group.ID)
+	return nil
+}
+
+func convertGroupPermissionSetToState(permissionSet restapi.GroupPermissionSet) []interface{} {
+	if isGroupPermissionSetEmpty(permissionSet) {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			GroupFieldPermissionSetID:                      permissionSet.ID,
+			GroupFieldPermissionSetPermissions:             permissionSet.Permissions,
+			GroupFieldPermissionSetApplicationIDs:          permissionSet.ApplicationIDs,
+			GroupFieldPermissionSetKubernetesClusterUUIDs:  permissionSet.KubernetesClusterUUIDs,
+			GroupFieldPermissionSetKubernetesNamespaceUIDs: permissionSet.KubernetesNamespaceUIDs,
+			GroupFieldPermissionSetWebsiteIDs:              permissionSet.WebsiteIDs,
+			GroupFieldPermissionSetMobileAppIDs:            permissionSet.MobileAppIDs,
+			GroupFieldPermissionSetInfraDFQFilter:          derefString(permissionSet.InfraDFQFilter),
+		},
+	}
+}
+
+func isGroupPermissionSetEmpty(permissionSet restapi.GroupPermissionSet) bool {
+	return len(permissionSet.Permissions) == 0 &&
+		len(permissionSet.ApplicationIDs) == 0 &&
+		len(permissionSet.KubernetesClusterUUIDs) == 0 &&
+		len(permissionSet.KubernetesNamespaceUIDs) == 0 &&
+		len(permissionSet.WebsiteIDs) == 0 &&
+		len(permissionSet.MobileAppIDs) == 0 &&
+		derefString(permissionSet.InfraDFQFilter) == ""
+}
+
+func mapStateToDataObjectForGroup(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	rawMembers := d.Get(GroupFieldMember).(*schema.Set).List()
+	members := make([]restapi.GroupMember, len(rawMembers))
+	for i, rawMember := range rawMembers {
+		member := rawMember.(map[string]interface{})
+		members[i] = restapi.GroupMember{
+			UserID: member[GroupFieldMemberUserID].(string),
+			Email:  member[GroupFieldMemberEmail].(string),
+		}
+	}
+
+	name := d.Get(GroupFieldName).(string)
+	return restapi.Group{
+		ID:            d.Id(),
+		Name:          name,
+		Members:       members,
+		PermissionSet: readGroupPermissionSetFromState(d, name),
+	}, nil
+}
+
+//readGroupPermissionSetFromState reads the permission set of the group from the state. The permission set is named after the group as
+//Instana requires a name for each permission set. The id assigned by Instana is sent along to update the existing permission set
+func readGroupPermissionSetFromState(d *schema.ResourceData, name string) restapi.GroupPermissionSet {
+	permissionSet := map[string]interface{}{}
+	if rawPermissionSets := d.Get(GroupFieldPermissionSet).([]interface{}); len(rawPermissionSets) == 1 {
+		if value, ok := rawPermissionSets[0].(map[string]interface{}); ok {
+			permissionSet = value
+		}
+	}
+
+	return restapi.GroupPermissionSet{
+		ID:                      derefString(readOptionalStringFromMap(permissionSet, GroupFieldPermissionSetID)),
+		Name:                    name,
+		Permissions:             readStringSetFromMap(permissionSet, GroupFieldPermissionSetPermissions),
+		ApplicationIDs:          readStringSetFromMap(permissionSet, GroupFieldPermissionSetApplicationIDs),
+		KubernetesClusterUUIDs:  readStringSetFromMap(permissionSet, GroupFieldPermissionSetKubernetesClusterUUIDs),
+		KubernetesNamespaceUIDs: readStringSetFromMap(permissionSet, GroupFieldPermissionSetKubernetesNamespaceUIDs),
+		WebsiteIDs:              readStringSetFromMap(permissionSet, GroupFieldPermissionSetWebsiteIDs),
+		MobileAppIDs:            readStringSetFromMap(permissionSet, GroupFieldPermissionSetMobileAppIDs),
+		InfraDFQFilter:          readOptionalStringFromMap(permissionSet, GroupFieldPermissionSetInfraDFQFilter),
+	}
+}
+
+//readStringSetFromMap reads the set of strings with the given key from the given map. An empty slice is returned when the key is not
+//available as Instana requires the lists of the permission set to be present
+func readStringSetFromMap(data map[string]interface{}, key string) []string {
+	result := make([]string, 0)
+	if set, ok := data[key].(*schema.Set); ok {
+		for _, item := range set.List() {
+			result = append(result, item.(string))
+		}
+	}
+	return result
+}
@@ -0,0 +1,328 @@
+package instana_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+var testGroupProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceGroupDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+}
+
+resource "instana_group" "example" {
+  name = "name"
+  member {
+    user_id = "user-id-1"
+    email   = "user1@example.com"
+  }
+  member {
+    user_id = "user-id-2"
+    email   = "user2@example.com"
+  }
+  permission_set {
+    permissions      = [ "CAN_CONFIGURE_APPLICATIONS", "CAN_VIEW_LOGS" ]
+    application_ids  = [ "app-id" ]
+    infra_dfq_filter = "entity.zone:test"
+  }
+}
+`
+
+const groupServerResponse = `
+{
+	"id" : "group-id",
+	"name" : "name",
+	"members" : [
+		{ "userId" : "user-id-1", "email" : "user1@example.com" },
+		{ "userId" : "user-id-2", "email" : "user2@example.com" }
+	],
+	"permissionSet" : {
+		"id" : "permission-set-id",
+		"name" : "name",
+		"permissions" : [ "CAN_CONFIGURE_APPLICATIONS", "CAN_VIEW_LOGS" ],
+		"applicationIds" : [ "app-id" ],
+		"kubernetesClusterUUIDs" : [],
+		"kubernetesNamespaceUIDs" : [],
+		"websiteIds" : [],
+		"mobileAppIds" : [],
+		"infraDfqFilter" : "entity.zone:test"
+	}
+}
+`
+
+const groupApiPath = restapi.GroupsResourcePath + "/{id}"
+const testGroupDefinition = "instana_group.example"
+const groupID = "group-id"
+const groupNameFieldValue = "name"
+
+func TestCRUDOfGroupResourceWithMockServer(t *testing.T) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	groupResponseHandler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(groupServerResponse))
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.GroupsResourcePath, groupResponseHandler)
+	httpServer.AddRoute(http.MethodPut, groupApiPath, groupResponseHandler)
+	httpServer.AddRoute(http.MethodDelete, groupApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, groupApiPath, groupResponseHandler)
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceGroupDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+	permissionSetPrefix := GroupFieldPermissionSet + ".0."
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testGroupProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinition,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testGroupDefinition, "id", groupID),
+					resource.TestCheckResourceAttr(testGroupDefinition, GroupFieldName, groupNameFieldValue),
+					resource.TestCheckResourceAttr(testGroupDefinition, GroupFieldMember+".#", "2"),
+					resource.TestCheckResourceAttr(testGroupDefinition, GroupFieldPermissionSet+".#", "1"),
+					resource.TestCheckResourceAttr(testGroupDefinition, permissionSetPrefix+GroupFieldPermissionSetPermissions+".#", "2"),
+					resource.TestCheckResourceAttr(testGroupDefinition, permissionSetPrefix+GroupFieldPermissionSetApplicationIDs+".#", "1"),
+					resource.TestCheckResourceAttr(testGroupDefinition, permissionSetPrefix+GroupFieldPermissionSetInfraDFQFilter, "entity.zone:test"),
+					resource.TestCheckResourceAttr(testGroupDefinition, permissionSetPrefix+GroupFieldPermissionSetID, "permission-set-id"),
+				),
+			},
+		},
+	})
+}
+
+func TestShouldSendIDOfPermissionSetWhenGroupIsUpdatedWithMockServer(t *testing.T) {
+	lock := sync.Mutex{}
+	serverGroup := groupServerResponse
+	getServerGroup := func() string {
+		lock.Lock()
+		defer lock.Unlock()
+		return serverGroup
+	}
+	setServerGroup := func(group string) {
+		lock.Lock()
+		defer lock.Unlock()
+		serverGroup = group
+	}
+
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	groupResponseHandler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(getServerGroup()))
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.GroupsResourcePath, groupResponseHandler)
+	httpServer.AddRoute(http.MethodPut, groupApiPath, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		setServerGroup(string(body))
+		groupResponseHandler(w, r)
+	})
+	httpServer.AddRoute(http.MethodDelete, groupApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, groupApiPath, groupResponseHandler)
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceGroupDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+	verifyPermissionSetIDIsSent := func(s *terraform.State) error {
+		if group := getServerGroup(); !strings.Contains(group, `"id":"permission-set-id"`) {
+			return fmt.Errorf("expected id of permission set in PUT request body but got %s", group)
+		}
+		return nil
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testGroupProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinition,
+				Check:  resource.TestCheckResourceAttr(testGroupDefinition, GroupFieldPermissionSet+".0."+GroupFieldPermissionSetID, "permission-set-id"),
+			},
+			{
+				Config: strings.ReplaceAll(resourceDefinition, `name = "name"`, `name = "new-name"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testGroupDefinition, GroupFieldName, "new-name"),
+					resource.TestCheckResourceAttr(testGroupDefinition, GroupFieldPermissionSet+".0."+GroupFieldPermissionSetID, "permission-set-id"),
+					verifyPermissionSetIDIsSent,
+				),
+			},
+		},
+	})
+}
+
+func TestGroupSchemaDefinitionIsValid(t *testing.T) {
+	schemaMap := NewGroupResourceHandle().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupFieldName)
+	assert.Equal(t, schema.TypeSet, schemaMap[GroupFieldMember].Type)
+	assert.True(t, schemaMap[GroupFieldMember].Optional)
+	assert.Equal(t, schema.TypeList, schemaMap[GroupFieldPermissionSet].Type)
+	assert.True(t, schemaMap[GroupFieldPermissionSet].Optional)
+	assert.Equal(t, 1, schemaMap[GroupFieldPermissionSet].MaxItems)
+
+	memberSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[GroupFieldMember].Elem.(*schema.Resource).Schema, t)
+	memberSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupFieldMemberUserID)
+	memberSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupFieldMemberEmail)
+
+	permissionSetSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[GroupFieldPermissionSet].Elem.(*schema.Resource).Schema, t)
+	permissionSetSchemaAssert.AssertSchemaIsComputedAndOfTypeString(GroupFieldPermissionSetID)
+	permissionSetSchemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(GroupFieldPermissionSetPermissions)
+	permissionSetSchemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(GroupFieldPermissionSetApplicationIDs)
+	permissionSetSchemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(GroupFieldPermissionSetKubernetesClusterUUIDs)
+	permissionSetSchemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(GroupFieldPermissionSetKubernetesNamespaceUIDs)
+	permissionSetSchemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(GroupFieldPermissionSetWebsiteIDs)
+	permissionSetSchemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(GroupFieldPermissionSetMobileAppIDs)
+	permissionSetSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(GroupFieldPermissionSetInfraDFQFilter)
+}
+
+func TestGroupResourceShouldHaveSchemaVersionZero(t *testing.T) {
+	assert.Equal(t, 0, NewGroupResourceHandle().SchemaVersion)
+}
+
+func TestShouldReturnCorrectResourceNameForGroupResource(t *testing.T) {
+	name := NewGroupResourceHandle().ResourceName
+
+	assert.Equal(t, "instana_group", name)
+}
+
+func TestGroupResourceShouldSkipIDGenerationAsTheIDIsAssignedByInstana(t *testing.T) {
+	assert.True(t, NewGroupResourceHandle().SkipIDGeneration)
+}
+
+func TestShouldUpdateTerraformResourceStateFromModelForGroup(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	sut := NewGroupResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, createTestGroup())
+
+	assert.Nil(t, err)
+	assert.Equal(t, groupID, resourceData.Id())
+	assert.Equal(t, groupNameFieldValue, resourceData.Get(GroupFieldName))
+	assert.Equal(t, 2, resourceData.Get(GroupFieldMember).(*schema.Set).Len())
+
+	permissionSets := resourceData.Get(GroupFieldPermissionSet).([]interface{})
+	assert.Len(t, permissionSets, 1)
+	permissionSet := permissionSets[0].(map[string]interface{})
+	assert.Equal(t, "permission-set-id", permissionSet[GroupFieldPermissionSetID])
+	assert.Equal(t, 2, permissionSet[GroupFieldPermissionSetPermissions].(*schema.Set).Len())
+	assert.True(t, permissionSet[GroupFieldPermissionSetWebsiteIDs].(*schema.Set).Contains("website-id"))
+	assert.Equal(t, 0, permissionSet[GroupFieldPermissionSetMobileAppIDs].(*schema.Set).Len())
+	assert.Equal(t, "entity.zone:test", permissionSet[GroupFieldPermissionSetInfraDFQFilter])
+}
+
+func TestShouldNotSetPermissionSetInTerraformResourceStateWhenPermissionSetOfGroupIsEmpty(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	sut := NewGroupResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, restapi.Group{ID: groupID, Name: groupNameFieldValue})
+
+	assert.Nil(t, err)
+	assert.Len(t, resourceData.Get(GroupFieldPermissionSet).([]interface{}), 0)
+	assert.Equal(t, 0, resourceData.Get(GroupFieldMember).(*schema.Set).Len())
+}
+
+func TestShouldConvertStateOfGroupTerraformResourceToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewGroupResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(groupID)
+	resourceData.Set(GroupFieldName, groupNameFieldValue)
+	resourceData.Set(GroupFieldMember, []interface{}{
+		map[string]interface{}{GroupFieldMemberUserID: "user-id-1", GroupFieldMemberEmail: "user1@example.com"},
+	})
+	resourceData.Set(GroupFieldPermissionSet, []interface{}{
+		map[string]interface{}{
+			GroupFieldPermissionSetID:             "permission-set-id",
+			GroupFieldPermissionSetPermissions:    []interface{}{"CAN_VIEW_LOGS"},
+			GroupFieldPermissionSetWebsiteIDs:     []interface{}{"website-id"},
+			GroupFieldPermissionSetInfraDFQFilter: "entity.zone:test",
+		},
+	})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.Nil(t, err)
+	infraDFQFilter := "entity.zone:test"
+	assert.Equal(t, restapi.Group{
+		ID:      groupID,
+		Name:    groupNameFieldValue,
+		Members: []restapi.GroupMember{{UserID: "user-id-1", Email: "user1@example.com"}},
+		PermissionSet: restapi.GroupPermissionSet{
+			ID:                      "permission-set-id",
+			Name:                    "name",
+			Permissions:             []string{"CAN_VIEW_LOGS"},
+			ApplicationIDs:          []string{},
+			KubernetesClusterUUIDs:  []string{},
+			KubernetesNamespaceUIDs: []string{},
+			WebsiteIDs:              []string{"website-id"},
+			MobileAppIDs:            []string{},
+			InfraDFQFilter:          &infraDFQFilter,
+		},
+	}, model)
+}
+
+func TestShouldConvertStateOfGroupTerraformResourceWithoutMembersAndPermissionSetToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewGroupResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(groupID)
+	resourceData.Set(GroupFieldName, groupNameFieldValue)
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.Nil(t, err)
+	group := model.(restapi.Group)
+	assert.Equal(t, groupID, group.ID)
+	assert.Len(t, group.Members, 0)
+	assert.Equal(t, groupNameFieldValue, group.PermissionSet.Name)
+	assert.Equal(t, []string{}, group.PermissionSet.Permissions)
+	assert.Nil(t, group.PermissionSet.InfraDFQFilter)
+}
+
+func createTestGroup() restapi.Group {
+	infraDFQFilter := "entity.zone:test"
+	return restapi.Group{
+		ID:   groupID,
+		Name: groupNameFieldValue,
+		Members: []restapi.GroupMember{
+			{UserID: "user-id-1", Email: "user1@example.com"},
+			{UserID: "user-id-2", Email: "user2@example.com"},
+		},
+		PermissionSet: restapi.GroupPermissionSet{
+			ID:             "permission-set-id",
+			Permissions:    []string{"CAN_CONFIGURE_APPLICATIONS", "CAN_VIEW_LOGS"},
+			ApplicationIDs: []string{"app-id"},
+			WebsiteIDs:     []string{"website-id"},
+			InfraDFQFilter: &infraDFQFilter,
+		},
+	}
+}
@@ -0,0 +1,285 @@
+package instana
+
+import (
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+//ResourceInstanaHTTPEndpointConfig the name of the terraform-provider-instana resource to manage HTTP endpoint configs
+const ResourceInstanaHTTPEndpointConfig = "instana_http_endpoint_config"
+
+const (
+	//HTTPEndpointConfigFieldServiceID constant value for the schema field service_id
+	HTTPEndpointConfigFieldServiceID = "service_id"
+	//HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled constant value for the schema field endpoint_name_by_first_path_segment_rule_enabled
+	HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled = "endpoint_name_by_first_path_segment_rule_enabled"
+	//HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled constant value for the schema field endpoint_name_by_collected_path_template_rule_enabled
+	HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled = "endpoint_name_by_collected_path_template_rule_enabled"
+	//HTTPEndpointConfigFieldRule constant value for the schema field rule
+	HTTPEndpointConfigFieldRule = "rule"
+	//HTTPEndpointConfigFieldRuleEnabled constant value for the schema field enabled of a rule
+	HTTPEndpointConfigFieldRuleEnabled = "enabled"
+	//HTTPEndpointConfigFieldRuleTestCases constant value for the schema field test_cases of a rule
+	HTTPEndpointConfigFieldRuleTestCases = "test_cases"
+	//HTTPEndpointConfigFieldRulePathSegment constant value for the schema field path_segment of a rule
+	HTTPEndpointConfigFieldRulePathSegment = "path_segment"
+	//HTTPEndpointConfigFieldPathSegmentFixed constant value for the schema field fixed of a path segment
+	HTTPEndpointConfigFieldPathSegmentFixed = "fixed"
+	//HTTPEndpointConfigFieldPathSegmentMatchAll constant value for the schema field match_all of a path segment
+	HTTPEndpointConfigFieldPathSegmentMatchAll = "match_all"
+	//HTTPEndpointConfigFieldPathSegmentParameter constant value for the schema field parameter of a path segment
+	HTTPEndpointConfigFieldPathSegmentParameter = "parameter"
+	//HTTPEndpointConfigFieldPathSegmentUnsupported constant value for the schema field unsupported of a path segment
+	HTTPEndpointConfigFieldPathSegmentUnsupported = "unsupported"
+	//HTTPEndpointConfigFieldPathSegmentName constant value for the schema field name of a fixed or parameter path segment
+	HTTPEndpointConfigFieldPathSegmentName = "name"
+	//HTTPEndpointConfigFieldPathSegmentUnsupportedType constant value for the schema field unsupported_type of an unsupported path segment
+	HTTPEndpointConfigFieldPathSegmentUnsupportedType = "unsupported_type"
+)
+
+//httpPathSegmentFields the schema fields of the path segment blocks per path segment matching type
+var httpPathSegmentFields = map[restapi.HTTPPathSegmentMatchingType]string{
+	restapi.HTTPPathSegmentMatchingTypeFixed:       HTTPEndpointConfigFieldPathSegmentFixed,
+	restapi.HTTPPathSegmentMatchingTypeMatchAll:    HTTPEndpointConfigFieldPathSegmentMatchAll,
+	restapi.HTTPPathSegmentMatchingTypeParameter:   HTTPEndpointConfigFieldPathSegmentParameter,
+	restapi.HTTPPathSegmentMatchingTypeUnsupported: HTTPEndpointConfigFieldPathSegmentUnsupported,
+}
+
+func newHTTPPathSegmentSchemaField(description string, fields map[string]*schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 0,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+		Description: description,
+	}
+}
+
+func newHTTPPathSegmentNameSchemaField(description string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		HTTPEndpointConfigFieldPathSegmentName: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  description,
+		},
+	}
+}
+
+//HTTPEndpointConfigSchemaServiceID schema field definition of instana_http_endpoint_config field service_id
+var HTTPEndpointConfigSchemaServiceID = &schema.Schema{
+	Type:        schema.TypeString,
+	Required:    true,
+	ForceNew:    true,
+	Description: "The ID of the service for which the HTTP endpoint config is defined",
+}
+
+//HTTPEndpointConfigSchemaEndpointNameByFirstPathSegmentRuleEnabled schema field definition of instana_http_endpoint_config field endpoint_name_by_first_path_segment_rule_enabled
+var HTTPEndpointConfigSchemaEndpointNameByFirstPathSegmentRuleEnabled = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     false,
+	Description: "Configures if endpoints are named by the first path segment when no rule matches",
+}
+
+//HTTPEndpointConfigSchemaEndpointNameByCollectedPathTemplateRuleEnabled schema field definition of instana_http_endpoint_config field endpoint_name_by_collected_path_template_rule_enabled
+var HTTPEndpointConfigSchemaEndpointNameByCollectedPathTemplateRuleEnabled = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     false,
+	Description: "Configures if endpoints are named by the path template collected by the tracer when no rule matches",
+}
+
+//HTTPEndpointConfigSchemaRule schema field definition of instana_http_endpoint_config field rule
+var HTTPEndpointConfigSchemaRule = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	MaxItems: restapi.HTTPEndpointConfigMaxRules,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			HTTPEndpointConfigFieldRuleEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Configures if the rule is enabled or not",
+			},
+			HTTPEndpointConfigFieldRulePathSegment: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: restapi.HTTPEndpointRuleMaxPathSegments,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						HTTPEndpointConfigFieldPathSegmentFixed:     newHTTPPathSegmentSchemaField("Path segment matcher which matches the given fixed path segment", newHTTPPathSegmentNameSchemaField("The fixed value of the path segment")),
+						HTTPEndpointConfigFieldPathSegmentMatchAll:  newHTTPPathSegmentSchemaField("Path segment matcher which matches any number of path segments", map[string]*schema.Schema{}),
+						HTTPEndpointConfigFieldPathSegmentParameter: newHTTPPathSegmentSchemaField("Path segment matcher which matches any value of a single path segment and uses the given name as parameter name", newHTTPPathSegmentNameSchemaField("The name of the path parameter")),
+						HTTPEndpointConfigFieldPathSegmentUnsupported: newHTTPPathSegmentSchemaField("Path segment matcher of a type which is not supported by the provider", map[string]*schema.Schema{
+							HTTPEndpointConfigFieldPathSegmentUnsupportedType: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.NoZeroValues,
+								Description:  "The original type of the path segment matcher",
+							},
+						}),
+					},
+				},
+				Description: "The ordered list of path segment matchers of the rule. Exactly one of fixed, match_all, parameter or unsupported must be configured per path segment",
+			},
+			HTTPEndpointConfigFieldRuleTestCases: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: restapi.HTTPEndpointRuleMaxTestCases,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The list of test cases (paths) of the rule",
+			},
+		},
+	},
+	Description: "The ordered list of endpoint naming rules of the service",
+}
+
+//NewHTTPEndpointConfigResourceHandle creates the resource handle for HTTP Endpoint Configs
+func NewHTTPEndpointConfigResourceHandle() *ResourceHandle {
+	return &ResourceHandle{
+		ResourceName: ResourceInstanaHTTPEndpointConfig,
+		Schema: map[string]*schema.Schema{
+			HTTPEndpointConfigFieldServiceID:                                      HTTPEndpointConfigSchemaServiceID,
+			HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled:      HTTPEndpointConfigSchemaEndpointNameByFirstPathSegmentRuleEnabled,
+			HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled: HTTPEndpointConfigSchemaEndpointNameByCollectedPathTemplateRuleEnabled,
+			HTTPEndpointConfigFieldRule:                                           HTTPEndpointConfigSchemaRule,
+		},
+		SchemaVersion:        0,
+		SkipIDGeneration:     true,
+		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.HTTPEndpointConfigs() },
+		UpdateState:          updateStateForHTTPEndpointConfig,
+		MapStateToDataObject: mapStateToDataObjectForHTTPEndpointConfig,
+		CustomizeDiff:        validateHTTPPathSegmentsOfHTTPEndpointConfig,
+	}
+}
+
+//validateHTTPPathSegmentsOfHTTPEndpointConfig ensures at plan time that exactly one typed block is configured per path segment. ConflictsWith cannot be used as the path segments are nested in lists
+func validateHTTPPathSegmentsOfHTTPEndpointConfig(d *schema.ResourceDiff, meta interface{}) error {
+	for i, rawRule := range d.Get(HTTPEndpointConfigFieldRule).([]interface{}) {
+		rule, ok := rawRule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for j, rawSegment := range rule[HTTPEndpointConfigFieldRulePathSegment].([]interface{}) {
+			segment, _ := rawSegment.(map[string]interface{})
+			if _, err := readHTTPPathSegmentMatchingType(segment); err != nil {
+				return fmt.Errorf("%s.%d.%s.%d: %s", HTTPEndpointConfigFieldRule, i, HTTPEndpointConfigFieldRulePathSegment, j, err)
+			}
+		}
+	}
+	return nil
+}
+
+func updateStateForHTTPEndpointConfig(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
+	config := obj.(restapi.HTTPEndpointConfig)
+
+	rules := make([]interface{}, len(config.Rules))
+	for i, rule := range config.Rules {
+		rules[i] = map[string]interface{}{
+			HTTPEndpointConfigFieldRuleEnabled:     rule.Enabled,
+			HTTPEndpointConfigFieldRulePathSegment: convertHTTPPathSegmentsToState(rule.PathSegments),
+			HTTPEndpointConfigFieldRuleTestCases:   rule.TestCases,
+		}
+	}
+
+	d.Set(HTTPEndpointConfigFieldServiceID, config.ServiceID)
+	d.Set(HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, config.EndpointNameByFirstPathSegmentRuleEnabled)
+	d.Set(HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled, config.EndpointNameByCollectedPathTemplateRuleEnabled)
+	d.Set(HTTPEndpointConfigFieldRule, rules)
+
+	d.SetId(config.ServiceID)
+	return nil
+}
+
+func convertHTTPPathSegmentsToState(segments []restapi.HTTPPathSegmentMatchingRule) []interface{} {
+	result := make([]interface{}, len(segments))
+	for i, segment := range segments {
+		fields := make(map[string]interface{})
+		switch segment.Type {
+		case restapi.HTTPPathSegmentMatchingTypeFixed, restapi.HTTPPathSegmentMatchingTypeParameter:
+			fields[HTTPEndpointConfigFieldPathSegmentName] = derefString(segment.Name)
+		case restapi.HTTPPathSegmentMatchingTypeUnsupported:
+			fields[HTTPEndpointConfigFieldPathSegmentUnsupportedType] = derefString(segment.UnsupportedType)
+		}
+		result[i] = map[string]interface{}{
+			httpPathSegmentFields[segment.Type]: []interface{}{fields},
+		}
+	}
+	return result
+}
+
+func mapStateToDataObjectForHTTPEndpointConfig(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	rawRules := d.Get(HTTPEndpointConfigFieldRule).([]interface{})
+	rules := make([]restapi.HTTPEndpointRule, len(rawRules))
+	for i, rawRule := range rawRules {
+		rule := rawRule.(map[string]interface{})
+		pathSegments, err := readHTTPPathSegmentsFromState(rule[HTTPEndpointConfigFieldRulePathSegment].([]interface{}))
+		if err != nil {
+			return restapi.HTTPEndpointConfig{}, err
+		}
+		rules[i] = restapi.HTTPEndpointRule{
+			Enabled:      rule[HTTPEndpointConfigFieldRuleEnabled].(bool),
+			PathSegments: pathSegments,
+			TestCases:    readHTTPEndpointRuleTestCasesFromState(rule[HTTPEndpointConfigFieldRuleTestCases].([]interface{})),
+		}
+	}
+
+	return restapi.HTTPEndpointConfig{
+		ServiceID: d.Get(HTTPEndpointConfigFieldServiceID).(string),
+		EndpointNameByFirstPathSegmentRuleEnabled:      d.Get(HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled).(bool),
+		EndpointNameByCollectedPathTemplateRuleEnabled: d.Get(HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled).(bool),
+		Rules: rules,
+	}, nil
+}
+
+func readHTTPPathSegmentsFromState(rawSegments []interface{}) ([]restapi.HTTPPathSegmentMatchingRule, error) {
+	segments := make([]restapi.HTTPPathSegmentMatchingRule, len(rawSegments))
+	for i, rawSegment := range rawSegments {
+		segment, _ := rawSegment.(map[string]interface{})
+		matchingType, err := readHTTPPathSegmentMatchingType(segment)
+		if err != nil {
+			return nil, err
+		}
+		fields, _ := readSingleNestedBlock(segment, httpPathSegmentFields[matchingType])
+		segments[i] = restapi.HTTPPathSegmentMatchingRule{
+			Type:            matchingType,
+			Name:            readOptionalStringFromMap(fields, HTTPEndpointConfigFieldPathSegmentName),
+			UnsupportedType: readOptionalStringFromMap(fields, HTTPEndpointConfigFieldPathSegmentUnsupportedType),
+		}
+	}
+	return segments, nil
+}
+
+//readHTTPPathSegmentMatchingType returns the type of the path segment matcher from the configured typed block. An error is returned when not exactly one typed block is configured
+func readHTTPPathSegmentMatchingType(segment map[string]interface{}) (restapi.HTTPPathSegmentMatchingType, error) {
+	configuredTypes := make([]restapi.HTTPPathSegmentMatchingType, 0)
+	for _, matchingType := range restapi.SupportedHTTPPathSegmentMatchingTypes {
+		if blocks, ok := segment[httpPathSegmentFields[matchingType]].([]interface{}); ok && len(blocks) > 0 {
+			configuredTypes = append(configuredTypes, matchingType)
+		}
+	}
+	if len(configuredTypes) != 1 {
+		return "", fmt.Errorf("exactly one of %s, %s, %s or %s must be configured per path segment", HTTPEndpointConfigFieldPathSegmentFixed, HTTPEndpointConfigFieldPathSegmentMatchAll, HTTPEndpointConfigFieldPathSegmentParameter, HTTPEndpointConfigFieldPathSegmentUnsupported)
+	}
+	return configuredTypes[0], nil
+}
+
+func readHTTPEndpointRuleTestCasesFromState(rawTestCases []interface{}) []string {
+	testCases := make([]string, len(rawTestCases))
+	for i, testCase := range rawTestCases {
+		testCases[i] = testCase.(string)
+	}
+	return testCases
+}
@@ -0,0 +1,359 @@
+package instana_test
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+var testHTTPEndpointConfigProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceHTTPEndpointConfigDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+  default_name_prefix = "prefix"
+  default_name_suffix = "suffix"
+}
+
+resource "instana_http_endpoint_config" "example" {
+  service_id                                       = "service-id-{{ITERATOR}}"
+  endpoint_name_by_first_path_segment_rule_enabled = true
+
+  rule {
+    path_segment {
+      fixed {
+        name = "api"
+      }
+    }
+
+    path_segment {
+      parameter {
+        name = "id"
+      }
+    }
+
+    path_segment {
+      match_all {}
+    }
+
+    test_cases = ["/api/1234/details"]
+  }
+}
+`
+
+const httpEndpointConfigServerResponseTemplate = `
+{
+	"serviceId" : "{{id}}",
+	"endpointNameByFirstPathSegmentRuleEnabled" : true,
+	"endpointNameByCollectedPathTemplateRuleEnabled" : false,
+	"rules" : [
+		{
+			"enabled" : true,
+			"pathSegments" : [
+				{ "type" : "FIXED", "name" : "api" },
+				{ "type" : "PARAMETER", "name" : "id" },
+				{ "type" : "MATCH_ALL" }
+			],
+			"testCases" : [ "/api/1234/details" ]
+		}
+	]
+}
+`
+
+const httpEndpointConfigApiPath = restapi.HTTPEndpointConfigsResourcePath + "/{id}"
+const testHTTPEndpointConfigDefinition = "instana_http_endpoint_config.example"
+const httpEndpointConfigServiceID = "service-id"
+
+func TestCRUDOfHTTPEndpointConfigResourceWithMockServer(t *testing.T) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, httpEndpointConfigApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodDelete, httpEndpointConfigApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, httpEndpointConfigApiPath, func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		json := strings.ReplaceAll(httpEndpointConfigServerResponseTemplate, "{{id}}", vars["id"])
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(json))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceHTTPEndpointConfigDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+	resourceDefinition = strings.ReplaceAll(resourceDefinition, iteratorPlaceholder, "0")
+
+	ruleField := HTTPEndpointConfigFieldRule + ".0."
+	pathSegmentField := ruleField + HTTPEndpointConfigFieldRulePathSegment + "."
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testHTTPEndpointConfigProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinition,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testHTTPEndpointConfigDefinition, "id", "service-id-0"),
+					resource.TestCheckResourceAttr(testHTTPEndpointConfigDefinition, HTTPEndpointConfigFieldServiceID, "service-id-0"),
+					resource.TestCheckResourceAttr(testHTTPEndpointConfigDefinition, HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, "true"),
+					resource.TestCheckResourceAttr(testHTTPEndpointConfigDefinition, HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled, "false"),
+					resource.TestCheckResourceAttr(testHTTPEndpointConfigDefinition, ruleField+HTTPEndpointConfigFieldRuleEnabled, "true"),
+					resource.TestCheckResourceAttr(testHTTPEndpointConfigDefinition, ruleField+HTTPEndpointConfigFieldRulePathSegment+".#", "3"),
+					resource.TestCheckResourceAttr(testHTTPEndpointConfigDefinition, pathSegmentField+"0."+HTTPEndpointConfigFieldPathSegmentFixed+".0."+HTTPEndpointConfigFieldPathSegmentName, "api"),
+					resource.TestCheckResourceAttr(testHTTPEndpointConfigDefinition, pathSegmentField+"1."+HTTPEndpointConfigFieldPathSegmentParameter+".0."+HTTPEndpointConfigFieldPathSegmentName, "id"),
+					resource.TestCheckResourceAttr(testHTTPEndpointConfigDefinition, pathSegmentField+"2."+HTTPEndpointConfigFieldPathSegmentMatchAll+".#", "1"),
+					resource.TestCheckResourceAttr(testHTTPEndpointConfigDefinition, ruleField+HTTPEndpointConfigFieldRuleTestCases+".#", "1"),
+					resource.TestCheckResourceAttr(testHTTPEndpointConfigDefinition, ruleField+HTTPEndpointConfigFieldRuleTestCases+".0", "/api/1234/details"),
+				),
+			},
+		},
+	})
+}
+
+func TestHTTPEndpointConfigSchemaDefinitionIsValid(t *testing.T) {
+	schemaMap := NewHTTPEndpointConfigResourceHandle().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(HTTPEndpointConfigFieldServiceID)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled, false)
+	assert.True(t, schemaMap[HTTPEndpointConfigFieldServiceID].ForceNew)
+
+	ruleSchema := schemaMap[HTTPEndpointConfigFieldRule]
+	assert.True(t, ruleSchema.Optional)
+	assert.Equal(t, restapi.HTTPEndpointConfigMaxRules, ruleSchema.MaxItems)
+
+	ruleSchemaMap := ruleSchema.Elem.(*schema.Resource).Schema
+	ruleSchemaAssert := testutils.NewTerraformSchemaAssert(ruleSchemaMap, t)
+	ruleSchemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(HTTPEndpointConfigFieldRuleEnabled, true)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeListOfStrings(HTTPEndpointConfigFieldRuleTestCases)
+	assert.True(t, ruleSchemaMap[HTTPEndpointConfigFieldRulePathSegment].Required)
+	assert.Equal(t, 1, ruleSchemaMap[HTTPEndpointConfigFieldRulePathSegment].MinItems)
+	assert.Equal(t, restapi.HTTPEndpointRuleMaxPathSegments, ruleSchemaMap[HTTPEndpointConfigFieldRulePathSegment].MaxItems)
+
+	pathSegmentSchemaMap := ruleSchemaMap[HTTPEndpointConfigFieldRulePathSegment].Elem.(*schema.Resource).Schema
+	assert.Len(t, pathSegmentSchemaMap, 4)
+	for _, field := range []string{HTTPEndpointConfigFieldPathSegmentFixed, HTTPEndpointConfigFieldPathSegmentMatchAll, HTTPEndpointConfigFieldPathSegmentParameter, HTTPEndpointConfigFieldPathSegmentUnsupported} {
+		assert.True(t, pathSegmentSchemaMap[field].Optional)
+		assert.Equal(t, 1, pathSegmentSchemaMap[field].MaxItems)
+	}
+	testutils.NewTerraformSchemaAssert(pathSegmentSchemaMap[HTTPEndpointConfigFieldPathSegmentFixed].Elem.(*schema.Resource).Schema, t).AssertSchemaIsRequiredAndOfTypeString(HTTPEndpointConfigFieldPathSegmentName)
+	testutils.NewTerraformSchemaAssert(pathSegmentSchemaMap[HTTPEndpointConfigFieldPathSegmentParameter].Elem.(*schema.Resource).Schema, t).AssertSchemaIsRequiredAndOfTypeString(HTTPEndpointConfigFieldPathSegmentName)
+	testutils.NewTerraformSchemaAssert(pathSegmentSchemaMap[HTTPEndpointConfigFieldPathSegmentUnsupported].Elem.(*schema.Resource).Schema, t).AssertSchemaIsRequiredAndOfTypeString(HTTPEndpointConfigFieldPathSegmentUnsupportedType)
+	assert.Len(t, pathSegmentSchemaMap[HTTPEndpointConfigFieldPathSegmentMatchAll].Elem.(*schema.Resource).Schema, 0)
+}
+
+func TestShouldFailToPlanHTTPEndpointConfigWhenNotExactlyOnePathSegmentTypeIsConfigured(t *testing.T) {
+	testCases := map[string]string{
+		"no path segment type":        "",
+		"multiple path segment types": "fixed {\n name = \"api\"\n }\n match_all {}",
+	}
+
+	for name, pathSegment := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resourceDefinition := `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:8080"
+}
+
+resource "instana_http_endpoint_config" "example" {
+  service_id = "service-id"
+  rule {
+    path_segment {
+      ` + pathSegment + `
+    }
+  }
+}
+`
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testHTTPEndpointConfigProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      resourceDefinition,
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile("exactly one of fixed, match_all, parameter or unsupported must be configured"),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestShouldReturnCorrectResourceNameForHTTPEndpointConfigResource(t *testing.T) {
+	name := NewHTTPEndpointConfigResourceHandle().ResourceName
+
+	assert.Equal(t, "instana_http_endpoint_config", name)
+}
+
+func TestHTTPEndpointConfigResourceShouldHaveSchemaVersionZero(t *testing.T) {
+	assert.Equal(t, 0, NewHTTPEndpointConfigResourceHandle().SchemaVersion)
+}
+
+func TestHTTPEndpointConfigResourceShouldSkipIDGeneration(t *testing.T) {
+	assert.True(t, NewHTTPEndpointConfigResourceHandle().SkipIDGeneration)
+}
+
+func TestShouldUpdateHTTPEndpointConfigTerraformResourceStateFromModel(t *testing.T) {
+	name := "api"
+	unsupportedType := "REGEX"
+	config := restapi.HTTPEndpointConfig{
+		ServiceID: httpEndpointConfigServiceID,
+		EndpointNameByFirstPathSegmentRuleEnabled:      true,
+		EndpointNameByCollectedPathTemplateRuleEnabled: true,
+		Rules: []restapi.HTTPEndpointRule{
+			{
+				Enabled: false,
+				PathSegments: []restapi.HTTPPathSegmentMatchingRule{
+					{Type: restapi.HTTPPathSegmentMatchingTypeFixed, Name: &name},
+					{Type: restapi.HTTPPathSegmentMatchingTypeMatchAll},
+					{Type: restapi.HTTPPathSegmentMatchingTypeUnsupported, UnsupportedType: &unsupportedType},
+				},
+				TestCases: []string{"/api/foo/bar"},
+			},
+		},
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewHTTPEndpointConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config)
+
+	assert.Nil(t, err)
+	assert.Equal(t, httpEndpointConfigServiceID, resourceData.Id())
+	assert.Equal(t, httpEndpointConfigServiceID, resourceData.Get(HTTPEndpointConfigFieldServiceID))
+	assert.True(t, resourceData.Get(HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled).(bool))
+	assert.True(t, resourceData.Get(HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled).(bool))
+
+	rules := resourceData.Get(HTTPEndpointConfigFieldRule).([]interface{})
+	assert.Len(t, rules, 1)
+	rule := rules[0].(map[string]interface{})
+	assert.False(t, rule[HTTPEndpointConfigFieldRuleEnabled].(bool))
+	assert.Equal(t, []interface{}{"/api/foo/bar"}, rule[HTTPEndpointConfigFieldRuleTestCases])
+
+	segments := rule[HTTPEndpointConfigFieldRulePathSegment].([]interface{})
+	assert.Len(t, segments, 3)
+	assert.Equal(t, map[string]interface{}{
+		HTTPEndpointConfigFieldPathSegmentFixed:       []interface{}{map[string]interface{}{HTTPEndpointConfigFieldPathSegmentName: name}},
+		HTTPEndpointConfigFieldPathSegmentMatchAll:    []interface{}{},
+		HTTPEndpointConfigFieldPathSegmentParameter:   []interface{}{},
+		HTTPEndpointConfigFieldPathSegmentUnsupported: []interface{}{},
+	}, segments[0])
+	assert.Len(t, segments[1].(map[string]interface{})[HTTPEndpointConfigFieldPathSegmentMatchAll], 1)
+	assert.Len(t, segments[1].(map[string]interface{})[HTTPEndpointConfigFieldPathSegmentFixed], 0)
+	assert.Equal(t, []interface{}{map[string]interface{}{HTTPEndpointConfigFieldPathSegmentUnsupportedType: unsupportedType}}, segments[2].(map[string]interface{})[HTTPEndpointConfigFieldPathSegmentUnsupported])
+}
+
+func TestShouldUpdateHTTPEndpointConfigTerraformResourceStateFromModelWithoutRules(t *testing.T) {
+	config := restapi.HTTPEndpointConfig{ServiceID: httpEndpointConfigServiceID}
+
+	testHelper := NewTestHelper(t)
+	sut := NewHTTPEndpointConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config)
+
+	assert.Nil(t, err)
+	assert.Equal(t, httpEndpointConfigServiceID, resourceData.Id())
+	assert.False(t, resourceData.Get(HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled).(bool))
+	assert.False(t, resourceData.Get(HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled).(bool))
+	assert.Len(t, resourceData.Get(HTTPEndpointConfigFieldRule), 0)
+}
+
+func TestShouldSuccessfullyConvertHTTPEndpointConfigStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewHTTPEndpointConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(httpEndpointConfigServiceID)
+	resourceData.Set(HTTPEndpointConfigFieldServiceID, httpEndpointConfigServiceID)
+	resourceData.Set(HTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, true)
+	resourceData.Set(HTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled, false)
+	resourceData.Set(HTTPEndpointConfigFieldRule, []interface{}{
+		map[string]interface{}{
+			HTTPEndpointConfigFieldRuleEnabled: true,
+			HTTPEndpointConfigFieldRulePathSegment: []interface{}{
+				map[string]interface{}{HTTPEndpointConfigFieldPathSegmentFixed: []interface{}{map[string]interface{}{HTTPEndpointConfigFieldPathSegmentName: "api"}}},
+				map[string]interface{}{HTTPEndpointConfigFieldPathSegmentParameter: []interface{}{map[string]interface{}{HTTPEndpointConfigFieldPathSegmentName: "id"}}},
+				map[string]interface{}{HTTPEndpointConfigFieldPathSegmentMatchAll: []interface{}{map[string]interface{}{}}},
+				map[string]interface{}{HTTPEndpointConfigFieldPathSegmentUnsupported: []interface{}{map[string]interface{}{HTTPEndpointConfigFieldPathSegmentUnsupportedType: "REGEX"}}},
+			},
+			HTTPEndpointConfigFieldRuleTestCases: []interface{}{"/api/1234"},
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.Nil(t, err)
+	assert.IsType(t, restapi.HTTPEndpointConfig{}, result)
+	config := result.(restapi.HTTPEndpointConfig)
+	assert.Equal(t, httpEndpointConfigServiceID, config.GetID())
+	assert.True(t, config.EndpointNameByFirstPathSegmentRuleEnabled)
+	assert.False(t, config.EndpointNameByCollectedPathTemplateRuleEnabled)
+	assert.Len(t, config.Rules, 1)
+	assert.True(t, config.Rules[0].Enabled)
+	assert.Equal(t, []string{"/api/1234"}, config.Rules[0].TestCases)
+
+	segments := config.Rules[0].PathSegments
+	assert.Len(t, segments, 4)
+	assert.Equal(t, restapi.HTTPPathSegmentMatchingTypeFixed, segments[0].Type)
+	assert.Equal(t, "api", *segments[0].Name)
+	assert.Nil(t, segments[0].UnsupportedType)
+	assert.Equal(t, restapi.HTTPPathSegmentMatchingTypeParameter, segments[1].Type)
+	assert.Equal(t, "id", *segments[1].Name)
+	assert.Equal(t, restapi.HTTPPathSegmentMatchingTypeMatchAll, segments[2].Type)
+	assert.Nil(t, segments[2].Name)
+	assert.Nil(t, segments[2].UnsupportedType)
+	assert.Equal(t, restapi.HTTPPathSegmentMatchingTypeUnsupported, segments[3].Type)
+	assert.Nil(t, segments[3].Name)
+	assert.Equal(t, "REGEX", *segments[3].UnsupportedType)
+	assert.Nil(t, config.Validate())
+}
+
+func TestShouldFailToConvertHTTPEndpointConfigStateToDataModelWhenPathSegmentTypeIsMissing(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewHTTPEndpointConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(HTTPEndpointConfigFieldServiceID, httpEndpointConfigServiceID)
+	resourceData.Set(HTTPEndpointConfigFieldRule, []interface{}{
+		map[string]interface{}{
+			HTTPEndpointConfigFieldRulePathSegment: []interface{}{map[string]interface{}{}},
+		},
+	})
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldConvertHTTPEndpointConfigStateToDataModelWithoutRules(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewHTTPEndpointConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(HTTPEndpointConfigFieldServiceID, httpEndpointConfigServiceID)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.Nil(t, err)
+	config := result.(restapi.HTTPEndpointConfig)
+	assert.Equal(t, httpEndpointConfigServiceID, config.ServiceID)
+	assert.Len(t, config.Rules, 0)
+	assert.Nil(t, config.Validate())
+}
@@ -0,0 +1,177 @@
+package instana
+
+import (
+	"time"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+//ResourceInstanaMaintenanceWindow the name of the terraform-provider-instana resource to manage maintenance windows
+const ResourceInstanaMaintenanceWindow = "instana_maintenance_window"
+
+const (
+	//MaintenanceWindowFieldName constant value for the schema field name
+	MaintenanceWindowFieldName = "name"
+	//MaintenanceWindowFieldFullName constant value for the schema field full_name. The field is computed and contains the name which is sent to instana. The computation depends on the configured default_name_prefix and default_name_suffix at provider level
+	MaintenanceWindowFieldFullName = "full_name"
+	//MaintenanceWindowFieldQuery constant value for the schema field query
+	MaintenanceWindowFieldQuery = "query"
+	//MaintenanceWindowFieldWindow constant value for the schema field window
+	MaintenanceWindowFieldWindow = "window"
+	//MaintenanceWindowFieldWindowStart constant value for the schema field start of a window
+	MaintenanceWindowFieldWindowStart = "start"
+	//MaintenanceWindowFieldWindowEnd constant value for the schema field end of a window
+	MaintenanceWindowFieldWindowEnd = "end"
+)
+
+//MaintenanceWindowSchemaName schema field definition of instana_maintenance_window field name
+var MaintenanceWindowSchemaName = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	Description:  "Configures the name of the maintenance window",
+	ValidateFunc: validation.StringLenBetween(1, 256),
+}
+
+//MaintenanceWindowSchemaFullName schema field definition of instana_maintenance_window field full_name
+var MaintenanceWindowSchemaFullName = &schema.Schema{
+	Type:        schema.TypeString,
+	Computed:    true,
+	Description: "The computed full name of the maintenance window. The field contains the name which is sent to instana. The computation depends on the configured default_name_prefix and default_name_suffix at provider level",
+}
+
+//MaintenanceWindowSchemaQuery schema field definition of instana_maintenance_window field query
+var MaintenanceWindowSchemaQuery = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	Description:  "Configures the dynamic focus query which defines the entities affected by the maintenance window",
+	ValidateFunc: validation.StringLenBetween(1, 2048),
+}
+
+//MaintenanceWindowSchemaWindow schema field definition of instana_maintenance_window field window
+var MaintenanceWindowSchemaWindow = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	MinItems: 0,
+	MaxItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			MaintenanceWindowFieldWindowStart: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEqualRFC3339TimeStrings,
+				Description:      "Configures the start of the maintenance window as RFC3339 timestamp",
+			},
+			MaintenanceWindowFieldWindowEnd: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEqualRFC3339TimeStrings,
+				Description:      "Configures the end of the maintenance window as RFC3339 timestamp",
+			},
+		},
+	},
+	Description: "Configures the time window of the maintenance window. When no time window is configured the maintenance window is unscheduled",
+}
+
+//NewMaintenanceWindowResourceHandle creates the resource handle for Maintenance Windows
+func NewMaintenanceWindowResourceHandle() *ResourceHandle {
+	return &ResourceHandle{
+		ResourceName: ResourceInstanaMaintenanceWindow,
+		Schema: map[string]*schema.Schema{
+			MaintenanceWindowFieldName:     MaintenanceWindowSchemaName,
+			MaintenanceWindowFieldFullName: MaintenanceWindowSchemaFullName,
+			MaintenanceWindowFieldQuery:    MaintenanceWindowSchemaQuery,
+			MaintenanceWindowFieldWindow:   MaintenanceWindowSchemaWindow,
+		},
+		SchemaVersion:        0,
+		NameField:            MaintenanceWindowFieldName,
+		FullNameField:        MaintenanceWindowFieldFullName,
+		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.MaintenanceConfigurations() },
+		UpdateState:          updateStateForMaintenanceWindow,
+		MapStateToDataObject: mapStateToDataObjectForMaintenanceWindow,
+	}
+}
+
+func updateStateForMaintenanceWindow(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
+	config := obj.(restapi.MaintenanceConfiguration)
+	windows := make([]interface{}, len(config.Windows))
+	for i, w := range config.Windows {
+		windows[i] = map[string]interface{}{
+			MaintenanceWindowFieldWindowStart: convertUnixMillisToRFC3339TimeString(w.Start),
+			MaintenanceWindowFieldWindowEnd:   convertUnixMillisToRFC3339TimeString(w.End),
+		}
+	}
+
+	d.Set(MaintenanceWindowFieldFullName, config.Name)
+	d.Set(MaintenanceWindowFieldQuery, config.Query)
+	d.Set(MaintenanceWindowFieldWindow, windows)
+	d.SetId(config.ID)
+	return nil
+}
+
+func mapStateToDataObjectForMaintenanceWindow(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	windows, err := readMaintenanceWindowsFromResourceData(d)
+	if err != nil {
+		return restapi.MaintenanceConfiguration{}, err
+	}
+	return restapi.MaintenanceConfiguration{
+		ID:      d.Id(),
+		Name:    computeFullMaintenanceWindowNameString(d, formatter),
+		Query:   d.Get(MaintenanceWindowFieldQuery).(string),
+		Windows: windows,
+	}, nil
+}
+
+func readMaintenanceWindowsFromResourceData(d *schema.ResourceData) ([]restapi.MaintenanceWindow, error) {
+	rawWindows := d.Get(MaintenanceWindowFieldWindow).([]interface{})
+	windows := make([]restapi.MaintenanceWindow, len(rawWindows))
+	for i, rawWindow := range rawWindows {
+		window := rawWindow.(map[string]interface{})
+		start, err := time.Parse(time.RFC3339, window[MaintenanceWindowFieldWindowStart].(string))
+		if err != nil {
+			return nil, err
+		}
+		end, err := time.Parse(time.RFC3339, window[MaintenanceWindowFieldWindowEnd].(string))
+		if err != nil {
+			return nil, err
+		}
+		//The API supports at most one window per maintenance configuration. Therefore, the ID of the maintenance configuration is reused as window ID
+		windows[i] = restapi.MaintenanceWindow{
+			ID:    d.Id(),
+			Start: convertTimeToUnixMillis(start),
+			End:   convertTimeToUnixMillis(end),
+		}
+	}
+	return windows, nil
+}
+
+func computeFullMaintenanceWindowNameString(d *schema.ResourceData, formatter utils.ResourceNameFormatter) string {
+	if d.HasChange(MaintenanceWindowFieldName) {
+		return formatter.Format(d.Get(MaintenanceWindowFieldName).(string))
+	}
+	return d.Get(MaintenanceWindowFieldFullName).(string)
+}
+
+func convertTimeToUnixMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func convertUnixMillisToRFC3339TimeString(millis int64) string {
+	return time.Unix(0, millis*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+}
+
+func suppressEqualRFC3339TimeStrings(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
@@ -0,0 +1,233 @@
+package instana_test
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+var testMaintenanceWindowProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceMaintenanceWindowDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+  default_name_prefix = "prefix"
+  default_name_suffix = "suffix"
+}
+
+resource "instana_maintenance_window" "example" {
+  name  = "name {{ITERATOR}}"
+  query = "entity.zone:\"test\""
+  window {
+    start = "{{START}}"
+    end   = "2020-01-01T12:00:00Z"
+  }
+}
+`
+
+const maintenanceWindowServerResponseTemplate = `
+{
+	"id"      : "{{id}}",
+	"name"    : "prefix name 0 suffix",
+	"query"   : "entity.zone:\"test\"",
+	"windows" : [ { "id" : "window-id", "start" : 1577872800000, "end" : 1577880000000 } ]
+}
+`
+
+const maintenanceWindowApiPath = restapi.MaintenanceConfigurationsResourcePath + "/{id}"
+const testMaintenanceWindowDefinition = "instana_maintenance_window.example"
+const maintenanceWindowID = "maintenance-window-id"
+const maintenanceWindowQuery = "entity.zone:\"test\""
+const maintenanceWindowStart = "2020-01-01T10:00:00Z"
+const maintenanceWindowEnd = "2020-01-01T12:00:00Z"
+const maintenanceWindowStartMillis = int64(1577872800000)
+const maintenanceWindowEndMillis = int64(1577880000000)
+
+func TestCRUDOfMaintenanceWindowResourceWithMockServer(t *testing.T) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, maintenanceWindowApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodDelete, maintenanceWindowApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, maintenanceWindowApiPath, func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		json := strings.ReplaceAll(maintenanceWindowServerResponseTemplate, "{{id}}", vars["id"])
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(json))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceMaintenanceWindowDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+	resourceDefinition = strings.ReplaceAll(resourceDefinition, iteratorPlaceholder, "0")
+	resourceDefinitionWithUTCStart := strings.ReplaceAll(resourceDefinition, "{{START}}", maintenanceWindowStart)
+	resourceDefinitionWithOffsetStart := strings.ReplaceAll(resourceDefinition, "{{START}}", "2020-01-01T12:00:00+02:00")
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testMaintenanceWindowProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinitionWithUTCStart,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testMaintenanceWindowDefinition, "id"),
+					resource.TestCheckResourceAttr(testMaintenanceWindowDefinition, MaintenanceWindowFieldName, "name 0"),
+					resource.TestCheckResourceAttr(testMaintenanceWindowDefinition, MaintenanceWindowFieldFullName, "prefix name 0 suffix"),
+					resource.TestCheckResourceAttr(testMaintenanceWindowDefinition, MaintenanceWindowFieldQuery, maintenanceWindowQuery),
+					resource.TestCheckResourceAttr(testMaintenanceWindowDefinition, MaintenanceWindowFieldWindow+".0."+MaintenanceWindowFieldWindowStart, maintenanceWindowStart),
+					resource.TestCheckResourceAttr(testMaintenanceWindowDefinition, MaintenanceWindowFieldWindow+".0."+MaintenanceWindowFieldWindowEnd, maintenanceWindowEnd),
+				),
+			},
+			{
+				Config: resourceDefinitionWithOffsetStart,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testMaintenanceWindowDefinition, "id"),
+					resource.TestCheckResourceAttr(testMaintenanceWindowDefinition, MaintenanceWindowFieldWindow+".0."+MaintenanceWindowFieldWindowStart, maintenanceWindowStart),
+				),
+			},
+		},
+	})
+}
+
+func TestMaintenanceWindowSchemaDefinitionIsValid(t *testing.T) {
+	schema := NewMaintenanceWindowResourceHandle().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MaintenanceWindowFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(MaintenanceWindowFieldFullName)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MaintenanceWindowFieldQuery)
+	assert.True(t, schema[MaintenanceWindowFieldWindow].Optional)
+	assert.Equal(t, 1, schema[MaintenanceWindowFieldWindow].MaxItems)
+}
+
+func TestShouldAcceptDynamicFocusQueryAsMaintenanceWindowQuery(t *testing.T) {
+	warns, errs := MaintenanceWindowSchemaQuery.ValidateFunc("entity.zone:\"production\" AND entity.type:host", MaintenanceWindowFieldQuery)
+
+	assert.Empty(t, warns)
+	assert.Empty(t, errs)
+}
+
+func TestShouldReturnCorrectResourceNameForMaintenanceWindowResource(t *testing.T) {
+	name := NewMaintenanceWindowResourceHandle().ResourceName
+
+	assert.Equal(t, "instana_maintenance_window", name)
+}
+
+func TestMaintenanceWindowResourceShouldHaveSchemaVersionZero(t *testing.T) {
+	assert.Equal(t, 0, NewMaintenanceWindowResourceHandle().SchemaVersion)
+}
+
+func TestShouldUpdateMaintenanceWindowTerraformResourceStateFromModel(t *testing.T) {
+	name := "name"
+	config := restapi.MaintenanceConfiguration{
+		ID:      maintenanceWindowID,
+		Name:    name,
+		Query:   maintenanceWindowQuery,
+		Windows: []restapi.MaintenanceWindow{{ID: "window-id", Start: maintenanceWindowStartMillis, End: maintenanceWindowEndMillis}},
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewMaintenanceWindowResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config)
+
+	assert.Nil(t, err)
+	assert.Equal(t, maintenanceWindowID, resourceData.Id())
+	assert.Equal(t, name, resourceData.Get(MaintenanceWindowFieldFullName))
+	assert.Equal(t, maintenanceWindowQuery, resourceData.Get(MaintenanceWindowFieldQuery))
+	assert.Equal(t, maintenanceWindowStart, resourceData.Get(MaintenanceWindowFieldWindow+".0."+MaintenanceWindowFieldWindowStart))
+	assert.Equal(t, maintenanceWindowEnd, resourceData.Get(MaintenanceWindowFieldWindow+".0."+MaintenanceWindowFieldWindowEnd))
+}
+
+func TestShouldUpdateMaintenanceWindowTerraformResourceStateFromModelWithoutWindow(t *testing.T) {
+	config := restapi.MaintenanceConfiguration{
+		ID:    maintenanceWindowID,
+		Name:  "name",
+		Query: maintenanceWindowQuery,
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewMaintenanceWindowResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config)
+
+	assert.Nil(t, err)
+	assert.Len(t, resourceData.Get(MaintenanceWindowFieldWindow), 0)
+}
+
+func TestShouldSuccessfullyConvertMaintenanceWindowStateToDataModel(t *testing.T) {
+	name := "name"
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(maintenanceWindowID)
+	resourceData.Set(MaintenanceWindowFieldFullName, name)
+	resourceData.Set(MaintenanceWindowFieldQuery, maintenanceWindowQuery)
+	resourceData.Set(MaintenanceWindowFieldWindow, []interface{}{
+		map[string]interface{}{
+			MaintenanceWindowFieldWindowStart: "2020-01-01T12:00:00+02:00",
+			MaintenanceWindowFieldWindowEnd:   maintenanceWindowEnd,
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.Nil(t, err)
+	assert.IsType(t, restapi.MaintenanceConfiguration{}, result)
+	config := result.(restapi.MaintenanceConfiguration)
+	assert.Equal(t, maintenanceWindowID, config.ID)
+	assert.Equal(t, name, config.Name)
+	assert.Equal(t, maintenanceWindowQuery, config.Query)
+	assert.Equal(t, []restapi.MaintenanceWindow{{ID: maintenanceWindowID, Start: maintenanceWindowStartMillis, End: maintenanceWindowEndMillis}}, config.Windows)
+}
+
+func TestShouldSuccessfullyConvertMaintenanceWindowStateWithoutWindowToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(maintenanceWindowID)
+	resourceData.Set(MaintenanceWindowFieldFullName, "name")
+	resourceData.Set(MaintenanceWindowFieldQuery, maintenanceWindowQuery)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.Nil(t, err)
+	assert.Len(t, result.(restapi.MaintenanceConfiguration).Windows, 0)
+}
+
+func TestShouldFailToConvertMaintenanceWindowStateToDataModelWhenTimestampIsNotValid(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(maintenanceWindowID)
+	resourceData.Set(MaintenanceWindowFieldFullName, "name")
+	resourceData.Set(MaintenanceWindowFieldQuery, maintenanceWindowQuery)
+	resourceData.Set(MaintenanceWindowFieldWindow, []interface{}{
+		map[string]interface{}{
+			MaintenanceWindowFieldWindowStart: "INVALID",
+			MaintenanceWindowFieldWindowEnd:   maintenanceWindowEnd,
+		},
+	})
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,166 @@
+package instana
+
+import (
+	"time"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+//ResourceInstanaRelease the name of the terraform-provider-instana resource to manage releases
+const ResourceInstanaRelease = "instana_release"
+
+const (
+	//ReleaseFieldName constant value for the schema field name
+	ReleaseFieldName = "name"
+	//ReleaseFieldStart constant value for the schema field start
+	ReleaseFieldStart = "start"
+	//ReleaseFieldApplications constant value for the schema field applications
+	ReleaseFieldApplications = "applications"
+	//ReleaseFieldService constant value for the schema field service
+	ReleaseFieldService = "service"
+	//ReleaseFieldServiceName constant value for the schema field name of a service
+	ReleaseFieldServiceName = "name"
+	//ReleaseFieldServiceApplications constant value for the schema field applications of a service
+	ReleaseFieldServiceApplications = "applications"
+)
+
+var (
+	//ReleaseSchemaName schema field definition of instana_release field name
+	ReleaseSchemaName = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 256),
+		Description:  "The name of the release",
+	}
+	//ReleaseSchemaStart schema field definition of instana_release field start
+	ReleaseSchemaStart = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ValidateFunc:     validation.ValidateRFC3339TimeString,
+		DiffSuppressFunc: suppressEqualRFC3339TimeStrings,
+		Description:      "The start of the release as RFC3339 timestamp. When not configured the time of the creation of the resource is used",
+	}
+	//ReleaseSchemaApplications schema field definition of instana_release field applications
+	ReleaseSchemaApplications = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The names of the applications the release is scoped to",
+	}
+	//ReleaseSchemaService schema field definition of instana_release field service
+	ReleaseSchemaService = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ReleaseFieldServiceName: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the service the release is scoped to",
+				},
+				ReleaseFieldServiceApplications: {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The names of the applications the service is restricted to",
+				},
+			},
+		},
+		Description: "The services the release is scoped to",
+	}
+)
+
+//NewReleaseResourceHandle creates a ResourceHandle instance for the terraform resource release. The ID of a release is assigned by Instana.
+//A release without applications and services is a global release
+func NewReleaseResourceHandle() *ResourceHandle {
+	return &ResourceHandle{
+		ResourceName: ResourceInstanaRelease,
+		Schema: map[string]*schema.Schema{
+			ReleaseFieldName:         ReleaseSchemaName,
+			ReleaseFieldStart:        ReleaseSchemaStart,
+			ReleaseFieldApplications: ReleaseSchemaApplications,
+			ReleaseFieldService:      ReleaseSchemaService,
+		},
+		SchemaVersion:        0,
+		SkipIDGeneration:     true,
+		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.Releases() },
+		UpdateState:          updateStateForRelease,
+		MapStateToDataObject: mapStateToDataObjectForRelease,
+		SetComputedFields: func(d *schema.ResourceData) error {
+			if start, ok := d.GetOk(ReleaseFieldStart); !ok || start.(string) == "" {
+				return d.Set(ReleaseFieldStart, time.Now().UTC().Format(time.RFC3339))
+			}
+			return nil
+		},
+	}
+}
+
+func updateStateForRelease(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
+	release := obj.(restapi.Release)
+
+	applications := convertReleaseApplicationsToNames(release.Applications)
+	services := make([]interface{}, len(release.Services))
+	for i, service := range release.Services {
+		serviceApplications := make([]string, 0)
+		if service.ScopedTo != nil {
+			serviceApplications = convertReleaseApplicationsToNames(service.ScopedTo.Applications)
+		}
+		services[i] = map[string]interface{}{
+			ReleaseFieldServiceName:         service.Name,
+			ReleaseFieldServiceApplications: serviceApplications,
+		}
+	}
+
+	d.Set(ReleaseFieldName, release.Name)
+	d.Set(ReleaseFieldStart, convertUnixMillisToRFC3339TimeString(release.Start))
+	d.Set(ReleaseFieldApplications, applications)
+	d.Set(ReleaseFieldService, services)
+
+	d.SetId(release.ID)
+	return nil
+}
+
+func convertReleaseApplicationsToNames(applications []restapi.ReleaseApplication) []string {
+	names := make([]string, len(applications))
+	for i, application := range applications {
+		names[i] = application.Name
+	}
+	return names
+}
+
+func mapStateToDataObjectForRelease(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	start, err := time.Parse(time.RFC3339, d.Get(ReleaseFieldStart).(string))
+	if err != nil {
+		return restapi.Release{}, err
+	}
+
+	rawServices := d.Get(ReleaseFieldService).([]interface{})
+	services := make([]restapi.ReleaseService, len(rawServices))
+	for i, rawService := range rawServices {
+		service := rawService.(map[string]interface{})
+		services[i] = restapi.ReleaseService{Name: service[ReleaseFieldServiceName].(string)}
+		if applications := readStringSetFromMap(service, ReleaseFieldServiceApplications); len(applications) > 0 {
+			services[i].ScopedTo = &restapi.ReleaseServiceScope{Applications: convertNamesToReleaseApplications(applications)}
+		}
+	}
+
+	return restapi.Release{
+		ID:           d.Id(),
+		Name:         d.Get(ReleaseFieldName).(string),
+		Start:        convertTimeToUnixMillis(start),
+		Applications: convertNamesToReleaseApplications(ReadStringSetParameterFromResource(d, ReleaseFieldApplications)),
+		Services:     services,
+	}, nil
+}
+
+func convertNamesToReleaseApplications(names []string) []restapi.ReleaseApplication {
+	applications := make([]restapi.ReleaseApplication, len(names))
+	for i, name := range names {
+		applications[i] = restapi.ReleaseApplication{Name: name}
+	}
+	return applications
+}
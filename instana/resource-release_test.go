@@ -0,0 +1,222 @@
+package instana_test
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+var testReleaseProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceReleaseDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+}
+
+resource "instana_release" "example" {
+  name         = "name"
+  start        = "2021-01-01T00:00:00Z"
+  applications = [ "app" ]
+  service {
+    name         = "service"
+    applications = [ "app" ]
+  }
+}
+`
+
+const releaseServerResponse = `
+{
+	"id" : "release-id",
+	"name" : "name",
+	"start" : 1609459200000,
+	"lastUpdated" : 1609459200000,
+	"applications" : [ { "name" : "app" } ],
+	"services" : [ { "name" : "service", "scopedTo" : { "applications" : [ { "name" : "app" } ] } } ]
+}
+`
+
+const releaseApiPath = restapi.ReleasesResourcePath + "/{id}"
+const testReleaseDefinition = "instana_release.example"
+const releaseID = "release-id"
+const releaseNameFieldValue = "name"
+const releaseStartFieldValue = "2021-01-01T00:00:00Z"
+const releaseStartMillis = int64(1609459200000)
+
+func TestCRUDOfReleaseResourceWithMockServer(t *testing.T) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	releaseResponseHandler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(releaseServerResponse))
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.ReleasesResourcePath, releaseResponseHandler)
+	httpServer.AddRoute(http.MethodPut, releaseApiPath, releaseResponseHandler)
+	httpServer.AddRoute(http.MethodDelete, releaseApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, releaseApiPath, releaseResponseHandler)
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceReleaseDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testReleaseProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinition,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testReleaseDefinition, "id", releaseID),
+					resource.TestCheckResourceAttr(testReleaseDefinition, ReleaseFieldName, releaseNameFieldValue),
+					resource.TestCheckResourceAttr(testReleaseDefinition, ReleaseFieldStart, releaseStartFieldValue),
+					resource.TestCheckResourceAttr(testReleaseDefinition, ReleaseFieldApplications+".#", "1"),
+					resource.TestCheckResourceAttr(testReleaseDefinition, ReleaseFieldService+".#", "1"),
+					resource.TestCheckResourceAttr(testReleaseDefinition, ReleaseFieldService+".0."+ReleaseFieldServiceName, "service"),
+					resource.TestCheckResourceAttr(testReleaseDefinition, ReleaseFieldService+".0."+ReleaseFieldServiceApplications+".#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestReleaseSchemaDefinitionIsValid(t *testing.T) {
+	schemaMap := NewReleaseResourceHandle().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ReleaseFieldName)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(ReleaseFieldApplications)
+	assert.Equal(t, schema.TypeString, schemaMap[ReleaseFieldStart].Type)
+	assert.True(t, schemaMap[ReleaseFieldStart].Optional)
+	assert.True(t, schemaMap[ReleaseFieldStart].Computed)
+	assert.Equal(t, schema.TypeList, schemaMap[ReleaseFieldService].Type)
+	assert.True(t, schemaMap[ReleaseFieldService].Optional)
+
+	serviceSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[ReleaseFieldService].Elem.(*schema.Resource).Schema, t)
+	serviceSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(ReleaseFieldServiceName)
+	serviceSchemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(ReleaseFieldServiceApplications)
+}
+
+func TestReleaseResourceShouldHaveSchemaVersionZero(t *testing.T) {
+	assert.Equal(t, 0, NewReleaseResourceHandle().SchemaVersion)
+}
+
+func TestShouldReturnCorrectResourceNameForReleaseResource(t *testing.T) {
+	name := NewReleaseResourceHandle().ResourceName
+
+	assert.Equal(t, "instana_release", name)
+}
+
+func TestShouldSetStartOfReleaseToCurrentTimeWhenNotConfigured(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	sut := NewReleaseResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+	before := time.Now().Add(-1 * time.Second)
+
+	err := sut.SetComputedFields(resourceData)
+
+	assert.Nil(t, err)
+	start, err := time.Parse(time.RFC3339, resourceData.Get(ReleaseFieldStart).(string))
+	assert.Nil(t, err)
+	assert.True(t, start.After(before))
+	assert.True(t, start.Before(time.Now().Add(time.Second)))
+}
+
+func TestShouldKeepConfiguredStartOfRelease(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	sut := NewReleaseResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+	resourceData.Set(ReleaseFieldStart, releaseStartFieldValue)
+
+	err := sut.SetComputedFields(resourceData)
+
+	assert.Nil(t, err)
+	assert.Equal(t, releaseStartFieldValue, resourceData.Get(ReleaseFieldStart))
+}
+
+func TestShouldUpdateTerraformResourceStateFromModelForRelease(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	sut := NewReleaseResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+	release := restapi.Release{
+		ID:           releaseID,
+		Name:         releaseNameFieldValue,
+		Start:        releaseStartMillis,
+		Applications: []restapi.ReleaseApplication{{Name: "app"}},
+		Services: []restapi.ReleaseService{
+			{Name: "service-1"},
+			{Name: "service-2", ScopedTo: &restapi.ReleaseServiceScope{Applications: []restapi.ReleaseApplication{{Name: "app"}}}},
+		},
+	}
+
+	err := sut.UpdateState(resourceData, release)
+
+	assert.Nil(t, err)
+	assert.Equal(t, releaseID, resourceData.Id())
+	assert.Equal(t, releaseNameFieldValue, resourceData.Get(ReleaseFieldName))
+	assert.Equal(t, releaseStartFieldValue, resourceData.Get(ReleaseFieldStart))
+	assert.True(t, resourceData.Get(ReleaseFieldApplications).(*schema.Set).Contains("app"))
+
+	services := resourceData.Get(ReleaseFieldService).([]interface{})
+	assert.Len(t, services, 2)
+	assert.Equal(t, "service-1", services[0].(map[string]interface{})[ReleaseFieldServiceName])
+	assert.Equal(t, 0, services[0].(map[string]interface{})[ReleaseFieldServiceApplications].(*schema.Set).Len())
+	assert.True(t, services[1].(map[string]interface{})[ReleaseFieldServiceApplications].(*schema.Set).Contains("app"))
+}
+
+func TestShouldConvertStateOfReleaseTerraformResourceToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewReleaseResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(releaseID)
+	resourceData.Set(ReleaseFieldName, releaseNameFieldValue)
+	resourceData.Set(ReleaseFieldStart, releaseStartFieldValue)
+	resourceData.Set(ReleaseFieldApplications, []interface{}{"app"})
+	resourceData.Set(ReleaseFieldService, []interface{}{
+		map[string]interface{}{ReleaseFieldServiceName: "service-1"},
+		map[string]interface{}{ReleaseFieldServiceName: "service-2", ReleaseFieldServiceApplications: []interface{}{"app"}},
+	})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, restapi.Release{
+		ID:           releaseID,
+		Name:         releaseNameFieldValue,
+		Start:        releaseStartMillis,
+		Applications: []restapi.ReleaseApplication{{Name: "app"}},
+		Services: []restapi.ReleaseService{
+			{Name: "service-1"},
+			{Name: "service-2", ScopedTo: &restapi.ReleaseServiceScope{Applications: []restapi.ReleaseApplication{{Name: "app"}}}},
+		},
+	}, model)
+}
+
+func TestShouldFailToConvertStateOfReleaseTerraformResourceToDataModelWhenStartIsNotAValidTimestamp(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewReleaseResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(releaseID)
+	resourceData.Set(ReleaseFieldName, releaseNameFieldValue)
+	resourceData.Set(ReleaseFieldStart, "invalid")
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,137 @@
+package instana
+
+import (
+	"context"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//ResourceInstanaServiceConfigOrder the name of the terraform-provider-instana resource to manage the evaluation order of service configs
+const ResourceInstanaServiceConfigOrder = "instana_service_config_order"
+
+//ServiceConfigOrderFieldServiceConfigIDs constant value for the schema field service_config_ids
+const ServiceConfigOrderFieldServiceConfigIDs = "service_config_ids"
+
+//NewServiceConfigOrderResource creates a new TerraformResource to manage the evaluation order of service configs.
+//The configured service configs are moved to the top of the evaluation order in the configured sequence. Service configs which are not managed by the resource are evaluated afterwards in their current order.
+//The order cannot be deleted in Instana. Therefore, the resource is only removed from the terraform state on delete.
+func NewServiceConfigOrderResource() TerraformResource {
+	return &serviceConfigOrderResource{}
+}
+
+type serviceConfigOrderResource struct{}
+
+//Create applies the configured order of the service configs
+func (r *serviceConfigOrderResource) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	d.SetId(RandomID())
+	return r.Update(ctx, d, meta)
+}
+
+//Read reads the current order of the service configs. Only the top of the evaluation order with the size of the managed
+//service configs is considered. Unmanaged service configs which are moved in front of or between the managed service
+//configs are therefore detected as drift, while the order of the unmanaged service configs afterwards is ignored
+func (r *serviceConfigOrderResource) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	currentOrder, err := r.readCurrentOrder(ctx, meta)
+	if err != nil {
+		return err
+	}
+	managedIDs := ReadStringArrayParameterFromResource(d, ServiceConfigOrderFieldServiceConfigIDs)
+	if len(managedIDs) == 0 {
+		d.Set(ServiceConfigOrderFieldServiceConfigIDs, currentOrder)
+		return nil
+	}
+	d.Set(ServiceConfigOrderFieldServiceConfigIDs, r.topOfOrder(currentOrder, len(managedIDs)))
+	return nil
+}
+
+//Update applies the configured order of the service configs
+func (r *serviceConfigOrderResource) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	currentOrder, err := r.readCurrentOrder(ctx, meta)
+	if err != nil {
+		return err
+	}
+	desiredOrder := ReadStringArrayParameterFromResource(d, ServiceConfigOrderFieldServiceConfigIDs)
+	order := append(restapi.ServiceConfigOrder{}, desiredOrder...)
+	for _, id := range currentOrder {
+		if !r.contains(desiredOrder, id) {
+			order = append(order, id)
+		}
+	}
+	if err = r.getRestResource(meta).UpdateOrder(ctx, order); err != nil {
+		return err
+	}
+	return r.Read(ctx, d, meta)
+}
+
+//Delete removes the resource from the terraform state. The order of the service configs is kept as it cannot be deleted in Instana
+func (r *serviceConfigOrderResource) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+//Import adopts the current order of all service configs
+func (r *serviceConfigOrderResource) Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := r.Read(ctx, d, meta); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+//ToSchemaResource creates the terraform schema resource for the evaluation order of service configs
+func (r *serviceConfigOrderResource) ToSchemaResource() *schema.Resource {
+	return &schema.Resource{
+		Create: WithOperationContext(schema.TimeoutCreate, r.Create),
+		Read:   WithOperationContext(schema.TimeoutRead, r.Read),
+		Update: WithOperationContext(schema.TimeoutUpdate, r.Update),
+		Delete: WithOperationContext(schema.TimeoutDelete, r.Delete),
+		Importer: &schema.ResourceImporter{
+			State: WithImportContext(r.Import),
+		},
+		Timeouts: newDefaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			ServiceConfigOrderFieldServiceConfigIDs: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The ordered list of IDs of the service configs. The service configs are evaluated in the given order",
+			},
+		},
+	}
+}
+
+func (r *serviceConfigOrderResource) getRestResource(meta interface{}) restapi.ServiceConfigResource {
+	providerMeta := meta.(*ProviderMeta)
+	return providerMeta.InstanaAPI.ServiceConfigs()
+}
+
+func (r *serviceConfigOrderResource) readCurrentOrder(ctx context.Context, meta interface{}) ([]string, error) {
+	serviceConfigs, err := r.getRestResource(meta).GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, len(serviceConfigs))
+	for i, c := range serviceConfigs {
+		result[i] = c.GetID()
+	}
+	return result, nil
+}
+
+func (r *serviceConfigOrderResource) topOfOrder(order []string, size int) []string {
+	if len(order) < size {
+		return order
+	}
+	return order[:size]
+}
+
+func (r *serviceConfigOrderResource) contains(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,260 @@
+package instana_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+var testServiceConfigOrderProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceServiceConfigOrderDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+  default_name_prefix = "prefix"
+  default_name_suffix = "suffix"
+}
+
+resource "instana_service_config_order" "example" {
+  service_config_ids = [ "id-2", "id-1" ]
+}
+`
+
+const testServiceConfigOrderDefinition = "instana_service_config_order.example"
+
+func TestCRUDOfServiceConfigOrderResourceWithMockServer(t *testing.T) {
+	var lock sync.Mutex
+	serverOrder := []string{"id-1", "id-2", "id-3"}
+	setServerOrder := func(order []string) {
+		lock.Lock()
+		defer lock.Unlock()
+		serverOrder = order
+	}
+	getServerOrder := func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return serverOrder
+	}
+
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, restapi.ServiceConfigsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		configs := make([]string, 0)
+		for _, id := range getServerOrder() {
+			configs = append(configs, fmt.Sprintf(`{ "id" : "%s", "name" : "name-%s", "label" : "label", "enabled" : true, "matchSpecification" : [] }`, id, id))
+		}
+		w.Header().Set(contentType, "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("[" + strings.Join(configs, ",") + "]"))
+	})
+	httpServer.AddRoute(http.MethodPut, restapi.ServiceConfigsResourcePath+"/"+restapi.ServiceConfigOrderPathElement, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		order := make([]string, 0)
+		if err := json.Unmarshal(body, &order); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		setServerOrder(order)
+		w.WriteHeader(http.StatusOK)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceServiceConfigOrderDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+	verifyServerOrder := func(s *terraform.State) error {
+		expected := []string{"id-2", "id-1", "id-3"}
+		if actual := getServerOrder(); strings.Join(actual, ",") != strings.Join(expected, ",") {
+			return fmt.Errorf("expected server order %v but got %v", expected, actual)
+		}
+		return nil
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testServiceConfigOrderProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinition,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testServiceConfigOrderDefinition, "id"),
+					resource.TestCheckResourceAttr(testServiceConfigOrderDefinition, ServiceConfigOrderFieldServiceConfigIDs+".#", "2"),
+					resource.TestCheckResourceAttr(testServiceConfigOrderDefinition, ServiceConfigOrderFieldServiceConfigIDs+".0", "id-2"),
+					resource.TestCheckResourceAttr(testServiceConfigOrderDefinition, ServiceConfigOrderFieldServiceConfigIDs+".1", "id-1"),
+					verifyServerOrder,
+				),
+			},
+			{
+				PreConfig: func() { setServerOrder([]string{"id-1", "id-2", "id-3"}) },
+				Config:    resourceDefinition,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testServiceConfigOrderDefinition, ServiceConfigOrderFieldServiceConfigIDs+".0", "id-2"),
+					resource.TestCheckResourceAttr(testServiceConfigOrderDefinition, ServiceConfigOrderFieldServiceConfigIDs+".1", "id-1"),
+					verifyServerOrder,
+				),
+			},
+		},
+	})
+}
+
+func TestServiceConfigOrderResourceShouldDefineSchema(t *testing.T) {
+	schemaMap := NewServiceConfigOrderResource().ToSchemaResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeListOfStrings(ServiceConfigOrderFieldServiceConfigIDs)
+	assert.Equal(t, 1, schemaMap[ServiceConfigOrderFieldServiceConfigIDs].MinItems)
+}
+
+func TestShouldApplyServiceConfigOrderOnCreateAndAppendUnmanagedServiceConfigs(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createServiceConfigOrderResourceData(t, "id-3", "id-1")
+		mockResource := mocks.NewMockServiceConfigResource(ctrl)
+
+		mockInstanaAPI.EXPECT().ServiceConfigs().Return(mockResource).AnyTimes()
+		gomock.InOrder(
+			mockResource.EXPECT().GetAll(gomock.Any()).Return(createTestServiceConfigs("id-1", "id-2", "id-3"), nil).Times(1),
+			mockResource.EXPECT().UpdateOrder(gomock.Any(), restapi.ServiceConfigOrder{"id-3", "id-1", "id-2"}).Return(nil).Times(1),
+			mockResource.EXPECT().GetAll(gomock.Any()).Return(createTestServiceConfigs("id-3", "id-1", "id-2"), nil).Times(1),
+		)
+
+		err := NewServiceConfigOrderResource().Create(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.NotEmpty(t, resourceData.Id())
+		assert.Equal(t, []string{"id-3", "id-1"}, ReadStringArrayParameterFromResource(resourceData, ServiceConfigOrderFieldServiceConfigIDs))
+	})
+}
+
+func TestShouldFailToUpdateServiceConfigOrderWhenServiceConfigsCannotBeRead(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createServiceConfigOrderResourceData(t, "id-1")
+		mockResource := mocks.NewMockServiceConfigResource(ctrl)
+		expectedError := errors.New("test")
+
+		mockInstanaAPI.EXPECT().ServiceConfigs().Return(mockResource).Times(1)
+		mockResource.EXPECT().GetAll(gomock.Any()).Return(nil, expectedError).Times(1)
+		mockResource.EXPECT().UpdateOrder(gomock.Any(), gomock.Any()).Times(0)
+
+		err := NewServiceConfigOrderResource().Update(context.Background(), resourceData, providerMeta)
+
+		assert.Equal(t, expectedError, err)
+	})
+}
+
+func TestShouldFailToUpdateServiceConfigOrderWhenOrderCannotBeApplied(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createServiceConfigOrderResourceData(t, "id-1")
+		mockResource := mocks.NewMockServiceConfigResource(ctrl)
+		expectedError := errors.New("test")
+
+		mockInstanaAPI.EXPECT().ServiceConfigs().Return(mockResource).Times(2)
+		mockResource.EXPECT().GetAll(gomock.Any()).Return(createTestServiceConfigs("id-1"), nil).Times(1)
+		mockResource.EXPECT().UpdateOrder(gomock.Any(), restapi.ServiceConfigOrder{"id-1"}).Return(expectedError).Times(1)
+
+		err := NewServiceConfigOrderResource().Update(context.Background(), resourceData, providerMeta)
+
+		assert.Equal(t, expectedError, err)
+	})
+}
+
+func TestShouldReadTopOfServiceConfigOrderWithSizeOfManagedServiceConfigs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		remoteOrder   []string
+		expectedOrder []string
+	}{
+		{name: "managed service configs are on top", remoteOrder: []string{"id-3", "id-1", "id-2"}, expectedOrder: []string{"id-3", "id-1"}},
+		{name: "managed service configs are reordered", remoteOrder: []string{"id-1", "id-3", "id-2"}, expectedOrder: []string{"id-1", "id-3"}},
+		{name: "unmanaged service config moved to top", remoteOrder: []string{"id-2", "id-3", "id-1"}, expectedOrder: []string{"id-2", "id-3"}},
+		{name: "managed service config deleted", remoteOrder: []string{"id-3"}, expectedOrder: []string{"id-3"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testHelper := NewTestHelper(t)
+			testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+				resourceData := createServiceConfigOrderResourceData(t, "id-3", "id-1")
+				resourceData.SetId("order-id")
+				mockResource := mocks.NewMockServiceConfigResource(ctrl)
+
+				mockInstanaAPI.EXPECT().ServiceConfigs().Return(mockResource).Times(1)
+				mockResource.EXPECT().GetAll(gomock.Any()).Return(createTestServiceConfigs(testCase.remoteOrder...), nil).Times(1)
+
+				err := NewServiceConfigOrderResource().Read(context.Background(), resourceData, providerMeta)
+
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.expectedOrder, ReadStringArrayParameterFromResource(resourceData, ServiceConfigOrderFieldServiceConfigIDs))
+			})
+		})
+	}
+}
+
+func TestShouldReadOrderOfAllServiceConfigsOnImport(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := schema.TestResourceDataRaw(t, NewServiceConfigOrderResource().ToSchemaResource().Schema, map[string]interface{}{})
+		resourceData.SetId("order-id")
+		mockResource := mocks.NewMockServiceConfigResource(ctrl)
+
+		mockInstanaAPI.EXPECT().ServiceConfigs().Return(mockResource).Times(1)
+		mockResource.EXPECT().GetAll(gomock.Any()).Return(createTestServiceConfigs("id-1", "id-2", "id-3"), nil).Times(1)
+
+		result, err := NewServiceConfigOrderResource().Import(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, []string{"id-1", "id-2", "id-3"}, ReadStringArrayParameterFromResource(result[0], ServiceConfigOrderFieldServiceConfigIDs))
+	})
+}
+
+func TestShouldOnlyRemoveServiceConfigOrderFromStateOnDelete(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createServiceConfigOrderResourceData(t, "id-1")
+		resourceData.SetId("order-id")
+
+		mockInstanaAPI.EXPECT().ServiceConfigs().Times(0)
+
+		err := NewServiceConfigOrderResource().Delete(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "", resourceData.Id())
+	})
+}
+
+func createServiceConfigOrderResourceData(t *testing.T, ids ...string) *schema.ResourceData {
+	data := map[string]interface{}{
+		ServiceConfigOrderFieldServiceConfigIDs: ConvertStringToInterfaceSlice(ids),
+	}
+	return schema.TestResourceDataRaw(t, NewServiceConfigOrderResource().ToSchemaResource().Schema, data)
+}
+
+func createTestServiceConfigs(ids ...string) []restapi.InstanaDataObject {
+	result := make([]restapi.InstanaDataObject, len(ids))
+	for i, id := range ids {
+		result[i] = restapi.ServiceConfig{ID: id, Name: "name-" + id, Label: "label", Enabled: true}
+	}
+	return result
+}
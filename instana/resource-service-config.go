@@ -0,0 +1,175 @@
+package instana
+
+import (
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+//ResourceInstanaServiceConfig the name of the terraform-provider-instana resource to manage service configs
+const ResourceInstanaServiceConfig = "instana_service_config"
+
+const (
+	//ServiceConfigFieldName constant value for the schema field name
+	ServiceConfigFieldName = "name"
+	//ServiceConfigFieldFullName constant value for the schema field full_name. The field is computed and contains the name which is sent to instana. The computation depends on the configured default_name_prefix and default_name_suffix at provider level
+	ServiceConfigFieldFullName = "full_name"
+	//ServiceConfigFieldComment constant value for the schema field comment
+	ServiceConfigFieldComment = "comment"
+	//ServiceConfigFieldLabel constant value for the schema field label
+	ServiceConfigFieldLabel = "label"
+	//ServiceConfigFieldEnabled constant value for the schema field enabled
+	ServiceConfigFieldEnabled = "enabled"
+	//ServiceConfigFieldMatchSpecification constant value for the schema field match_specification
+	ServiceConfigFieldMatchSpecification = "match_specification"
+)
+
+//ServiceConfigSchemaName schema field definition of instana_service_config field name
+var ServiceConfigSchemaName = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	Description:  "Configures the name of the service config",
+	ValidateFunc: validation.StringLenBetween(1, 128),
+}
+
+//ServiceConfigSchemaFullName schema field definition of instana_service_config field full_name
+var ServiceConfigSchemaFullName = &schema.Schema{
+	Type:        schema.TypeString,
+	Computed:    true,
+	Description: "The computed full name of the service config. The field contains the name which is sent to instana. The computation depends on the configured default_name_prefix and default_name_suffix at provider level",
+}
+
+//ServiceConfigSchemaComment schema field definition of instana_service_config field comment
+var ServiceConfigSchemaComment = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	Description:  "Configures an optional comment of the service config",
+	ValidateFunc: validation.StringLenBetween(0, 2048),
+}
+
+//ServiceConfigSchemaLabel schema field definition of instana_service_config field label
+var ServiceConfigSchemaLabel = &schema.Schema{
+	Type:        schema.TypeString,
+	Required:    true,
+	Description: "Configures the label of the service which is created by the service config. Tags can be referenced using curly braces, e.g. {kubernetes.container.name}",
+}
+
+//ServiceConfigSchemaEnabled schema field definition of instana_service_config field enabled
+var ServiceConfigSchemaEnabled = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     true,
+	Description: "Configures if the service config is enabled or not",
+}
+
+//ServiceConfigSchemaMatchSpecification schema field definition of instana_service_config field match_specification
+var ServiceConfigSchemaMatchSpecification = &schema.Schema{
+	Type:        schema.TypeString,
+	Required:    true,
+	Description: "Configures the match specification of the service config as filter expression. Only EQUALS comparisons combined with AND are supported",
+}
+
+//NewServiceConfigResourceHandle creates the resource handle for Service Configs
+func NewServiceConfigResourceHandle() *ResourceHandle {
+	return &ResourceHandle{
+		ResourceName: ResourceInstanaServiceConfig,
+		Schema: map[string]*schema.Schema{
+			ServiceConfigFieldName:               ServiceConfigSchemaName,
+			ServiceConfigFieldFullName:           ServiceConfigSchemaFullName,
+			ServiceConfigFieldComment:            ServiceConfigSchemaComment,
+			ServiceConfigFieldLabel:              ServiceConfigSchemaLabel,
+			ServiceConfigFieldEnabled:            ServiceConfigSchemaEnabled,
+			ServiceConfigFieldMatchSpecification: ServiceConfigSchemaMatchSpecification,
+		},
+		SchemaVersion:        0,
+		NameField:            ServiceConfigFieldName,
+		FullNameField:        ServiceConfigFieldFullName,
+		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.ServiceConfigs() },
+		UpdateState:          updateStateForServiceConfig,
+		MapStateToDataObject: mapStateToDataObjectForServiceConfig,
+	}
+}
+
+func updateStateForServiceConfig(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
+	serviceConfig := obj.(restapi.ServiceConfig)
+	matchSpecification, err := mapServiceMatchingRulesToNormalizedStringRepresentation(serviceConfig.MatchSpecification)
+	if err != nil {
+		return err
+	}
+
+	d.Set(ServiceConfigFieldFullName, serviceConfig.Name)
+	d.Set(ServiceConfigFieldComment, serviceConfig.Comment)
+	d.Set(ServiceConfigFieldLabel, serviceConfig.Label)
+	d.Set(ServiceConfigFieldEnabled, serviceConfig.Enabled)
+	d.Set(ServiceConfigFieldMatchSpecification, matchSpecification)
+
+	d.SetId(serviceConfig.ID)
+	return nil
+}
+
+func mapServiceMatchingRulesToNormalizedStringRepresentation(rules []restapi.ServiceMatchingRule) (string, error) {
+	if len(rules) == 0 {
+		return "", nil
+	}
+	last := rules[len(rules)-1]
+	expression := restapi.NewComparisionExpression(last.Key, restapi.EqualsOperator, last.Value)
+	for i := len(rules) - 2; i >= 0; i-- {
+		left := restapi.NewComparisionExpression(rules[i].Key, restapi.EqualsOperator, rules[i].Value)
+		expression = restapi.NewBinaryOperator(left, restapi.LogicalAnd, expression)
+	}
+	return mapAPIModelToNormalizedStringRepresentation(expression)
+}
+
+func mapStateToDataObjectForServiceConfig(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	matchSpecification, err := mapServiceMatchSpecificationStringToAPIModel(d.Get(ServiceConfigFieldMatchSpecification).(string))
+	if err != nil {
+		return restapi.ServiceConfig{}, err
+	}
+
+	return restapi.ServiceConfig{
+		ID:                 d.Id(),
+		Name:               computeFullServiceConfigNameString(d, formatter),
+		Comment:            d.Get(ServiceConfigFieldComment).(string),
+		Label:              d.Get(ServiceConfigFieldLabel).(string),
+		Enabled:            d.Get(ServiceConfigFieldEnabled).(bool),
+		MatchSpecification: matchSpecification,
+	}, nil
+}
+
+func mapServiceMatchSpecificationStringToAPIModel(input string) ([]restapi.ServiceMatchingRule, error) {
+	expression, err := mapExpressionStringToAPIModel(input)
+	if err != nil {
+		return []restapi.ServiceMatchingRule{}, err
+	}
+	return appendServiceMatchingRules(make([]restapi.ServiceMatchingRule, 0), expression)
+}
+
+func appendServiceMatchingRules(rules []restapi.ServiceMatchingRule, expression restapi.MatchExpression) ([]restapi.ServiceMatchingRule, error) {
+	switch e := expression.(type) {
+	case restapi.BinaryOperator:
+		if e.Conjunction != restapi.LogicalAnd {
+			return rules, fmt.Errorf("conjunction %s is not supported for the match specification of service configs; only AND is supported", e.Conjunction)
+		}
+		rules, err := appendServiceMatchingRules(rules, e.Left.(restapi.MatchExpression))
+		if err != nil {
+			return rules, err
+		}
+		return appendServiceMatchingRules(rules, e.Right.(restapi.MatchExpression))
+	case restapi.TagMatcherExpression:
+		if e.Operator != restapi.EqualsOperator {
+			return rules, fmt.Errorf("operator %s is not supported for the match specification of service configs; only EQUALS is supported", e.Operator)
+		}
+		return append(rules, restapi.ServiceMatchingRule{Key: e.Key, Value: *e.Value}), nil
+	}
+	return rules, fmt.Errorf("unsupported match expression type %s", expression.GetType())
+}
+
+func computeFullServiceConfigNameString(d *schema.ResourceData, formatter utils.ResourceNameFormatter) string {
+	if d.HasChange(ServiceConfigFieldName) {
+		return formatter.Format(d.Get(ServiceConfigFieldName).(string))
+	}
+	return d.Get(ServiceConfigFieldFullName).(string)
+}
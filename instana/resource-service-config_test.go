@@ -0,0 +1,256 @@
+package instana_test
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+var testServiceConfigProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceServiceConfigDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+  default_name_prefix = "prefix"
+  default_name_suffix = "suffix"
+}
+
+resource "instana_service_config" "example" {
+  name                = "name {{ITERATOR}}"
+  comment             = "comment"
+  label               = "{kubernetes.container.name}"
+  match_specification = "kubernetes.container.name EQUALS '{kubernetes.container.name}' AND kubernetes.namespace EQUALS 'production'"
+}
+`
+
+const serviceConfigServerResponseTemplate = `
+{
+	"id"                 : "{{id}}",
+	"name"               : "prefix name 0 suffix",
+	"comment"            : "comment",
+	"label"              : "{kubernetes.container.name}",
+	"enabled"            : true,
+	"matchSpecification" : [
+		{ "key" : "kubernetes.container.name", "value" : "{kubernetes.container.name}" },
+		{ "key" : "kubernetes.namespace", "value" : "production" }
+	]
+}
+`
+
+const serviceConfigApiPath = restapi.ServiceConfigsResourcePath + "/{id}"
+const testServiceConfigDefinition = "instana_service_config.example"
+const serviceConfigID = "service-config-id"
+const serviceConfigLabel = "{kubernetes.container.name}"
+const serviceConfigMatchSpecification = "kubernetes.container.name EQUALS '{kubernetes.container.name}' AND kubernetes.namespace EQUALS 'production'"
+
+func TestCRUDOfServiceConfigResourceWithMockServer(t *testing.T) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, serviceConfigApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodDelete, serviceConfigApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, serviceConfigApiPath, func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		json := strings.ReplaceAll(serviceConfigServerResponseTemplate, "{{id}}", vars["id"])
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(json))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceServiceConfigDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+	resourceDefinition = strings.ReplaceAll(resourceDefinition, iteratorPlaceholder, "0")
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testServiceConfigProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinition,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testServiceConfigDefinition, "id"),
+					resource.TestCheckResourceAttr(testServiceConfigDefinition, ServiceConfigFieldName, "name 0"),
+					resource.TestCheckResourceAttr(testServiceConfigDefinition, ServiceConfigFieldFullName, "prefix name 0 suffix"),
+					resource.TestCheckResourceAttr(testServiceConfigDefinition, ServiceConfigFieldComment, "comment"),
+					resource.TestCheckResourceAttr(testServiceConfigDefinition, ServiceConfigFieldLabel, serviceConfigLabel),
+					resource.TestCheckResourceAttr(testServiceConfigDefinition, ServiceConfigFieldEnabled, "true"),
+					resource.TestCheckResourceAttr(testServiceConfigDefinition, ServiceConfigFieldMatchSpecification, serviceConfigMatchSpecification),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceConfigSchemaDefinitionIsValid(t *testing.T) {
+	schemaMap := NewServiceConfigResourceHandle().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(ServiceConfigFieldFullName)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ServiceConfigFieldComment)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldLabel)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(ServiceConfigFieldEnabled, true)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldMatchSpecification)
+}
+
+func TestShouldReturnCorrectResourceNameForServiceConfigResource(t *testing.T) {
+	name := NewServiceConfigResourceHandle().ResourceName
+
+	assert.Equal(t, "instana_service_config", name)
+}
+
+func TestServiceConfigResourceShouldHaveSchemaVersionZero(t *testing.T) {
+	assert.Equal(t, 0, NewServiceConfigResourceHandle().SchemaVersion)
+}
+
+func TestShouldUpdateServiceConfigTerraformResourceStateFromModel(t *testing.T) {
+	name := "name"
+	config := restapi.ServiceConfig{
+		ID:      serviceConfigID,
+		Name:    name,
+		Comment: "comment",
+		Label:   serviceConfigLabel,
+		Enabled: false,
+		MatchSpecification: []restapi.ServiceMatchingRule{
+			{Key: "kubernetes.container.name", Value: "{kubernetes.container.name}"},
+			{Key: "kubernetes.namespace", Value: "production"},
+		},
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewServiceConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config)
+
+	assert.Nil(t, err)
+	assert.Equal(t, serviceConfigID, resourceData.Id())
+	assert.Equal(t, name, resourceData.Get(ServiceConfigFieldFullName))
+	assert.Equal(t, "comment", resourceData.Get(ServiceConfigFieldComment))
+	assert.Equal(t, serviceConfigLabel, resourceData.Get(ServiceConfigFieldLabel))
+	assert.False(t, resourceData.Get(ServiceConfigFieldEnabled).(bool))
+	assert.Equal(t, serviceConfigMatchSpecification, resourceData.Get(ServiceConfigFieldMatchSpecification))
+}
+
+func TestShouldUpdateServiceConfigTerraformResourceStateFromModelWithSingleMatchingRule(t *testing.T) {
+	config := restapi.ServiceConfig{
+		ID:                 serviceConfigID,
+		Name:               "name",
+		Label:              serviceConfigLabel,
+		MatchSpecification: []restapi.ServiceMatchingRule{{Key: "kubernetes.namespace", Value: "production"}},
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewServiceConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "kubernetes.namespace EQUALS 'production'", resourceData.Get(ServiceConfigFieldMatchSpecification))
+}
+
+func TestShouldUpdateServiceConfigTerraformResourceStateFromModelWithoutMatchingRules(t *testing.T) {
+	config := restapi.ServiceConfig{
+		ID:    serviceConfigID,
+		Name:  "name",
+		Label: serviceConfigLabel,
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewServiceConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "", resourceData.Get(ServiceConfigFieldMatchSpecification))
+}
+
+func TestShouldSuccessfullyConvertServiceConfigStateToDataModel(t *testing.T) {
+	name := "name"
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewServiceConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(serviceConfigID)
+	resourceData.Set(ServiceConfigFieldFullName, name)
+	resourceData.Set(ServiceConfigFieldComment, "comment")
+	resourceData.Set(ServiceConfigFieldLabel, serviceConfigLabel)
+	resourceData.Set(ServiceConfigFieldEnabled, true)
+	resourceData.Set(ServiceConfigFieldMatchSpecification, serviceConfigMatchSpecification)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.Nil(t, err)
+	assert.IsType(t, restapi.ServiceConfig{}, result)
+	config := result.(restapi.ServiceConfig)
+	assert.Equal(t, serviceConfigID, config.ID)
+	assert.Equal(t, name, config.Name)
+	assert.Equal(t, "comment", config.Comment)
+	assert.Equal(t, serviceConfigLabel, config.Label)
+	assert.True(t, config.Enabled)
+	assert.Equal(t, []restapi.ServiceMatchingRule{
+		{Key: "kubernetes.container.name", Value: "{kubernetes.container.name}"},
+		{Key: "kubernetes.namespace", Value: "production"},
+	}, config.MatchSpecification)
+}
+
+func TestShouldFailToConvertServiceConfigStateToDataModelWhenMatchSpecificationIsNotValid(t *testing.T) {
+	resourceData := createServiceConfigResourceDataWithMatchSpecification(t, "INVALID EXPRESSION")
+
+	_, err := NewServiceConfigResourceHandle().MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToConvertServiceConfigStateToDataModelWhenMatchSpecificationContainsLogicalOr(t *testing.T) {
+	resourceData := createServiceConfigResourceDataWithMatchSpecification(t, "kubernetes.namespace EQUALS 'a' OR kubernetes.namespace EQUALS 'b'")
+
+	_, err := NewServiceConfigResourceHandle().MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "only AND is supported")
+}
+
+func TestShouldFailToConvertServiceConfigStateToDataModelWhenMatchSpecificationContainsOtherOperatorThanEquals(t *testing.T) {
+	resourceData := createServiceConfigResourceDataWithMatchSpecification(t, "kubernetes.namespace EQUALS 'a' AND kubernetes.container.name CONTAINS 'b'")
+
+	_, err := NewServiceConfigResourceHandle().MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "only EQUALS is supported")
+}
+
+func TestShouldFailToConvertServiceConfigStateToDataModelWhenMatchSpecificationContainsUnaryOperation(t *testing.T) {
+	resourceData := createServiceConfigResourceDataWithMatchSpecification(t, "kubernetes.namespace NOT_EMPTY")
+
+	_, err := NewServiceConfigResourceHandle().MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "only EQUALS is supported")
+}
+
+func createServiceConfigResourceDataWithMatchSpecification(t *testing.T, matchSpecification string) *schema.ResourceData {
+	testHelper := NewTestHelper(t)
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(NewServiceConfigResourceHandle())
+	resourceData.SetId(serviceConfigID)
+	resourceData.Set(ServiceConfigFieldFullName, "name")
+	resourceData.Set(ServiceConfigFieldLabel, serviceConfigLabel)
+	resourceData.Set(ServiceConfigFieldMatchSpecification, matchSpecification)
+	return resourceData
+}
@@ -0,0 +1,133 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+//ResourceInstanaSyntheticCallConfig the name of the terraform-provider-instana resource to manage the synthetic call config
+const ResourceInstanaSyntheticCallConfig = "instana_synthetic_call_config"
+
+const (
+	//SyntheticCallConfigFieldDefaultRulesEnabled constant value for the schema field default_rules_enabled
+	SyntheticCallConfigFieldDefaultRulesEnabled = "default_rules_enabled"
+	//SyntheticCallConfigFieldCustomRule constant value for the schema field custom_rule
+	SyntheticCallConfigFieldCustomRule = "custom_rule"
+	//SyntheticCallConfigFieldCustomRuleName constant value for the schema field name of a custom rule
+	SyntheticCallConfigFieldCustomRuleName = "name"
+	//SyntheticCallConfigFieldCustomRuleDescription constant value for the schema field description of a custom rule
+	SyntheticCallConfigFieldCustomRuleDescription = "description"
+	//SyntheticCallConfigFieldCustomRuleMatchSpecification constant value for the schema field match_specification of a custom rule
+	SyntheticCallConfigFieldCustomRuleMatchSpecification = "match_specification"
+	//SyntheticCallConfigFieldCustomRuleEnabled constant value for the schema field enabled of a custom rule
+	SyntheticCallConfigFieldCustomRuleEnabled = "enabled"
+)
+
+//SyntheticCallConfigSchemaDefaultRulesEnabled schema field definition of instana_synthetic_call_config field default_rules_enabled
+var SyntheticCallConfigSchemaDefaultRulesEnabled = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     true,
+	Description: "Configures if the default rules of Instana to detect synthetic calls are enabled or not",
+}
+
+//SyntheticCallConfigSchemaCustomRule schema field definition of instana_synthetic_call_config field custom_rule
+var SyntheticCallConfigSchemaCustomRule = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	MaxItems: restapi.SyntheticCallConfigMaxCustomRules,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			SyntheticCallConfigFieldCustomRuleName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+				Description:  "The name of the custom rule",
+			},
+			SyntheticCallConfigFieldCustomRuleDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+				Description:  "The optional description of the custom rule",
+			},
+			SyntheticCallConfigFieldCustomRuleMatchSpecification: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The match specification of the custom rule as filter expression. Calls matching the expression are marked as synthetic calls",
+			},
+			SyntheticCallConfigFieldCustomRuleEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Configures if the custom rule is enabled or not",
+			},
+		},
+	},
+	Description: "The list of custom rules to detect synthetic calls",
+}
+
+//NewSyntheticCallConfigResourceHandle creates the resource handle for the synthetic call config. The synthetic call config exists only once per Instana tenant unit.
+//Deleting the resource resets the synthetic call config to the defaults of Instana
+func NewSyntheticCallConfigResourceHandle() *ResourceHandle {
+	return &ResourceHandle{
+		ResourceName: ResourceInstanaSyntheticCallConfig,
+		Schema: map[string]*schema.Schema{
+			SyntheticCallConfigFieldDefaultRulesEnabled: SyntheticCallConfigSchemaDefaultRulesEnabled,
+			SyntheticCallConfigFieldCustomRule:          SyntheticCallConfigSchemaCustomRule,
+		},
+		SchemaVersion:        0,
+		SkipIDGeneration:     true,
+		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.SyntheticCallConfig() },
+		UpdateState:          updateStateForSyntheticCallConfig,
+		MapStateToDataObject: mapStateToDataObjectForSyntheticCallConfig,
+	}
+}
+
+func updateStateForSyntheticCallConfig(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
+	config := obj.(restapi.SyntheticCallConfig)
+
+	customRules := make([]interface{}, len(config.CustomRules))
+	for i, rule := range config.CustomRules {
+		matchSpecification, err := mapAPIModelToNormalizedStringRepresentation(rule.MatchSpecification.(restapi.MatchExpression))
+		if err != nil {
+			return err
+		}
+		customRules[i] = map[string]interface{}{
+			SyntheticCallConfigFieldCustomRuleName:               rule.Name,
+			SyntheticCallConfigFieldCustomRuleDescription:        derefString(rule.Description),
+			SyntheticCallConfigFieldCustomRuleMatchSpecification: matchSpecification,
+			SyntheticCallConfigFieldCustomRuleEnabled:            rule.Enabled,
+		}
+	}
+
+	d.Set(SyntheticCallConfigFieldDefaultRulesEnabled, config.DefaultRulesEnabled)
+	d.Set(SyntheticCallConfigFieldCustomRule, customRules)
+
+	d.SetId(config.GetID())
+	return nil
+}
+
+func mapStateToDataObjectForSyntheticCallConfig(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	rawRules := d.Get(SyntheticCallConfigFieldCustomRule).([]interface{})
+	customRules := make([]restapi.SyntheticCallRule, len(rawRules))
+	for i, rawRule := range rawRules {
+		rule := rawRule.(map[string]interface{})
+		matchSpecification, err := mapExpressionStringToAPIModel(rule[SyntheticCallConfigFieldCustomRuleMatchSpecification].(string))
+		if err != nil {
+			return restapi.SyntheticCallConfig{}, err
+		}
+		customRules[i] = restapi.SyntheticCallRule{
+			Name:               rule[SyntheticCallConfigFieldCustomRuleName].(string),
+			Description:        readOptionalStringFromMap(rule, SyntheticCallConfigFieldCustomRuleDescription),
+			MatchSpecification: matchSpecification,
+			Enabled:            rule[SyntheticCallConfigFieldCustomRuleEnabled].(bool),
+		}
+	}
+
+	return restapi.SyntheticCallConfig{
+		DefaultRulesEnabled: d.Get(SyntheticCallConfigFieldDefaultRulesEnabled).(bool),
+		CustomRules:         customRules,
+	}, nil
+}
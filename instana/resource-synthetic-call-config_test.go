@@ -0,0 +1,248 @@
+package instana_test
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+var testSyntheticCallConfigProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceSyntheticCallConfigDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+  default_name_prefix = "prefix"
+  default_name_suffix = "suffix"
+}
+
+resource "instana_synthetic_call_config" "example" {
+  default_rules_enabled = false
+
+  custom_rule {
+    name                = "health-checks"
+    description         = "description"
+    match_specification = "call.http.path EQUALS '/health' OR call.http.path STARTS_WITH '/probe'"
+  }
+}
+`
+
+const syntheticCallConfigServerResponse = `
+{
+	"defaultRulesEnabled" : false,
+	"customRules" : [
+		{
+			"name" : "health-checks",
+			"description" : "description",
+			"enabled" : true,
+			"matchSpecification" : {
+				"type" : "BINARY_OP",
+				"left" : { "type" : "LEAF", "key" : "call.http.path", "operator" : "EQUALS", "value" : "/health" },
+				"conjunction" : "OR",
+				"right" : { "type" : "LEAF", "key" : "call.http.path", "operator" : "STARTS_WITH", "value" : "/probe" }
+			}
+		}
+	],
+	"defaultRules" : []
+}
+`
+
+const testSyntheticCallConfigDefinition = "instana_synthetic_call_config.example"
+const syntheticCallConfigMatchSpecification = "call.http.path EQUALS '/health' OR call.http.path STARTS_WITH '/probe'"
+
+func TestCRUDOfSyntheticCallConfigResourceWithMockServer(t *testing.T) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, restapi.SyntheticCallConfigResourcePath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodDelete, restapi.SyntheticCallConfigResourcePath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, restapi.SyntheticCallConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, r.Header.Get(contentType))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(syntheticCallConfigServerResponse))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceSyntheticCallConfigDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+	customRuleField := SyntheticCallConfigFieldCustomRule + ".0."
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testSyntheticCallConfigProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinition,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testSyntheticCallConfigDefinition, "id", restapi.SyntheticCallConfigPathElement),
+					resource.TestCheckResourceAttr(testSyntheticCallConfigDefinition, SyntheticCallConfigFieldDefaultRulesEnabled, "false"),
+					resource.TestCheckResourceAttr(testSyntheticCallConfigDefinition, SyntheticCallConfigFieldCustomRule+".#", "1"),
+					resource.TestCheckResourceAttr(testSyntheticCallConfigDefinition, customRuleField+SyntheticCallConfigFieldCustomRuleName, "health-checks"),
+					resource.TestCheckResourceAttr(testSyntheticCallConfigDefinition, customRuleField+SyntheticCallConfigFieldCustomRuleDescription, "description"),
+					resource.TestCheckResourceAttr(testSyntheticCallConfigDefinition, customRuleField+SyntheticCallConfigFieldCustomRuleMatchSpecification, syntheticCallConfigMatchSpecification),
+					resource.TestCheckResourceAttr(testSyntheticCallConfigDefinition, customRuleField+SyntheticCallConfigFieldCustomRuleEnabled, "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestSyntheticCallConfigSchemaDefinitionIsValid(t *testing.T) {
+	schemaMap := NewSyntheticCallConfigResourceHandle().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticCallConfigFieldDefaultRulesEnabled, true)
+	assert.True(t, schemaMap[SyntheticCallConfigFieldCustomRule].Optional)
+	assert.Equal(t, restapi.SyntheticCallConfigMaxCustomRules, schemaMap[SyntheticCallConfigFieldCustomRule].MaxItems)
+
+	customRuleSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[SyntheticCallConfigFieldCustomRule].Elem.(*schema.Resource).Schema, t)
+	customRuleSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticCallConfigFieldCustomRuleName)
+	customRuleSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticCallConfigFieldCustomRuleDescription)
+	customRuleSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticCallConfigFieldCustomRuleMatchSpecification)
+	customRuleSchemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticCallConfigFieldCustomRuleEnabled, true)
+}
+
+func TestShouldReturnCorrectResourceNameForSyntheticCallConfigResource(t *testing.T) {
+	name := NewSyntheticCallConfigResourceHandle().ResourceName
+
+	assert.Equal(t, "instana_synthetic_call_config", name)
+}
+
+func TestSyntheticCallConfigResourceShouldHaveSchemaVersionZero(t *testing.T) {
+	assert.Equal(t, 0, NewSyntheticCallConfigResourceHandle().SchemaVersion)
+}
+
+func TestShouldUpdateSyntheticCallConfigTerraformResourceStateFromModel(t *testing.T) {
+	description := "description"
+	config := restapi.SyntheticCallConfig{
+		DefaultRulesEnabled: true,
+		CustomRules: []restapi.SyntheticCallRule{
+			{
+				Name:        "rule-1",
+				Description: &description,
+				MatchSpecification: restapi.NewBinaryOperator(
+					restapi.NewComparisionExpression("call.http.path", restapi.EqualsOperator, "/health"),
+					restapi.LogicalOr,
+					restapi.NewComparisionExpression("call.http.path", restapi.StartsWithOperator, "/probe"),
+				),
+				Enabled: true,
+			},
+			{
+				Name:               "rule-2",
+				MatchSpecification: restapi.NewUnaryOperationExpression("call.http.header", restapi.IsEmptyOperator),
+			},
+		},
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewSyntheticCallConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config)
+
+	assert.Nil(t, err)
+	assert.Equal(t, restapi.SyntheticCallConfigPathElement, resourceData.Id())
+	assert.True(t, resourceData.Get(SyntheticCallConfigFieldDefaultRulesEnabled).(bool))
+
+	rules := resourceData.Get(SyntheticCallConfigFieldCustomRule).([]interface{})
+	assert.Len(t, rules, 2)
+	assert.Equal(t, map[string]interface{}{
+		SyntheticCallConfigFieldCustomRuleName:               "rule-1",
+		SyntheticCallConfigFieldCustomRuleDescription:        description,
+		SyntheticCallConfigFieldCustomRuleMatchSpecification: syntheticCallConfigMatchSpecification,
+		SyntheticCallConfigFieldCustomRuleEnabled:            true,
+	}, rules[0])
+	assert.Equal(t, map[string]interface{}{
+		SyntheticCallConfigFieldCustomRuleName:               "rule-2",
+		SyntheticCallConfigFieldCustomRuleDescription:        "",
+		SyntheticCallConfigFieldCustomRuleMatchSpecification: "call.http.header IS_EMPTY",
+		SyntheticCallConfigFieldCustomRuleEnabled:            false,
+	}, rules[1])
+}
+
+func TestShouldUpdateSyntheticCallConfigTerraformResourceStateFromModelWithoutCustomRules(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	sut := NewSyntheticCallConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, restapi.SyntheticCallConfig{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, restapi.SyntheticCallConfigPathElement, resourceData.Id())
+	assert.False(t, resourceData.Get(SyntheticCallConfigFieldDefaultRulesEnabled).(bool))
+	assert.Len(t, resourceData.Get(SyntheticCallConfigFieldCustomRule), 0)
+}
+
+func TestShouldSuccessfullyConvertSyntheticCallConfigStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewSyntheticCallConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(restapi.SyntheticCallConfigPathElement)
+	resourceData.Set(SyntheticCallConfigFieldDefaultRulesEnabled, false)
+	resourceData.Set(SyntheticCallConfigFieldCustomRule, []interface{}{
+		map[string]interface{}{
+			SyntheticCallConfigFieldCustomRuleName:               "rule-1",
+			SyntheticCallConfigFieldCustomRuleDescription:        "description",
+			SyntheticCallConfigFieldCustomRuleMatchSpecification: syntheticCallConfigMatchSpecification,
+			SyntheticCallConfigFieldCustomRuleEnabled:            true,
+		},
+		map[string]interface{}{
+			SyntheticCallConfigFieldCustomRuleName:               "rule-2",
+			SyntheticCallConfigFieldCustomRuleMatchSpecification: "call.http.header IS_EMPTY",
+			SyntheticCallConfigFieldCustomRuleEnabled:            false,
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.Nil(t, err)
+	assert.IsType(t, restapi.SyntheticCallConfig{}, result)
+	config := result.(restapi.SyntheticCallConfig)
+	assert.Equal(t, restapi.SyntheticCallConfigPathElement, config.GetID())
+	assert.False(t, config.DefaultRulesEnabled)
+	assert.Len(t, config.CustomRules, 2)
+
+	assert.Equal(t, "rule-1", config.CustomRules[0].Name)
+	assert.Equal(t, "description", *config.CustomRules[0].Description)
+	assert.True(t, config.CustomRules[0].Enabled)
+	assert.Equal(t, restapi.NewBinaryOperator(
+		restapi.NewComparisionExpression("call.http.path", restapi.EqualsOperator, "/health"),
+		restapi.LogicalOr,
+		restapi.NewComparisionExpression("call.http.path", restapi.StartsWithOperator, "/probe"),
+	), config.CustomRules[0].MatchSpecification)
+
+	assert.Equal(t, "rule-2", config.CustomRules[1].Name)
+	assert.Nil(t, config.CustomRules[1].Description)
+	assert.False(t, config.CustomRules[1].Enabled)
+	assert.Equal(t, restapi.NewUnaryOperationExpression("call.http.header", restapi.IsEmptyOperator), config.CustomRules[1].MatchSpecification)
+	assert.Nil(t, config.Validate())
+}
+
+func TestShouldFailToConvertSyntheticCallConfigStateToDataModelWhenMatchSpecificationIsNotValid(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewSyntheticCallConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(SyntheticCallConfigFieldCustomRule, []interface{}{
+		map[string]interface{}{
+			SyntheticCallConfigFieldCustomRuleName:               "rule",
+			SyntheticCallConfigFieldCustomRuleMatchSpecification: "invalid expression",
+		},
+	})
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.NotNil(t, err)
+}
@@ -68,184 +68,161 @@ var (
 		Required:    true,
 		Description: "The name of the user role",
 	}
-	userRoleSchemaCanConfigureServiceMapping = &schema.Schema{
+	userRoleSchemaRestrictedAccess = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure service mappings",
+		Description: "Configures if users of the role has limited access by group access scopes",
 	}
-	userRoleSchemaCanConfigureEumApplications = &schema.Schema{
+)
+
+//schema definitions of the permissions which are shared between user roles and API tokens
+var (
+	permissionSchemaCanConfigureServiceMapping = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure End User Monitoring applications",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure service mappings",
 	}
-	userRoleSchemaCanConfigureMobileAppMonitoring = &schema.Schema{
+	permissionSchemaCanConfigureEumApplications = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure Mobile App Monitoring",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure End User Monitoring applications",
 	}
-	userRoleSchemaCanConfigureUsers = &schema.Schema{
+	permissionSchemaCanConfigureMobileAppMonitoring = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure users",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure Mobile App Monitoring",
 	}
-	userRoleSchemaCanInstallNewAgents = &schema.Schema{
+	permissionSchemaCanConfigureUsers = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to install new agents",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure users",
 	}
-	userRoleSchemaCanSeeUsageInformation = &schema.Schema{
+	permissionSchemaCanInstallNewAgents = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to see usage information",
+		Description: "Configures if users of the role or holders of the API token are allowed to install new agents",
 	}
-	userRoleSchemaCanConfigureIntegrations = &schema.Schema{
+	permissionSchemaCanSeeUsageInformation = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure integrations",
+		Description: "Configures if users of the role or holders of the API token are allowed to see usage information",
 	}
-	userRoleSchemaCanSeeOnPremiseLicenseInformation = &schema.Schema{
+	permissionSchemaCanConfigureIntegrations = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to see onPremise license information",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure integrations",
 	}
-	userRoleSchemaCanConfigureRoles = &schema.Schema{
+	permissionSchemaCanSeeOnPremiseLicenseInformation = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure user roles",
+		Description: "Configures if users of the role or holders of the API token are allowed to see onPremise license information",
 	}
-	userRoleSchemaCanConfigureCustomAlerts = &schema.Schema{
+	permissionSchemaCanConfigureRoles = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure custom alerts",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure user roles",
 	}
-	userRoleSchemaCanConfigureAPITokens = &schema.Schema{
+	permissionSchemaCanConfigureCustomAlerts = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure API tokens",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure custom alerts",
 	}
-	userRoleSchemaCanConfigureAgentRunMode = &schema.Schema{
+	permissionSchemaCanConfigureAPITokens = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure agent run mode",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure API tokens",
 	}
-	userRoleSchemaCanViewAuditLog = &schema.Schema{
+	permissionSchemaCanConfigureAgentRunMode = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to view the audit log",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure agent run mode",
 	}
-	userRoleSchemaCanConfigureObjectives = &schema.Schema{
+	permissionSchemaCanViewAuditLog = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure objectives",
+		Description: "Configures if users of the role or holders of the API token are allowed to view the audit log",
 	}
-	userRoleSchemaCanConfigureAgents = &schema.Schema{
+	permissionSchemaCanConfigureObjectives = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure agents",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure objectives",
 	}
-	userRoleSchemaCanConfigureAuthenticationMethods = &schema.Schema{
+	permissionSchemaCanConfigureAgents = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure authentication methods",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure agents",
 	}
-	userRoleSchemaCanConfigureApplications = &schema.Schema{
+	permissionSchemaCanConfigureAuthenticationMethods = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure applications",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure authentication methods",
 	}
-
-	userRoleFieldCanConfigureTeams = &schema.Schema{
+	permissionSchemaCanConfigureApplications = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure teams (Groups)",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure applications",
 	}
-	userRoleFieldRestrictedAccess = &schema.Schema{
+	permissionSchemaCanConfigureTeams = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role has limited access by group access scopes",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure teams (Groups)",
 	}
-	userRoleFieldCanConfigureReleases = &schema.Schema{
+	permissionSchemaCanConfigureReleases = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure releases",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure releases",
 	}
-	userRoleFieldCanConfigureLogManagement = &schema.Schema{
+	permissionSchemaCanConfigureLogManagement = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to configure log management",
+		Description: "Configures if users of the role or holders of the API token are allowed to configure log management",
 	}
-	userRoleFieldCanCreatePublicCustomDashboards = &schema.Schema{
+	permissionSchemaCanCreatePublicCustomDashboards = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to create public custom dashboards",
+		Description: "Configures if users of the role or holders of the API token are allowed to create public custom dashboards",
 	}
-	userRoleFieldCanViewLogs = &schema.Schema{
+	permissionSchemaCanViewLogs = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to view logs",
+		Description: "Configures if users of the role or holders of the API token are allowed to view logs",
 	}
-	userRoleFieldCanViewTraceDetails = &schema.Schema{
+	permissionSchemaCanViewTraceDetails = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Configures if users of the role are allowed to view trace details",
+		Description: "Configures if users of the role or holders of the API token are allowed to view trace details",
 	}
 )
 
 //NewUserRoleResourceHandle creates a ResourceHandle instance for the terraform resource user role
 func NewUserRoleResourceHandle() *ResourceHandle {
 	return &ResourceHandle{
-		ResourceName: ResourceInstanaUserRole,
-		Schema: map[string]*schema.Schema{
-			UserRoleFieldName:                              userRoleSchemaName,
-			UserRoleFieldCanConfigureServiceMapping:        userRoleSchemaCanConfigureServiceMapping,
-			UserRoleFieldCanConfigureEumApplications:       userRoleSchemaCanConfigureEumApplications,
-			UserRoleFieldCanConfigureMobileAppMonitoring:   userRoleSchemaCanConfigureMobileAppMonitoring,
-			UserRoleFieldCanConfigureUsers:                 userRoleSchemaCanConfigureUsers,
-			UserRoleFieldCanInstallNewAgents:               userRoleSchemaCanInstallNewAgents,
-			UserRoleFieldCanSeeUsageInformation:            userRoleSchemaCanSeeUsageInformation,
-			UserRoleFieldCanConfigureIntegrations:          userRoleSchemaCanConfigureIntegrations,
-			UserRoleFieldCanSeeOnPremiseLicenseInformation: userRoleSchemaCanSeeOnPremiseLicenseInformation,
-			UserRoleFieldCanConfigureRoles:                 userRoleSchemaCanConfigureRoles,
-			UserRoleFieldCanConfigureCustomAlerts:          userRoleSchemaCanConfigureCustomAlerts,
-			UserRoleFieldCanConfigureAPITokens:             userRoleSchemaCanConfigureAPITokens,
-			UserRoleFieldCanConfigureAgentRunMode:          userRoleSchemaCanConfigureAgentRunMode,
-			UserRoleFieldCanViewAuditLog:                   userRoleSchemaCanViewAuditLog,
-			UserRoleFieldCanConfigureObjectives:            userRoleSchemaCanConfigureObjectives,
-			UserRoleFieldCanConfigureAgents:                userRoleSchemaCanConfigureAgents,
-			UserRoleFieldCanConfigureAuthenticationMethods: userRoleSchemaCanConfigureAuthenticationMethods,
-			UserRoleFieldCanConfigureApplications:          userRoleSchemaCanConfigureApplications,
-			UserRoleFieldCanConfigureTeams:                 userRoleFieldCanConfigureTeams,
-			UserRoleFieldRestrictedAccess:                  userRoleFieldRestrictedAccess,
-			UserRoleFieldCanConfigureReleases:              userRoleFieldCanConfigureReleases,
-			UserRoleFieldCanConfigureLogManagement:         userRoleFieldCanConfigureLogManagement,
-			UserRoleFieldCanCreatePublicCustomDashboards:   userRoleFieldCanCreatePublicCustomDashboards,
-			UserRoleFieldCanViewLogs:                       userRoleFieldCanViewLogs,
-			UserRoleFieldCanViewTraceDetails:               userRoleFieldCanViewTraceDetails,
-		},
+		ResourceName:  ResourceInstanaUserRole,
+		Schema:        userRoleSchema(),
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -260,33 +237,47 @@ func NewUserRoleResourceHandle() *ResourceHandle {
 	}
 }
 
+func userRoleSchema() map[string]*schema.Schema {
+	userRoleSchema := newPermissionSchemas()
+	userRoleSchema[UserRoleFieldName] = userRoleSchemaName
+	userRoleSchema[UserRoleFieldRestrictedAccess] = userRoleSchemaRestrictedAccess
+	return userRoleSchema
+}
+
+//newPermissionSchemas creates the schema definitions of the permissions which are shared between user roles and API tokens. The field names of the permissions are the same for both resources
+func newPermissionSchemas() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		UserRoleFieldCanConfigureServiceMapping:        permissionSchemaCanConfigureServiceMapping,
+		UserRoleFieldCanConfigureEumApplications:       permissionSchemaCanConfigureEumApplications,
+		UserRoleFieldCanConfigureMobileAppMonitoring:   permissionSchemaCanConfigureMobileAppMonitoring,
+		UserRoleFieldCanConfigureUsers:                 permissionSchemaCanConfigureUsers,
+		UserRoleFieldCanInstallNewAgents:               permissionSchemaCanInstallNewAgents,
+		UserRoleFieldCanSeeUsageInformation:            permissionSchemaCanSeeUsageInformation,
+		UserRoleFieldCanConfigureIntegrations:          permissionSchemaCanConfigureIntegrations,
+		UserRoleFieldCanSeeOnPremiseLicenseInformation: permissionSchemaCanSeeOnPremiseLicenseInformation,
+		UserRoleFieldCanConfigureRoles:                 permissionSchemaCanConfigureRoles,
+		UserRoleFieldCanConfigureCustomAlerts:          permissionSchemaCanConfigureCustomAlerts,
+		UserRoleFieldCanConfigureAPITokens:             permissionSchemaCanConfigureAPITokens,
+		UserRoleFieldCanConfigureAgentRunMode:          permissionSchemaCanConfigureAgentRunMode,
+		UserRoleFieldCanViewAuditLog:                   permissionSchemaCanViewAuditLog,
+		UserRoleFieldCanConfigureObjectives:            permissionSchemaCanConfigureObjectives,
+		UserRoleFieldCanConfigureAgents:                permissionSchemaCanConfigureAgents,
+		UserRoleFieldCanConfigureAuthenticationMethods: permissionSchemaCanConfigureAuthenticationMethods,
+		UserRoleFieldCanConfigureApplications:          permissionSchemaCanConfigureApplications,
+		UserRoleFieldCanConfigureTeams:                 permissionSchemaCanConfigureTeams,
+		UserRoleFieldCanConfigureReleases:              permissionSchemaCanConfigureReleases,
+		UserRoleFieldCanConfigureLogManagement:         permissionSchemaCanConfigureLogManagement,
+		UserRoleFieldCanCreatePublicCustomDashboards:   permissionSchemaCanCreatePublicCustomDashboards,
+		UserRoleFieldCanViewLogs:                       permissionSchemaCanViewLogs,
+		UserRoleFieldCanViewTraceDetails:               permissionSchemaCanViewTraceDetails,
+	}
+}
+
 func updateStateForUserRole(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
 	userRole := obj.(restapi.UserRole)
 	d.Set(UserRoleFieldName, userRole.Name)
-	d.Set(UserRoleFieldCanConfigureServiceMapping, userRole.CanConfigureServiceMapping)
-	d.Set(UserRoleFieldCanConfigureEumApplications, userRole.CanConfigureEumApplications)
-	d.Set(UserRoleFieldCanConfigureMobileAppMonitoring, userRole.CanConfigureMobileAppMonitoring)
-	d.Set(UserRoleFieldCanConfigureUsers, userRole.CanConfigureUsers)
-	d.Set(UserRoleFieldCanInstallNewAgents, userRole.CanInstallNewAgents)
-	d.Set(UserRoleFieldCanSeeUsageInformation, userRole.CanSeeUsageInformation)
-	d.Set(UserRoleFieldCanConfigureIntegrations, userRole.CanConfigureIntegrations)
-	d.Set(UserRoleFieldCanSeeOnPremiseLicenseInformation, userRole.CanSeeOnPremiseLicenseInformation)
-	d.Set(UserRoleFieldCanConfigureRoles, userRole.CanConfigureRoles)
-	d.Set(UserRoleFieldCanConfigureCustomAlerts, userRole.CanConfigureCustomAlerts)
-	d.Set(UserRoleFieldCanConfigureAPITokens, userRole.CanConfigureAPITokens)
-	d.Set(UserRoleFieldCanConfigureAgentRunMode, userRole.CanConfigureAgentRunMode)
-	d.Set(UserRoleFieldCanViewAuditLog, userRole.CanViewAuditLog)
-	d.Set(UserRoleFieldCanConfigureObjectives, userRole.CanConfigureObjectives)
-	d.Set(UserRoleFieldCanConfigureAgents, userRole.CanConfigureAgents)
-	d.Set(UserRoleFieldCanConfigureAuthenticationMethods, userRole.CanConfigureAuthenticationMethods)
-	d.Set(UserRoleFieldCanConfigureApplications, userRole.CanConfigureApplications)
-	d.Set(UserRoleFieldCanConfigureTeams, userRole.CanConfigureTeams)
+	updateStateForPermissions(d, userRole.Permissions)
 	d.Set(UserRoleFieldRestrictedAccess, userRole.RestrictedAccess)
-	d.Set(UserRoleFieldCanConfigureReleases, userRole.CanConfigureReleases)
-	d.Set(UserRoleFieldCanConfigureLogManagement, userRole.CanConfigureLogManagement)
-	d.Set(UserRoleFieldCanCreatePublicCustomDashboards, userRole.CanCreatePublicCustomDashboards)
-	d.Set(UserRoleFieldCanViewLogs, userRole.CanViewLogs)
-	d.Set(UserRoleFieldCanViewTraceDetails, userRole.CanViewTraceDetails)
 
 	d.SetId(userRole.ID)
 	return nil
@@ -294,8 +285,43 @@ func updateStateForUserRole(d *schema.ResourceData, obj restapi.InstanaDataObjec
 
 func mapStateToDataObjectForUserRole(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
 	return restapi.UserRole{
-		ID:                                d.Id(),
-		Name:                              d.Get(UserRoleFieldName).(string),
+		ID:               d.Id(),
+		Name:             d.Get(UserRoleFieldName).(string),
+		Permissions:      readPermissionsFromState(d),
+		RestrictedAccess: d.Get(UserRoleFieldRestrictedAccess).(bool),
+	}, nil
+}
+
+//updateStateForPermissions updates the permission fields of the terraform state which are shared between user roles and API tokens
+func updateStateForPermissions(d *schema.ResourceData, permissions restapi.Permissions) {
+	d.Set(UserRoleFieldCanConfigureServiceMapping, permissions.CanConfigureServiceMapping)
+	d.Set(UserRoleFieldCanConfigureEumApplications, permissions.CanConfigureEumApplications)
+	d.Set(UserRoleFieldCanConfigureMobileAppMonitoring, permissions.CanConfigureMobileAppMonitoring)
+	d.Set(UserRoleFieldCanConfigureUsers, permissions.CanConfigureUsers)
+	d.Set(UserRoleFieldCanInstallNewAgents, permissions.CanInstallNewAgents)
+	d.Set(UserRoleFieldCanSeeUsageInformation, permissions.CanSeeUsageInformation)
+	d.Set(UserRoleFieldCanConfigureIntegrations, permissions.CanConfigureIntegrations)
+	d.Set(UserRoleFieldCanSeeOnPremiseLicenseInformation, permissions.CanSeeOnPremiseLicenseInformation)
+	d.Set(UserRoleFieldCanConfigureRoles, permissions.CanConfigureRoles)
+	d.Set(UserRoleFieldCanConfigureCustomAlerts, permissions.CanConfigureCustomAlerts)
+	d.Set(UserRoleFieldCanConfigureAPITokens, permissions.CanConfigureAPITokens)
+	d.Set(UserRoleFieldCanConfigureAgentRunMode, permissions.CanConfigureAgentRunMode)
+	d.Set(UserRoleFieldCanViewAuditLog, permissions.CanViewAuditLog)
+	d.Set(UserRoleFieldCanConfigureObjectives, permissions.CanConfigureObjectives)
+	d.Set(UserRoleFieldCanConfigureAgents, permissions.CanConfigureAgents)
+	d.Set(UserRoleFieldCanConfigureAuthenticationMethods, permissions.CanConfigureAuthenticationMethods)
+	d.Set(UserRoleFieldCanConfigureApplications, permissions.CanConfigureApplications)
+	d.Set(UserRoleFieldCanConfigureTeams, permissions.CanConfigureTeams)
+	d.Set(UserRoleFieldCanConfigureReleases, permissions.CanConfigureReleases)
+	d.Set(UserRoleFieldCanConfigureLogManagement, permissions.CanConfigureLogManagement)
+	d.Set(UserRoleFieldCanCreatePublicCustomDashboards, permissions.CanCreatePublicCustomDashboards)
+	d.Set(UserRoleFieldCanViewLogs, permissions.CanViewLogs)
+	d.Set(UserRoleFieldCanViewTraceDetails, permissions.CanViewTraceDetails)
+}
+
+//readPermissionsFromState reads the permission fields from the terraform state which are shared between user roles and API tokens
+func readPermissionsFromState(d *schema.ResourceData) restapi.Permissions {
+	return restapi.Permissions{
 		CanConfigureServiceMapping:        d.Get(UserRoleFieldCanConfigureServiceMapping).(bool),
 		CanConfigureEumApplications:       d.Get(UserRoleFieldCanConfigureEumApplications).(bool),
 		CanConfigureMobileAppMonitoring:   d.Get(UserRoleFieldCanConfigureMobileAppMonitoring).(bool),
@@ -314,13 +340,12 @@ func mapStateToDataObjectForUserRole(d *schema.ResourceData, formatter utils.Res
 		CanConfigureAuthenticationMethods: d.Get(UserRoleFieldCanConfigureAuthenticationMethods).(bool),
 		CanConfigureApplications:          d.Get(UserRoleFieldCanConfigureApplications).(bool),
 		CanConfigureTeams:                 d.Get(UserRoleFieldCanConfigureTeams).(bool),
-		RestrictedAccess:                  d.Get(UserRoleFieldRestrictedAccess).(bool),
 		CanConfigureReleases:              d.Get(UserRoleFieldCanConfigureReleases).(bool),
 		CanConfigureLogManagement:         d.Get(UserRoleFieldCanConfigureLogManagement).(bool),
 		CanCreatePublicCustomDashboards:   d.Get(UserRoleFieldCanCreatePublicCustomDashboards).(bool),
 		CanViewLogs:                       d.Get(UserRoleFieldCanViewLogs).(bool),
 		CanViewTraceDetails:               d.Get(UserRoleFieldCanViewTraceDetails).(bool),
-	}, nil
+	}
 }
 
 func userRoleSchemaV0() *schema.Resource {
@@ -333,22 +358,22 @@ func userRoleSchemaV0() *schema.Resource {
 				Optional:    true,
 				Description: "The an implicit view filter which is applied for users of the given role",
 			},
-			UserRoleFieldCanConfigureServiceMapping:        userRoleSchemaCanConfigureServiceMapping,
-			UserRoleFieldCanConfigureEumApplications:       userRoleSchemaCanConfigureEumApplications,
-			UserRoleFieldCanConfigureUsers:                 userRoleSchemaCanConfigureUsers,
-			UserRoleFieldCanInstallNewAgents:               userRoleSchemaCanInstallNewAgents,
-			UserRoleFieldCanSeeUsageInformation:            userRoleSchemaCanSeeUsageInformation,
-			UserRoleFieldCanConfigureIntegrations:          userRoleSchemaCanConfigureIntegrations,
-			UserRoleFieldCanSeeOnPremiseLicenseInformation: userRoleSchemaCanSeeOnPremiseLicenseInformation,
-			UserRoleFieldCanConfigureRoles:                 userRoleSchemaCanConfigureRoles,
-			UserRoleFieldCanConfigureCustomAlerts:          userRoleSchemaCanConfigureCustomAlerts,
-			UserRoleFieldCanConfigureAPITokens:             userRoleSchemaCanConfigureAPITokens,
-			UserRoleFieldCanConfigureAgentRunMode:          userRoleSchemaCanConfigureAgentRunMode,
-			UserRoleFieldCanViewAuditLog:                   userRoleSchemaCanViewAuditLog,
-			UserRoleFieldCanConfigureObjectives:            userRoleSchemaCanConfigureObjectives,
-			UserRoleFieldCanConfigureAgents:                userRoleSchemaCanConfigureAgents,
-			UserRoleFieldCanConfigureAuthenticationMethods: userRoleSchemaCanConfigureAuthenticationMethods,
-			UserRoleFieldCanConfigureApplications:          userRoleSchemaCanConfigureApplications,
+			UserRoleFieldCanConfigureServiceMapping:        permissionSchemaCanConfigureServiceMapping,
+			UserRoleFieldCanConfigureEumApplications:       permissionSchemaCanConfigureEumApplications,
+			UserRoleFieldCanConfigureUsers:                 permissionSchemaCanConfigureUsers,
+			UserRoleFieldCanInstallNewAgents:               permissionSchemaCanInstallNewAgents,
+			UserRoleFieldCanSeeUsageInformation:            permissionSchemaCanSeeUsageInformation,
+			UserRoleFieldCanConfigureIntegrations:          permissionSchemaCanConfigureIntegrations,
+			UserRoleFieldCanSeeOnPremiseLicenseInformation: permissionSchemaCanSeeOnPremiseLicenseInformation,
+			UserRoleFieldCanConfigureRoles:                 permissionSchemaCanConfigureRoles,
+			UserRoleFieldCanConfigureCustomAlerts:          permissionSchemaCanConfigureCustomAlerts,
+			UserRoleFieldCanConfigureAPITokens:             permissionSchemaCanConfigureAPITokens,
+			UserRoleFieldCanConfigureAgentRunMode:          permissionSchemaCanConfigureAgentRunMode,
+			UserRoleFieldCanViewAuditLog:                   permissionSchemaCanViewAuditLog,
+			UserRoleFieldCanConfigureObjectives:            permissionSchemaCanConfigureObjectives,
+			UserRoleFieldCanConfigureAgents:                permissionSchemaCanConfigureAgents,
+			UserRoleFieldCanConfigureAuthenticationMethods: permissionSchemaCanConfigureAuthenticationMethods,
+			UserRoleFieldCanConfigureApplications:          permissionSchemaCanConfigureApplications,
 		},
 	}
 }
@@ -286,9 +286,11 @@ func TestShouldUpdateBasicFieldsOfTerraformResourceStateFromModelForUserRole(t *
 
 func TestShouldUpdateCanConfigureServiceMappingPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                         userRoleID,
-		Name:                       userRoleNameFieldValue,
-		CanConfigureServiceMapping: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureServiceMapping: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureServiceMapping)
@@ -296,9 +298,11 @@ func TestShouldUpdateCanConfigureServiceMappingPermissionOfTerraformResourceStat
 
 func TestShouldUpdateCanConfigureEumApplicationsPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                          userRoleID,
-		Name:                        userRoleNameFieldValue,
-		CanConfigureEumApplications: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureEumApplications: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureEumApplications)
@@ -306,9 +310,11 @@ func TestShouldUpdateCanConfigureEumApplicationsPermissionOfTerraformResourceSta
 
 func TestShouldUpdateCanConfigureMobileAppMonitoringPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                              userRoleID,
-		Name:                            userRoleNameFieldValue,
-		CanConfigureMobileAppMonitoring: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureMobileAppMonitoring: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureMobileAppMonitoring)
@@ -316,9 +322,11 @@ func TestShouldUpdateCanConfigureMobileAppMonitoringPermissionOfTerraformResourc
 
 func TestShouldUpdateCanConfigureUsersPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                userRoleID,
-		Name:              userRoleNameFieldValue,
-		CanConfigureUsers: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureUsers: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureUsers)
@@ -326,9 +334,11 @@ func TestShouldUpdateCanConfigureUsersPermissionOfTerraformResourceStateFromMode
 
 func TestShouldUpdateCanInstallNewAgentsPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                  userRoleID,
-		Name:                userRoleNameFieldValue,
-		CanInstallNewAgents: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanInstallNewAgents: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanInstallNewAgents)
@@ -336,9 +346,11 @@ func TestShouldUpdateCanInstallNewAgentsPermissionOfTerraformResourceStateFromMo
 
 func TestShouldUpdateCanSeeUsageInformationPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                     userRoleID,
-		Name:                   userRoleNameFieldValue,
-		CanSeeUsageInformation: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanSeeUsageInformation: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanSeeUsageInformation)
@@ -346,9 +358,11 @@ func TestShouldUpdateCanSeeUsageInformationPermissionOfTerraformResourceStateFro
 
 func TestShouldUpdateCanConfigureIntegrationsPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                       userRoleID,
-		Name:                     userRoleNameFieldValue,
-		CanConfigureIntegrations: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureIntegrations: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureIntegrations)
@@ -356,9 +370,11 @@ func TestShouldUpdateCanConfigureIntegrationsPermissionOfTerraformResourceStateF
 
 func TestShouldUpdateCanSeeOnPremiseLicenseInformationPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                                userRoleID,
-		Name:                              userRoleNameFieldValue,
-		CanSeeOnPremiseLicenseInformation: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanSeeOnPremiseLicenseInformation: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanSeeOnPremiseLicenseInformation)
@@ -366,9 +382,11 @@ func TestShouldUpdateCanSeeOnPremiseLicenseInformationPermissionOfTerraformResou
 
 func TestShouldUpdateCanConfigureRolesPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                userRoleID,
-		Name:              userRoleNameFieldValue,
-		CanConfigureRoles: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureRoles: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureRoles)
@@ -376,9 +394,11 @@ func TestShouldUpdateCanConfigureRolesPermissionOfTerraformResourceStateFromMode
 
 func TestShouldUpdateCanConfigureCustomAlertsPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                       userRoleID,
-		Name:                     userRoleNameFieldValue,
-		CanConfigureCustomAlerts: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureCustomAlerts: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureCustomAlerts)
@@ -386,9 +406,11 @@ func TestShouldUpdateCanConfigureCustomAlertsPermissionOfTerraformResourceStateF
 
 func TestShouldUpdateCanConfigureAPITokensPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                    userRoleID,
-		Name:                  userRoleNameFieldValue,
-		CanConfigureAPITokens: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureAPITokens: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureAPITokens)
@@ -396,9 +418,11 @@ func TestShouldUpdateCanConfigureAPITokensPermissionOfTerraformResourceStateFrom
 
 func TestShouldUpdateCanConfigureAgentRunModePermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                       userRoleID,
-		Name:                     userRoleNameFieldValue,
-		CanConfigureAgentRunMode: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureAgentRunMode: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureAgentRunMode)
@@ -406,9 +430,11 @@ func TestShouldUpdateCanConfigureAgentRunModePermissionOfTerraformResourceStateF
 
 func TestShouldUpdateCanViewAuditLogPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:              userRoleID,
-		Name:            userRoleNameFieldValue,
-		CanViewAuditLog: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanViewAuditLog: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanViewAuditLog)
@@ -416,9 +442,11 @@ func TestShouldUpdateCanViewAuditLogPermissionOfTerraformResourceStateFromModelF
 
 func TestShouldUpdateCanConfigureObjectivesPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                     userRoleID,
-		Name:                   userRoleNameFieldValue,
-		CanConfigureObjectives: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureObjectives: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureObjectives)
@@ -426,9 +454,11 @@ func TestShouldUpdateCanConfigureObjectivesPermissionOfTerraformResourceStateFro
 
 func TestShouldUpdateCanConfigureAgentsPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                 userRoleID,
-		Name:               userRoleNameFieldValue,
-		CanConfigureAgents: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureAgents: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureAgents)
@@ -436,9 +466,11 @@ func TestShouldUpdateCanConfigureAgentsPermissionOfTerraformResourceStateFromMod
 
 func TestShouldUpdateCanConfigureAuthenticationMethodsPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                                userRoleID,
-		Name:                              userRoleNameFieldValue,
-		CanConfigureAuthenticationMethods: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureAuthenticationMethods: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureAuthenticationMethods)
@@ -446,9 +478,11 @@ func TestShouldUpdateCanConfigureAuthenticationMethodsPermissionOfTerraformResou
 
 func TestShouldUpdateCanConfigureApplicationsPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                       userRoleID,
-		Name:                     userRoleNameFieldValue,
-		CanConfigureApplications: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureApplications: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureApplications)
@@ -456,9 +490,11 @@ func TestShouldUpdateCanConfigureApplicationsPermissionOfTerraformResourceStateF
 
 func TestShouldUpdateCanConfigureTeamsPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                userRoleID,
-		Name:              userRoleNameFieldValue,
-		CanConfigureTeams: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureTeams: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureTeams)
@@ -476,9 +512,11 @@ func TestShouldUpdateRestrictedAccessPermissionOfTerraformResourceStateFromModel
 
 func TestShouldUpdateCanConfigureReleasesPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                   userRoleID,
-		Name:                 userRoleNameFieldValue,
-		CanConfigureReleases: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureReleases: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureReleases)
@@ -486,9 +524,11 @@ func TestShouldUpdateCanConfigureReleasesPermissionOfTerraformResourceStateFromM
 
 func TestShouldUpdateCanConfigureLogManagementPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                        userRoleID,
-		Name:                      userRoleNameFieldValue,
-		CanConfigureLogManagement: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanConfigureLogManagement: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanConfigureLogManagement)
@@ -496,9 +536,11 @@ func TestShouldUpdateCanConfigureLogManagementPermissionOfTerraformResourceState
 
 func TestShouldUpdateCanCreatePublicCustomDashboardsPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                              userRoleID,
-		Name:                            userRoleNameFieldValue,
-		CanCreatePublicCustomDashboards: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanCreatePublicCustomDashboards: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanCreatePublicCustomDashboards)
@@ -506,9 +548,11 @@ func TestShouldUpdateCanCreatePublicCustomDashboardsPermissionOfTerraformResourc
 
 func TestShouldUpdateCanViewLogsPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:          userRoleID,
-		Name:        userRoleNameFieldValue,
-		CanViewLogs: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanViewLogs: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanViewLogs)
@@ -516,9 +560,11 @@ func TestShouldUpdateCanViewLogsPermissionOfTerraformResourceStateFromModelForUs
 
 func TestShouldUpdateCanViewTraceDetailsPermissionOfTerraformResourceStateFromModelForUserRole(t *testing.T) {
 	userRole := restapi.UserRole{
-		ID:                  userRoleID,
-		Name:                userRoleNameFieldValue,
-		CanViewTraceDetails: true,
+		ID:   userRoleID,
+		Name: userRoleNameFieldValue,
+		Permissions: restapi.Permissions{
+			CanViewTraceDetails: true,
+		},
 	}
 
 	testSingleUserRolePermissionSet(t, userRole, UserRoleFieldCanViewTraceDetails)
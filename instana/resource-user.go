@@ -0,0 +1,125 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//ResourceInstanaUser the name of the terraform-provider-instana resource to manage users
+const ResourceInstanaUser = "instana_user"
+
+const (
+	//UserFieldEmail constant value for the schema field email
+	UserFieldEmail = "email"
+	//UserFieldRoleID constant value for the schema field role_id
+	UserFieldRoleID = "role_id"
+	//UserFieldUserID constant value for the computed schema field user_id
+	UserFieldUserID = "user_id"
+	//UserFieldFullName constant value for the computed schema field full_name
+	UserFieldFullName = "full_name"
+	//UserFieldInvitationPending constant value for the computed schema field invitation_pending
+	UserFieldInvitationPending = "invitation_pending"
+)
+
+var (
+	//UserSchemaEmail schema field definition of instana_user field email
+	UserSchemaEmail = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressCaseInsensitiveEqualStrings,
+		Description:      "The email address of the user. The invitation is sent to this email address",
+	}
+	//UserSchemaRoleID schema field definition of instana_user field role_id
+	UserSchemaRoleID = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The id of the user role (instana_user_role) assigned to the user",
+	}
+	//UserSchemaUserID schema field definition of instana_user field user_id
+	UserSchemaUserID = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The id of the user in Instana. The id is only available after the user accepted the invitation",
+	}
+	//UserSchemaFullName schema field definition of instana_user field full_name
+	UserSchemaFullName = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The full name of the user. The full name is only available after the user accepted the invitation",
+	}
+	//UserSchemaInvitationPending schema field definition of instana_user field invitation_pending
+	UserSchemaInvitationPending = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Indicates if the user did not yet accept the invitation",
+	}
+)
+
+//NewUserResourceHandle creates a ResourceHandle instance for the terraform resource user. Users are invited by their email address which is
+//also used as ID of the resource. Existing users or pending invitations are not taken over on create but must be imported. Deleting the
+//resource removes the user from the tenant unit or revokes the pending invitation
+func NewUserResourceHandle() *ResourceHandle {
+	return &ResourceHandle{
+		ResourceName: ResourceInstanaUser,
+		Schema: map[string]*schema.Schema{
+			UserFieldEmail:             UserSchemaEmail,
+			UserFieldRoleID:            UserSchemaRoleID,
+			UserFieldUserID:            UserSchemaUserID,
+			UserFieldFullName:          UserSchemaFullName,
+			UserFieldInvitationPending: UserSchemaInvitationPending,
+		},
+		SchemaVersion:        0,
+		SkipIDGeneration:     true,
+		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.TenantUsers() },
+		UpdateState:          updateStateForUser,
+		MapStateToDataObject: mapStateToDataObjectForUser,
+		BeforeUpsert:         verifyUserDoesNotExistOnCreate,
+	}
+}
+
+//verifyUserDoesNotExistOnCreate ensures that an active user or a pending invitation with the same email address is not taken over silently
+//when the resource is created
+func verifyUserDoesNotExistOnCreate(ctx context.Context, d *schema.ResourceData, obj restapi.InstanaDataObject, api restapi.InstanaAPI) error {
+	if len(d.Id()) > 0 {
+		return nil
+	}
+	email := obj.(restapi.TenantUser).Email
+	_, err := api.TenantUsers().GetOne(ctx, email)
+	if err == restapi.ErrEntityNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("user %s already exists as active user or pending invitation; use terraform import to manage the existing user", email)
+}
+
+func updateStateForUser(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
+	user := obj.(restapi.TenantUser)
+	d.Set(UserFieldEmail, user.Email)
+	d.Set(UserFieldRoleID, user.RoleID)
+	d.Set(UserFieldUserID, user.UserID)
+	d.Set(UserFieldFullName, user.FullName)
+	d.Set(UserFieldInvitationPending, user.IsInvitationPending())
+
+	d.SetId(user.GetID())
+	return nil
+}
+
+func mapStateToDataObjectForUser(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	return restapi.TenantUser{
+		Email:  d.Get(UserFieldEmail).(string),
+		RoleID: d.Get(UserFieldRoleID).(string),
+		UserID: d.Get(UserFieldUserID).(string),
+	}, nil
+}
+
+func suppressCaseInsensitiveEqualStrings(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
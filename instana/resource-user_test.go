@@ -0,0 +1,240 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+var testUserProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceUserDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+}
+
+resource "instana_user" "example" {
+  email   = "user@example.com"
+  role_id = "role-id"
+}
+`
+
+const testUserDefinition = "instana_user.example"
+const userEmailFieldValue = "user@example.com"
+const userRoleIDFieldValue = "role-id"
+
+func TestCRUDOfUserResourceWithMockServer(t *testing.T) {
+	var mutex sync.Mutex
+	invitations := make(map[string]string)
+
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, restapi.UsersResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("[]"))
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.UserInvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		entries := make([]string, 0)
+		for email, roleID := range invitations {
+			entries = append(entries, fmt.Sprintf(`{ "email" : "%s", "roleId" : "%s" }`, email, roleID))
+		}
+		w.Header().Set(contentType, "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("[" + strings.Join(entries, ",") + "]"))
+	})
+	httpServer.AddRoute(http.MethodPost, restapi.UserInvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		invitations[r.URL.Query().Get("email")] = r.URL.Query().Get("roleId")
+		w.WriteHeader(http.StatusOK)
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.UserInvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		delete(invitations, r.URL.Query().Get("email"))
+		w.WriteHeader(http.StatusOK)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceUserDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testUserProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinition,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testUserDefinition, "id", userEmailFieldValue),
+					resource.TestCheckResourceAttr(testUserDefinition, UserFieldEmail, userEmailFieldValue),
+					resource.TestCheckResourceAttr(testUserDefinition, UserFieldRoleID, userRoleIDFieldValue),
+					resource.TestCheckResourceAttr(testUserDefinition, UserFieldUserID, ""),
+					resource.TestCheckResourceAttr(testUserDefinition, UserFieldInvitationPending, valueTrue),
+				),
+			},
+		},
+	})
+
+	assert.Len(t, invitations, 0)
+}
+
+func TestUserSchemaDefinitionIsValid(t *testing.T) {
+	schemaMap := NewUserResourceHandle().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(UserFieldEmail)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(UserFieldRoleID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(UserFieldUserID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(UserFieldFullName)
+	assert.True(t, schemaMap[UserFieldInvitationPending].Computed)
+	assert.True(t, schemaMap[UserFieldEmail].ForceNew)
+}
+
+func TestUserSchemaShouldIgnoreCaseOfEmail(t *testing.T) {
+	schemaMap := NewUserResourceHandle().Schema
+
+	assert.True(t, schemaMap[UserFieldEmail].DiffSuppressFunc(UserFieldEmail, "User@Example.com", userEmailFieldValue, nil))
+	assert.False(t, schemaMap[UserFieldEmail].DiffSuppressFunc(UserFieldEmail, "other@example.com", userEmailFieldValue, nil))
+}
+
+func TestUserResourceShouldHaveSchemaVersionZero(t *testing.T) {
+	assert.Equal(t, 0, NewUserResourceHandle().SchemaVersion)
+}
+
+func TestShouldReturnCorrectResourceNameForUserResource(t *testing.T) {
+	name := NewUserResourceHandle().ResourceName
+
+	assert.Equal(t, "instana_user", name)
+}
+
+func TestShouldUpdateTerraformResourceStateFromModelForActiveUser(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	sut := NewUserResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+	user := restapi.TenantUser{
+		Email:    userEmailFieldValue,
+		RoleID:   userRoleIDFieldValue,
+		UserID:   "user-id",
+		FullName: "full name",
+	}
+
+	err := sut.UpdateState(resourceData, user)
+
+	assert.Nil(t, err)
+	assert.Equal(t, userEmailFieldValue, resourceData.Id())
+	assert.Equal(t, userEmailFieldValue, resourceData.Get(UserFieldEmail))
+	assert.Equal(t, userRoleIDFieldValue, resourceData.Get(UserFieldRoleID))
+	assert.Equal(t, "user-id", resourceData.Get(UserFieldUserID))
+	assert.Equal(t, "full name", resourceData.Get(UserFieldFullName))
+	assert.False(t, resourceData.Get(UserFieldInvitationPending).(bool))
+}
+
+func TestShouldUpdateTerraformResourceStateFromModelForPendingInvitation(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	sut := NewUserResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, restapi.TenantUser{Email: userEmailFieldValue, RoleID: userRoleIDFieldValue})
+
+	assert.Nil(t, err)
+	assert.Equal(t, userEmailFieldValue, resourceData.Id())
+	assert.Equal(t, "", resourceData.Get(UserFieldUserID))
+	assert.True(t, resourceData.Get(UserFieldInvitationPending).(bool))
+}
+
+func TestShouldConvertStateOfUserTerraformResourceToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewUserResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(userEmailFieldValue)
+	resourceData.Set(UserFieldEmail, userEmailFieldValue)
+	resourceData.Set(UserFieldRoleID, userRoleIDFieldValue)
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, restapi.TenantUser{Email: userEmailFieldValue, RoleID: userRoleIDFieldValue}, model)
+	assert.Equal(t, userEmailFieldValue, model.GetID())
+}
+
+func TestShouldFailToCreateUserWhenActiveUserOrPendingInvitationAlreadyExists(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceHandle := NewUserResourceHandle()
+		resourceData := testHelper.CreateResourceDataForResourceHandle(resourceHandle, map[string]interface{}{UserFieldEmail: userEmailFieldValue, UserFieldRoleID: userRoleIDFieldValue})
+		mockTenantUsers := mocks.NewMockRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().TenantUsers().Return(mockTenantUsers).Times(1)
+		mockTenantUsers.EXPECT().GetOne(gomock.Any(), userEmailFieldValue).Return(restapi.TenantUser{Email: userEmailFieldValue, RoleID: "other-role-id"}, nil).Times(1)
+		mockTenantUsers.EXPECT().Upsert(gomock.Any(), gomock.Any()).Times(0)
+
+		err := NewTerraformResource(resourceHandle).Create(context.Background(), resourceData, providerMeta)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "user user@example.com already exists")
+		assert.Contains(t, err.Error(), "terraform import")
+	})
+}
+
+func TestShouldFailToCreateUserWhenExistingUsersCannotBeRetrieved(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceHandle := NewUserResourceHandle()
+		resourceData := testHelper.CreateResourceDataForResourceHandle(resourceHandle, map[string]interface{}{UserFieldEmail: userEmailFieldValue, UserFieldRoleID: userRoleIDFieldValue})
+		mockTenantUsers := mocks.NewMockRestResource(ctrl)
+		expectedError := errors.New("test")
+
+		mockInstanaAPI.EXPECT().TenantUsers().Return(mockTenantUsers).Times(1)
+		mockTenantUsers.EXPECT().GetOne(gomock.Any(), userEmailFieldValue).Return(nil, expectedError).Times(1)
+		mockTenantUsers.EXPECT().Upsert(gomock.Any(), gomock.Any()).Times(0)
+
+		err := NewTerraformResource(resourceHandle).Create(context.Background(), resourceData, providerMeta)
+
+		assert.Equal(t, expectedError, err)
+	})
+}
+
+func TestShouldUpdateRoleOfUserWithoutCheckingForExistingUser(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceHandle := NewUserResourceHandle()
+		resourceData := testHelper.CreateResourceDataForResourceHandle(resourceHandle, map[string]interface{}{UserFieldEmail: userEmailFieldValue, UserFieldRoleID: userRoleIDFieldValue})
+		resourceData.SetId(userEmailFieldValue)
+		mockTenantUsers := mocks.NewMockRestResource(ctrl)
+		expectedModel := restapi.TenantUser{Email: userEmailFieldValue, RoleID: userRoleIDFieldValue}
+
+		mockInstanaAPI.EXPECT().TenantUsers().Return(mockTenantUsers).Times(1)
+		mockTenantUsers.EXPECT().GetOne(gomock.Any(), gomock.Any()).Times(0)
+		mockTenantUsers.EXPECT().Upsert(gomock.Any(), expectedModel).Return(expectedModel, nil).Times(1)
+
+		err := NewTerraformResource(resourceHandle).Update(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, userRoleIDFieldValue, resourceData.Get(UserFieldRoleID))
+	})
+}
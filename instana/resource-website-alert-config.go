@@ -0,0 +1,703 @@
+package instana
+
+import (
+	"errors"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+//ResourceInstanaWebsiteAlertConfig the name of the terraform-provider-instana resource to manage website alert configurations
+const ResourceInstanaWebsiteAlertConfig = "instana_website_alert_config"
+
+const (
+	//WebsiteAlertConfigFieldName constant value for the schema field name
+	WebsiteAlertConfigFieldName = "name"
+	//WebsiteAlertConfigFieldFullName constant value for the schema field full_name. The field is computed and contains the name which is sent to instana. The computation depends on the configured default_name_prefix and default_name_suffix at provider level
+	WebsiteAlertConfigFieldFullName = "full_name"
+	//WebsiteAlertConfigFieldDescription constant value for the schema field description
+	WebsiteAlertConfigFieldDescription = "description"
+	//WebsiteAlertConfigFieldWebsiteID constant value for the schema field website_id
+	WebsiteAlertConfigFieldWebsiteID = "website_id"
+	//WebsiteAlertConfigFieldSeverity constant value for the schema field severity
+	WebsiteAlertConfigFieldSeverity = "severity"
+	//WebsiteAlertConfigFieldTriggering constant value for the schema field triggering
+	WebsiteAlertConfigFieldTriggering = "triggering"
+	//WebsiteAlertConfigFieldAlertChannelIDs constant value for the schema field alert_channel_ids
+	WebsiteAlertConfigFieldAlertChannelIDs = "alert_channel_ids"
+	//WebsiteAlertConfigFieldGranularity constant value for the schema field granularity
+	WebsiteAlertConfigFieldGranularity = "granularity"
+	//WebsiteAlertConfigFieldEnabled constant value for the schema field enabled
+	WebsiteAlertConfigFieldEnabled = "enabled"
+
+	//WebsiteAlertConfigFieldTagFilter constant value for the schema field tag_filter
+	WebsiteAlertConfigFieldTagFilter = "tag_filter"
+	//WebsiteAlertConfigFieldTagFilterName constant value for the schema field name of a tag filter
+	WebsiteAlertConfigFieldTagFilterName = "name"
+	//WebsiteAlertConfigFieldTagFilterOperator constant value for the schema field operator of a tag filter
+	WebsiteAlertConfigFieldTagFilterOperator = "operator"
+	//WebsiteAlertConfigFieldTagFilterValue constant value for the schema field value of a tag filter
+	WebsiteAlertConfigFieldTagFilterValue = "value"
+
+	//WebsiteAlertConfigFieldRule constant value for the schema field rule
+	WebsiteAlertConfigFieldRule = "rule"
+	//WebsiteAlertConfigFieldRuleSlowness constant value for the schema field slowness of a rule
+	WebsiteAlertConfigFieldRuleSlowness = "slowness"
+	//WebsiteAlertConfigFieldRuleStatusCode constant value for the schema field status_code of a rule
+	WebsiteAlertConfigFieldRuleStatusCode = "status_code"
+	//WebsiteAlertConfigFieldRuleSpecificJsError constant value for the schema field specific_js_error of a rule
+	WebsiteAlertConfigFieldRuleSpecificJsError = "specific_js_error"
+	//WebsiteAlertConfigFieldRuleThroughput constant value for the schema field throughput of a rule
+	WebsiteAlertConfigFieldRuleThroughput = "throughput"
+	//WebsiteAlertConfigFieldRuleMetricName constant value for the schema field metric_name of a rule
+	WebsiteAlertConfigFieldRuleMetricName = "metric_name"
+	//WebsiteAlertConfigFieldRuleAggregation constant value for the schema field aggregation of a rule
+	WebsiteAlertConfigFieldRuleAggregation = "aggregation"
+	//WebsiteAlertConfigFieldRuleOperator constant value for the schema field operator of a rule
+	WebsiteAlertConfigFieldRuleOperator = "operator"
+	//WebsiteAlertConfigFieldRuleValue constant value for the schema field value of a rule
+	WebsiteAlertConfigFieldRuleValue = "value"
+
+	//WebsiteAlertConfigFieldThreshold constant value for the schema field threshold
+	WebsiteAlertConfigFieldThreshold = "threshold"
+	//WebsiteAlertConfigFieldThresholdOperator constant value for the schema field operator of the threshold
+	WebsiteAlertConfigFieldThresholdOperator = "operator"
+	//WebsiteAlertConfigFieldThresholdValue constant value for the schema field value of the threshold
+	WebsiteAlertConfigFieldThresholdValue = "value"
+
+	//WebsiteAlertConfigFieldTimeThreshold constant value for the schema field time_threshold
+	WebsiteAlertConfigFieldTimeThreshold = "time_threshold"
+	//WebsiteAlertConfigFieldTimeThresholdViolationsInSequence constant value for the schema field violations_in_sequence of the time threshold
+	WebsiteAlertConfigFieldTimeThresholdViolationsInSequence = "violations_in_sequence"
+	//WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod constant value for the schema field violations_in_period of the time threshold
+	WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod = "violations_in_period"
+	//WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence constant value for the schema field user_impact_of_violations_in_sequence of the time threshold
+	WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence = "user_impact_of_violations_in_sequence"
+	//WebsiteAlertConfigFieldTimeThresholdTimeWindow constant value for the schema field time_window of the time threshold
+	WebsiteAlertConfigFieldTimeThresholdTimeWindow = "time_window"
+	//WebsiteAlertConfigFieldTimeThresholdViolations constant value for the schema field violations of the time threshold
+	WebsiteAlertConfigFieldTimeThresholdViolations = "violations"
+	//WebsiteAlertConfigFieldTimeThresholdUsers constant value for the schema field users of the time threshold
+	WebsiteAlertConfigFieldTimeThresholdUsers = "users"
+	//WebsiteAlertConfigFieldTimeThresholdUserPercentage constant value for the schema field user_percentage of the time threshold
+	WebsiteAlertConfigFieldTimeThresholdUserPercentage = "user_percentage"
+)
+
+const websiteAlertConfigDefaultGranularity = 600000
+
+var (
+	websiteAlertConfigRuleSlownessPath                                  = WebsiteAlertConfigFieldRule + ".0." + WebsiteAlertConfigFieldRuleSlowness
+	websiteAlertConfigRuleStatusCodePath                                = WebsiteAlertConfigFieldRule + ".0." + WebsiteAlertConfigFieldRuleStatusCode
+	websiteAlertConfigRuleSpecificJsErrorPath                           = WebsiteAlertConfigFieldRule + ".0." + WebsiteAlertConfigFieldRuleSpecificJsError
+	websiteAlertConfigRuleThroughputPath                                = WebsiteAlertConfigFieldRule + ".0." + WebsiteAlertConfigFieldRuleThroughput
+	websiteAlertConfigTimeThresholdViolationsInSequencePath             = WebsiteAlertConfigFieldTimeThreshold + ".0." + WebsiteAlertConfigFieldTimeThresholdViolationsInSequence
+	websiteAlertConfigTimeThresholdViolationsInPeriodPath               = WebsiteAlertConfigFieldTimeThreshold + ".0." + WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod
+	websiteAlertConfigTimeThresholdUserImpactOfViolationsInSequencePath = WebsiteAlertConfigFieldTimeThreshold + ".0." + WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence
+)
+
+var websiteAlertConfigSchemaRuleMetricName = &schema.Schema{
+	Type:        schema.TypeString,
+	Required:    true,
+	Description: "The metric name of the website alert rule",
+}
+
+var websiteAlertConfigSchemaRuleOperator = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	ValidateFunc: validation.StringInSlice(restapi.SupportedExpressionOperators, false),
+	Description:  "The operator which is applied to the value of the website alert rule",
+}
+
+var websiteAlertConfigSchemaTimeThresholdTimeWindow = &schema.Schema{
+	Type:         schema.TypeInt,
+	Required:     true,
+	ValidateFunc: validation.IntAtLeast(1),
+	Description:  "The time window of the time threshold in milliseconds",
+}
+
+//WebsiteAlertConfigSchemaName schema field definition of instana_website_alert_config field name
+var WebsiteAlertConfigSchemaName = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	Description:  "Configures the name of the website alert configuration",
+	ValidateFunc: validation.StringLenBetween(1, 256),
+}
+
+//WebsiteAlertConfigSchemaFullName schema field definition of instana_website_alert_config field full_name
+var WebsiteAlertConfigSchemaFullName = &schema.Schema{
+	Type:        schema.TypeString,
+	Computed:    true,
+	Description: "The computed full name of the website alert configuration. The field contains the name which is sent to instana. The computation depends on the configured default_name_prefix and default_name_suffix at provider level",
+}
+
+//WebsiteAlertConfigSchemaDescription schema field definition of instana_website_alert_config field description
+var WebsiteAlertConfigSchemaDescription = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	Description:  "Configures the description of the website alert configuration",
+	ValidateFunc: validation.StringLenBetween(0, 65536),
+}
+
+//WebsiteAlertConfigSchemaWebsiteID schema field definition of instana_website_alert_config field website_id
+var WebsiteAlertConfigSchemaWebsiteID = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	Description:  "Configures the ID of the website for which the website alert configuration is defined",
+	ValidateFunc: validation.StringLenBetween(1, 64),
+}
+
+//WebsiteAlertConfigSchemaSeverity schema field definition of instana_website_alert_config field severity
+var WebsiteAlertConfigSchemaSeverity = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	ValidateFunc: validation.StringInSlice([]string{restapi.SeverityWarning.GetTerraformRepresentation(), restapi.SeverityCritical.GetTerraformRepresentation()}, false),
+	Description:  "Configures the severity of the website alert configuration (warning or critical)",
+}
+
+//WebsiteAlertConfigSchemaTriggering schema field definition of instana_website_alert_config field triggering
+var WebsiteAlertConfigSchemaTriggering = &schema.Schema{
+	Type:        schema.TypeBool,
+	Default:     false,
+	Optional:    true,
+	Description: "Configures if an incident is triggered or not",
+}
+
+//WebsiteAlertConfigSchemaAlertChannelIDs schema field definition of instana_website_alert_config field alert_channel_ids
+var WebsiteAlertConfigSchemaAlertChannelIDs = &schema.Schema{
+	Type:     schema.TypeSet,
+	MinItems: 0,
+	MaxItems: 1024,
+	Elem: &schema.Schema{
+		Type: schema.TypeString,
+	},
+	Optional:    true,
+	Description: "Configures the list of alerting channel IDs which are notified when the website alert is triggered",
+}
+
+//WebsiteAlertConfigSchemaGranularity schema field definition of instana_website_alert_config field granularity
+var WebsiteAlertConfigSchemaGranularity = &schema.Schema{
+	Type:         schema.TypeInt,
+	Optional:     true,
+	Default:      websiteAlertConfigDefaultGranularity,
+	ValidateFunc: validation.IntInSlice(restapi.SupportedWebsiteAlertGranularities),
+	Description:  "Configures the evaluation granularity of the website alert configuration in milliseconds",
+}
+
+//WebsiteAlertConfigSchemaEnabled schema field definition of instana_website_alert_config field enabled
+var WebsiteAlertConfigSchemaEnabled = &schema.Schema{
+	Type:        schema.TypeBool,
+	Default:     true,
+	Optional:    true,
+	Description: "Configures if the website alert configuration is enabled or not",
+}
+
+//WebsiteAlertConfigSchemaTagFilter schema field definition of instana_website_alert_config field tag_filter
+var WebsiteAlertConfigSchemaTagFilter = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	MinItems: 0,
+	MaxItems: 1024,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			WebsiteAlertConfigFieldTagFilterName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the tag",
+			},
+			WebsiteAlertConfigFieldTagFilterOperator: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(restapi.SupportedExpressionOperators, false),
+				Description:  "The operator of the tag filter",
+			},
+			WebsiteAlertConfigFieldTagFilterValue: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The value of the tag filter",
+			},
+		},
+	},
+	Description: "Configures the tag filters which limit the beacons considered by the website alert configuration",
+}
+
+//WebsiteAlertConfigSchemaRule schema field definition of instana_website_alert_config field rule
+var WebsiteAlertConfigSchemaRule = &schema.Schema{
+	Type:     schema.TypeList,
+	Required: true,
+	MinItems: 1,
+	MaxItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			WebsiteAlertConfigFieldRuleSlowness: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      0,
+				MaxItems:      1,
+				ConflictsWith: []string{websiteAlertConfigRuleStatusCodePath, websiteAlertConfigRuleSpecificJsErrorPath, websiteAlertConfigRuleThroughputPath},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						WebsiteAlertConfigFieldRuleMetricName: websiteAlertConfigSchemaRuleMetricName,
+						WebsiteAlertConfigFieldRuleAggregation: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(restapi.SupportedWebsiteAlertAggregations, false),
+							Description:  "The aggregation of the metric",
+						},
+					},
+				},
+				Description: "Configures a rule which alerts on the slowness of the website",
+			},
+			WebsiteAlertConfigFieldRuleStatusCode: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      0,
+				MaxItems:      1,
+				ConflictsWith: []string{websiteAlertConfigRuleSlownessPath, websiteAlertConfigRuleSpecificJsErrorPath, websiteAlertConfigRuleThroughputPath},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						WebsiteAlertConfigFieldRuleMetricName: websiteAlertConfigSchemaRuleMetricName,
+						WebsiteAlertConfigFieldRuleOperator:   websiteAlertConfigSchemaRuleOperator,
+						WebsiteAlertConfigFieldRuleValue: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The status code which is matched",
+						},
+					},
+				},
+				Description: "Configures a rule which alerts on HTTP status codes of the website",
+			},
+			WebsiteAlertConfigFieldRuleSpecificJsError: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      0,
+				MaxItems:      1,
+				ConflictsWith: []string{websiteAlertConfigRuleSlownessPath, websiteAlertConfigRuleStatusCodePath, websiteAlertConfigRuleThroughputPath},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						WebsiteAlertConfigFieldRuleMetricName: websiteAlertConfigSchemaRuleMetricName,
+						WebsiteAlertConfigFieldRuleOperator:   websiteAlertConfigSchemaRuleOperator,
+						WebsiteAlertConfigFieldRuleValue: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The JS error message which is matched",
+						},
+					},
+				},
+				Description: "Configures a rule which alerts on specific JS errors of the website",
+			},
+			WebsiteAlertConfigFieldRuleThroughput: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      0,
+				MaxItems:      1,
+				ConflictsWith: []string{websiteAlertConfigRuleSlownessPath, websiteAlertConfigRuleStatusCodePath, websiteAlertConfigRuleSpecificJsErrorPath},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						WebsiteAlertConfigFieldRuleMetricName: websiteAlertConfigSchemaRuleMetricName,
+						WebsiteAlertConfigFieldRuleAggregation: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(restapi.SupportedWebsiteAlertAggregations, false),
+							Description:  "The aggregation of the metric",
+						},
+					},
+				},
+				Description: "Configures a rule which alerts on the throughput of the website",
+			},
+		},
+	},
+	Description: "Configures the rule of the website alert configuration. Exactly one rule type must be configured",
+}
+
+//WebsiteAlertConfigSchemaThreshold schema field definition of instana_website_alert_config field threshold
+var WebsiteAlertConfigSchemaThreshold = &schema.Schema{
+	Type:     schema.TypeList,
+	Required: true,
+	MinItems: 1,
+	MaxItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			WebsiteAlertConfigFieldThresholdOperator: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(restapi.SupportedThresholdOperators, false),
+				Description:  "The operator of the static threshold",
+			},
+			WebsiteAlertConfigFieldThresholdValue: {
+				Type:        schema.TypeFloat,
+				Required:    true,
+				Description: "The value of the static threshold",
+			},
+		},
+	},
+	Description: "Configures the static threshold of the website alert configuration",
+}
+
+//WebsiteAlertConfigSchemaTimeThreshold schema field definition of instana_website_alert_config field time_threshold
+var WebsiteAlertConfigSchemaTimeThreshold = &schema.Schema{
+	Type:     schema.TypeList,
+	Required: true,
+	MinItems: 1,
+	MaxItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			WebsiteAlertConfigFieldTimeThresholdViolationsInSequence: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      0,
+				MaxItems:      1,
+				ConflictsWith: []string{websiteAlertConfigTimeThresholdViolationsInPeriodPath, websiteAlertConfigTimeThresholdUserImpactOfViolationsInSequencePath},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						WebsiteAlertConfigFieldTimeThresholdTimeWindow: websiteAlertConfigSchemaTimeThresholdTimeWindow,
+					},
+				},
+				Description: "Configures a time threshold which is violated when the threshold is violated in sequence during the time window",
+			},
+			WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      0,
+				MaxItems:      1,
+				ConflictsWith: []string{websiteAlertConfigTimeThresholdViolationsInSequencePath, websiteAlertConfigTimeThresholdUserImpactOfViolationsInSequencePath},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						WebsiteAlertConfigFieldTimeThresholdTimeWindow: websiteAlertConfigSchemaTimeThresholdTimeWindow,
+						WebsiteAlertConfigFieldTimeThresholdViolations: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 12),
+							Description:  "The number of violations within the time window",
+						},
+					},
+				},
+				Description: "Configures a time threshold which is violated when the threshold is violated a given number of times during the time window",
+			},
+			WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      0,
+				MaxItems:      1,
+				ConflictsWith: []string{websiteAlertConfigTimeThresholdViolationsInSequencePath, websiteAlertConfigTimeThresholdViolationsInPeriodPath},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						WebsiteAlertConfigFieldTimeThresholdTimeWindow: websiteAlertConfigSchemaTimeThresholdTimeWindow,
+						WebsiteAlertConfigFieldTimeThresholdUsers: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of impacted users",
+						},
+						WebsiteAlertConfigFieldTimeThresholdUserPercentage: {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatBetween(0, 1),
+							Description:  "The percentage of impacted users (greater than 0 and less or equal than 1)",
+						},
+					},
+				},
+				Description: "Configures a time threshold which is violated when the threshold is violated in sequence for a given number or percentage of users during the time window",
+			},
+		},
+	},
+	Description: "Configures the time threshold of the website alert configuration. Exactly one time threshold type must be configured",
+}
+
+//NewWebsiteAlertConfigResourceHandle creates the resource handle for Website Alert Configurations
+func NewWebsiteAlertConfigResourceHandle() *ResourceHandle {
+	return &ResourceHandle{
+		ResourceName: ResourceInstanaWebsiteAlertConfig,
+		Schema: map[string]*schema.Schema{
+			WebsiteAlertConfigFieldName:            WebsiteAlertConfigSchemaName,
+			WebsiteAlertConfigFieldFullName:        WebsiteAlertConfigSchemaFullName,
+			WebsiteAlertConfigFieldDescription:     WebsiteAlertConfigSchemaDescription,
+			WebsiteAlertConfigFieldWebsiteID:       WebsiteAlertConfigSchemaWebsiteID,
+			WebsiteAlertConfigFieldSeverity:        WebsiteAlertConfigSchemaSeverity,
+			WebsiteAlertConfigFieldTriggering:      WebsiteAlertConfigSchemaTriggering,
+			WebsiteAlertConfigFieldAlertChannelIDs: WebsiteAlertConfigSchemaAlertChannelIDs,
+			WebsiteAlertConfigFieldGranularity:     WebsiteAlertConfigSchemaGranularity,
+			WebsiteAlertConfigFieldEnabled:         WebsiteAlertConfigSchemaEnabled,
+			WebsiteAlertConfigFieldTagFilter:       WebsiteAlertConfigSchemaTagFilter,
+			WebsiteAlertConfigFieldRule:            WebsiteAlertConfigSchemaRule,
+			WebsiteAlertConfigFieldThreshold:       WebsiteAlertConfigSchemaThreshold,
+			WebsiteAlertConfigFieldTimeThreshold:   WebsiteAlertConfigSchemaTimeThreshold,
+		},
+		SchemaVersion:        0,
+		NameField:            WebsiteAlertConfigFieldName,
+		FullNameField:        WebsiteAlertConfigFieldFullName,
+		SkipIDGeneration:     true,
+		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.WebsiteAlertConfig() },
+		UpdateState:          updateStateForWebsiteAlertConfig,
+		MapStateToDataObject: mapStateToDataObjectForWebsiteAlertConfig,
+	}
+}
+
+func updateStateForWebsiteAlertConfig(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
+	config := obj.(restapi.WebsiteAlertConfig)
+	severity, err := ConvertSeverityFromInstanaAPIToTerraformRepresentation(config.Severity)
+	if err != nil {
+		return err
+	}
+
+	d.Set(WebsiteAlertConfigFieldFullName, config.Name)
+	d.Set(WebsiteAlertConfigFieldDescription, config.Description)
+	d.Set(WebsiteAlertConfigFieldWebsiteID, config.WebsiteID)
+	d.Set(WebsiteAlertConfigFieldSeverity, severity)
+	d.Set(WebsiteAlertConfigFieldTriggering, config.Triggering)
+	d.Set(WebsiteAlertConfigFieldAlertChannelIDs, config.AlertChannelIDs)
+	d.Set(WebsiteAlertConfigFieldGranularity, config.Granularity)
+	d.Set(WebsiteAlertConfigFieldEnabled, config.Enabled)
+	d.Set(WebsiteAlertConfigFieldTagFilter, convertWebsiteAlertTagFiltersToState(config.TagFilters))
+	d.Set(WebsiteAlertConfigFieldRule, convertWebsiteAlertRuleToState(config.Rule))
+	d.Set(WebsiteAlertConfigFieldThreshold, []interface{}{
+		map[string]interface{}{
+			WebsiteAlertConfigFieldThresholdOperator: config.Threshold.Operator,
+			WebsiteAlertConfigFieldThresholdValue:    derefFloat64(config.Threshold.Value),
+		},
+	})
+	d.Set(WebsiteAlertConfigFieldTimeThreshold, convertWebsiteTimeThresholdToState(config.TimeThreshold))
+	d.SetId(config.ID)
+	return nil
+}
+
+func convertWebsiteAlertTagFiltersToState(tagFilters []restapi.TagFilter) []interface{} {
+	result := make([]interface{}, len(tagFilters))
+	for i, f := range tagFilters {
+		result[i] = map[string]interface{}{
+			WebsiteAlertConfigFieldTagFilterName:     f.Name,
+			WebsiteAlertConfigFieldTagFilterOperator: f.Operator,
+			WebsiteAlertConfigFieldTagFilterValue:    f.Value,
+		}
+	}
+	return result
+}
+
+func convertWebsiteAlertRuleToState(rule restapi.WebsiteAlertRule) []interface{} {
+	ruleData := map[string]interface{}{
+		WebsiteAlertConfigFieldRuleMetricName: rule.MetricName,
+	}
+	var ruleField string
+	if rule.AlertType == restapi.WebsiteAlertRuleTypeSlowness || rule.AlertType == restapi.WebsiteAlertRuleTypeThroughput {
+		ruleData[WebsiteAlertConfigFieldRuleAggregation] = derefString(rule.Aggregation)
+		ruleField = WebsiteAlertConfigFieldRuleSlowness
+		if rule.AlertType == restapi.WebsiteAlertRuleTypeThroughput {
+			ruleField = WebsiteAlertConfigFieldRuleThroughput
+		}
+	} else {
+		ruleData[WebsiteAlertConfigFieldRuleOperator] = derefString(rule.Operator)
+		ruleData[WebsiteAlertConfigFieldRuleValue] = derefString(rule.Value)
+		ruleField = WebsiteAlertConfigFieldRuleStatusCode
+		if rule.AlertType == restapi.WebsiteAlertRuleTypeSpecificJsError {
+			ruleField = WebsiteAlertConfigFieldRuleSpecificJsError
+		}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			ruleField: []interface{}{ruleData},
+		},
+	}
+}
+
+func convertWebsiteTimeThresholdToState(threshold restapi.WebsiteTimeThreshold) []interface{} {
+	thresholdData := map[string]interface{}{
+		WebsiteAlertConfigFieldTimeThresholdTimeWindow: derefInt64(threshold.TimeWindow),
+	}
+	thresholdField := WebsiteAlertConfigFieldTimeThresholdViolationsInSequence
+	if threshold.Type == restapi.WebsiteTimeThresholdTypeViolationsInPeriod {
+		thresholdField = WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod
+		thresholdData[WebsiteAlertConfigFieldTimeThresholdViolations] = derefInt(threshold.Violations)
+	} else if threshold.Type == restapi.WebsiteTimeThresholdTypeUserImpactOfViolationsInSequence {
+		thresholdField = WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence
+		thresholdData[WebsiteAlertConfigFieldTimeThresholdUsers] = derefInt(threshold.Users)
+		thresholdData[WebsiteAlertConfigFieldTimeThresholdUserPercentage] = derefFloat64(threshold.UserPercentage)
+	}
+	return []interface{}{
+		map[string]interface{}{
+			thresholdField: []interface{}{thresholdData},
+		},
+	}
+}
+
+func mapStateToDataObjectForWebsiteAlertConfig(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	severity, err := ConvertSeverityFromTerraformToInstanaAPIRepresentation(d.Get(WebsiteAlertConfigFieldSeverity).(string))
+	if err != nil {
+		return restapi.WebsiteAlertConfig{}, err
+	}
+	rule, err := readWebsiteAlertRuleFromResourceData(d)
+	if err != nil {
+		return restapi.WebsiteAlertConfig{}, err
+	}
+	timeThreshold, err := readWebsiteTimeThresholdFromResourceData(d)
+	if err != nil {
+		return restapi.WebsiteAlertConfig{}, err
+	}
+
+	alertChannelIDs := ReadStringSetParameterFromResource(d, WebsiteAlertConfigFieldAlertChannelIDs)
+	if alertChannelIDs == nil {
+		alertChannelIDs = []string{}
+	}
+
+	return restapi.WebsiteAlertConfig{
+		ID:              d.Id(),
+		Name:            computeFullWebsiteAlertConfigNameString(d, formatter),
+		Description:     d.Get(WebsiteAlertConfigFieldDescription).(string),
+		WebsiteID:       d.Get(WebsiteAlertConfigFieldWebsiteID).(string),
+		Severity:        severity,
+		Triggering:      d.Get(WebsiteAlertConfigFieldTriggering).(bool),
+		TagFilters:      readWebsiteAlertTagFiltersFromResourceData(d),
+		Rule:            rule,
+		Threshold:       readWebsiteAlertThresholdFromResourceData(d),
+		AlertChannelIDs: alertChannelIDs,
+		Granularity:     d.Get(WebsiteAlertConfigFieldGranularity).(int),
+		TimeThreshold:   timeThreshold,
+		Enabled:         d.Get(WebsiteAlertConfigFieldEnabled).(bool),
+	}, nil
+}
+
+func readWebsiteAlertTagFiltersFromResourceData(d *schema.ResourceData) []restapi.TagFilter {
+	rawTagFilters := d.Get(WebsiteAlertConfigFieldTagFilter).([]interface{})
+	tagFilters := make([]restapi.TagFilter, len(rawTagFilters))
+	for i, rawTagFilter := range rawTagFilters {
+		tagFilter := rawTagFilter.(map[string]interface{})
+		tagFilters[i] = restapi.TagFilter{
+			Name:     tagFilter[WebsiteAlertConfigFieldTagFilterName].(string),
+			Operator: tagFilter[WebsiteAlertConfigFieldTagFilterOperator].(string),
+			Value:    tagFilter[WebsiteAlertConfigFieldTagFilterValue].(string),
+		}
+	}
+	return tagFilters
+}
+
+func readWebsiteAlertRuleFromResourceData(d *schema.ResourceData) (restapi.WebsiteAlertRule, error) {
+	rule, _ := d.Get(WebsiteAlertConfigFieldRule).([]interface{})[0].(map[string]interface{})
+	if ruleData, ok := readSingleNestedBlock(rule, WebsiteAlertConfigFieldRuleSlowness); ok {
+		aggregation := ruleData[WebsiteAlertConfigFieldRuleAggregation].(string)
+		return restapi.WebsiteAlertRule{
+			AlertType:   restapi.WebsiteAlertRuleTypeSlowness,
+			MetricName:  ruleData[WebsiteAlertConfigFieldRuleMetricName].(string),
+			Aggregation: &aggregation,
+		}, nil
+	}
+	if ruleData, ok := readSingleNestedBlock(rule, WebsiteAlertConfigFieldRuleStatusCode); ok {
+		return restapi.WebsiteAlertRule{
+			AlertType:  restapi.WebsiteAlertRuleTypeStatusCode,
+			MetricName: ruleData[WebsiteAlertConfigFieldRuleMetricName].(string),
+			Operator:   readOptionalStringFromMap(ruleData, WebsiteAlertConfigFieldRuleOperator),
+			Value:      readOptionalStringFromMap(ruleData, WebsiteAlertConfigFieldRuleValue),
+		}, nil
+	}
+	if ruleData, ok := readSingleNestedBlock(rule, WebsiteAlertConfigFieldRuleSpecificJsError); ok {
+		return restapi.WebsiteAlertRule{
+			AlertType:  restapi.WebsiteAlertRuleTypeSpecificJsError,
+			MetricName: ruleData[WebsiteAlertConfigFieldRuleMetricName].(string),
+			Operator:   readOptionalStringFromMap(ruleData, WebsiteAlertConfigFieldRuleOperator),
+			Value:      readOptionalStringFromMap(ruleData, WebsiteAlertConfigFieldRuleValue),
+		}, nil
+	}
+	if ruleData, ok := readSingleNestedBlock(rule, WebsiteAlertConfigFieldRuleThroughput); ok {
+		return restapi.WebsiteAlertRule{
+			AlertType:   restapi.WebsiteAlertRuleTypeThroughput,
+			MetricName:  ruleData[WebsiteAlertConfigFieldRuleMetricName].(string),
+			Aggregation: readOptionalStringFromMap(ruleData, WebsiteAlertConfigFieldRuleAggregation),
+		}, nil
+	}
+	return restapi.WebsiteAlertRule{}, errors.New("exactly one rule type must be configured for the website alert configuration")
+}
+
+func readWebsiteAlertThresholdFromResourceData(d *schema.ResourceData) restapi.Threshold {
+	threshold := d.Get(WebsiteAlertConfigFieldThreshold).([]interface{})[0].(map[string]interface{})
+	value := threshold[WebsiteAlertConfigFieldThresholdValue].(float64)
+	return restapi.Threshold{
+		Type:     restapi.ThresholdTypeStatic,
+		Operator: threshold[WebsiteAlertConfigFieldThresholdOperator].(string),
+		Value:    &value,
+	}
+}
+
+func readWebsiteTimeThresholdFromResourceData(d *schema.ResourceData) (restapi.WebsiteTimeThreshold, error) {
+	timeThreshold, _ := d.Get(WebsiteAlertConfigFieldTimeThreshold).([]interface{})[0].(map[string]interface{})
+	if thresholdData, ok := readSingleNestedBlock(timeThreshold, WebsiteAlertConfigFieldTimeThresholdViolationsInSequence); ok {
+		return restapi.WebsiteTimeThreshold{
+			Type:       restapi.WebsiteTimeThresholdTypeViolationsInSequence,
+			TimeWindow: readTimeWindowFromMap(thresholdData),
+		}, nil
+	}
+	if thresholdData, ok := readSingleNestedBlock(timeThreshold, WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod); ok {
+		violations := thresholdData[WebsiteAlertConfigFieldTimeThresholdViolations].(int)
+		return restapi.WebsiteTimeThreshold{
+			Type:       restapi.WebsiteTimeThresholdTypeViolationsInPeriod,
+			TimeWindow: readTimeWindowFromMap(thresholdData),
+			Violations: &violations,
+		}, nil
+	}
+	if thresholdData, ok := readSingleNestedBlock(timeThreshold, WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence); ok {
+		threshold := restapi.WebsiteTimeThreshold{
+			Type:       restapi.WebsiteTimeThresholdTypeUserImpactOfViolationsInSequence,
+			TimeWindow: readTimeWindowFromMap(thresholdData),
+		}
+		if users, ok := thresholdData[WebsiteAlertConfigFieldTimeThresholdUsers].(int); ok && users > 0 {
+			threshold.Users = &users
+		}
+		if userPercentage, ok := thresholdData[WebsiteAlertConfigFieldTimeThresholdUserPercentage].(float64); ok && userPercentage > 0 {
+			threshold.UserPercentage = &userPercentage
+		}
+		return threshold, nil
+	}
+	return restapi.WebsiteTimeThreshold{}, errors.New("exactly one time threshold type must be configured for the website alert configuration")
+}
+
+func readSingleNestedBlock(data map[string]interface{}, key string) (map[string]interface{}, bool) {
+	if blocks, ok := data[key].([]interface{}); ok && len(blocks) == 1 && blocks[0] != nil {
+		return blocks[0].(map[string]interface{}), true
+	}
+	return nil, false
+}
+
+func readOptionalStringFromMap(data map[string]interface{}, key string) *string {
+	if value, ok := data[key].(string); ok && len(value) > 0 {
+		return &value
+	}
+	return nil
+}
+
+func readTimeWindowFromMap(data map[string]interface{}) *int64 {
+	timeWindow := int64(data[WebsiteAlertConfigFieldTimeThresholdTimeWindow].(int))
+	return &timeWindow
+}
+
+func derefString(value *string) string {
+	if value != nil {
+		return *value
+	}
+	return ""
+}
+
+func derefInt(value *int) int {
+	if value != nil {
+		return *value
+	}
+	return 0
+}
+
+func derefInt64(value *int64) int {
+	if value != nil {
+		return int(*value)
+	}
+	return 0
+}
+
+func derefFloat64(value *float64) float64 {
+	if value != nil {
+		return *value
+	}
+	return 0
+}
+
+func computeFullWebsiteAlertConfigNameString(d *schema.ResourceData, formatter utils.ResourceNameFormatter) string {
+	if d.HasChange(WebsiteAlertConfigFieldName) {
+		return formatter.Format(d.Get(WebsiteAlertConfigFieldName).(string))
+	}
+	return d.Get(WebsiteAlertConfigFieldFullName).(string)
+}
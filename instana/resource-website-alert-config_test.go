@@ -0,0 +1,465 @@
+package instana_test
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+var testWebsiteAlertConfigProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceWebsiteAlertConfigDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+  default_name_prefix = "prefix"
+  default_name_suffix = "suffix"
+}
+
+resource "instana_website_alert_config" "example" {
+  name              = "name {{ITERATOR}}"
+  description       = "description"
+  website_id        = "website-id"
+  severity          = "warning"
+  alert_channel_ids = [ "channel-1" ]
+
+  tag_filter {
+    name     = "beacon.page.name"
+    operator = "EQUALS"
+    value    = "home"
+  }
+
+  rule {
+    slowness {
+      metric_name = "onLoadTime"
+      aggregation = "P90"
+    }
+  }
+
+  threshold {
+    operator = ">="
+    value    = 5000
+  }
+
+  time_threshold {
+    violations_in_period {
+      time_window = 600000
+      violations  = 3
+    }
+  }
+}
+`
+
+const websiteAlertConfigServerResponseTemplate = `
+{
+	"id"              : "{{id}}",
+	"name"            : "prefix name 0 suffix",
+	"description"     : "description",
+	"websiteId"       : "website-id",
+	"severity"        : 5,
+	"triggering"      : false,
+	"tagFilters"      : [ { "name" : "beacon.page.name", "operator" : "EQUALS", "value" : "home" } ],
+	"rule"            : { "alertType" : "slowness", "metricName" : "onLoadTime", "aggregation" : "P90" },
+	"threshold"       : { "type" : "staticThreshold", "operator" : ">=", "value" : 5000 },
+	"alertChannelIds" : [ "channel-1" ],
+	"granularity"     : 600000,
+	"timeThreshold"   : { "type" : "violationsInPeriod", "timeWindow" : 600000, "violations" : 3 },
+	"enabled"         : true
+}
+`
+
+const websiteAlertConfigApiPath = restapi.WebsiteAlertConfigResourcePath + "/{id}"
+const testWebsiteAlertConfigDefinition = "instana_website_alert_config.example"
+const websiteAlertConfigID = "website-alert-config-id"
+const websiteAlertConfigWebsiteID = "website-id"
+
+func TestCRUDOfWebsiteAlertConfigResourceWithMockServer(t *testing.T) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	writeResponse := func(id string, w http.ResponseWriter) {
+		json := strings.ReplaceAll(websiteAlertConfigServerResponseTemplate, "{{id}}", id)
+		w.Header().Set(contentType, "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(json))
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.WebsiteAlertConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		writeResponse(websiteAlertConfigID, w)
+	})
+	httpServer.AddRoute(http.MethodPost, websiteAlertConfigApiPath, func(w http.ResponseWriter, r *http.Request) {
+		writeResponse(mux.Vars(r)["id"], w)
+	})
+	httpServer.AddRoute(http.MethodGet, websiteAlertConfigApiPath, func(w http.ResponseWriter, r *http.Request) {
+		writeResponse(mux.Vars(r)["id"], w)
+	})
+	httpServer.AddRoute(http.MethodDelete, websiteAlertConfigApiPath, testutils.EchoHandlerFunc)
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceWebsiteAlertConfigDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+	resourceDefinition = strings.ReplaceAll(resourceDefinition, iteratorPlaceholder, "0")
+
+	ruleSlowness := WebsiteAlertConfigFieldRule + ".0." + WebsiteAlertConfigFieldRuleSlowness + ".0."
+	violationsInPeriod := WebsiteAlertConfigFieldTimeThreshold + ".0." + WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod + ".0."
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testWebsiteAlertConfigProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinition,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testWebsiteAlertConfigDefinition, "id", websiteAlertConfigID),
+					resource.TestCheckResourceAttr(testWebsiteAlertConfigDefinition, WebsiteAlertConfigFieldName, "name 0"),
+					resource.TestCheckResourceAttr(testWebsiteAlertConfigDefinition, WebsiteAlertConfigFieldFullName, "prefix name 0 suffix"),
+					resource.TestCheckResourceAttr(testWebsiteAlertConfigDefinition, WebsiteAlertConfigFieldWebsiteID, websiteAlertConfigWebsiteID),
+					resource.TestCheckResourceAttr(testWebsiteAlertConfigDefinition, WebsiteAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation()),
+					resource.TestCheckResourceAttr(testWebsiteAlertConfigDefinition, WebsiteAlertConfigFieldEnabled, "true"),
+					resource.TestCheckResourceAttr(testWebsiteAlertConfigDefinition, WebsiteAlertConfigFieldTagFilter+".0."+WebsiteAlertConfigFieldTagFilterName, "beacon.page.name"),
+					resource.TestCheckResourceAttr(testWebsiteAlertConfigDefinition, ruleSlowness+WebsiteAlertConfigFieldRuleMetricName, "onLoadTime"),
+					resource.TestCheckResourceAttr(testWebsiteAlertConfigDefinition, ruleSlowness+WebsiteAlertConfigFieldRuleAggregation, "P90"),
+					resource.TestCheckResourceAttr(testWebsiteAlertConfigDefinition, WebsiteAlertConfigFieldThreshold+".0."+WebsiteAlertConfigFieldThresholdValue, "5000"),
+					resource.TestCheckResourceAttr(testWebsiteAlertConfigDefinition, violationsInPeriod+WebsiteAlertConfigFieldTimeThresholdTimeWindow, "600000"),
+					resource.TestCheckResourceAttr(testWebsiteAlertConfigDefinition, violationsInPeriod+WebsiteAlertConfigFieldTimeThresholdViolations, "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestWebsiteAlertConfigSchemaDefinitionIsValid(t *testing.T) {
+	schemaMap := NewWebsiteAlertConfigResourceHandle().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteAlertConfigFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(WebsiteAlertConfigFieldFullName)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteAlertConfigFieldDescription)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteAlertConfigFieldWebsiteID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteAlertConfigFieldSeverity)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(WebsiteAlertConfigFieldTriggering, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(WebsiteAlertConfigFieldEnabled, true)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(WebsiteAlertConfigFieldAlertChannelIDs)
+	assert.Equal(t, schema.TypeInt, schemaMap[WebsiteAlertConfigFieldGranularity].Type)
+	assert.Equal(t, 600000, schemaMap[WebsiteAlertConfigFieldGranularity].Default)
+	assert.True(t, schemaMap[WebsiteAlertConfigFieldRule].Required)
+	assert.Equal(t, 1, schemaMap[WebsiteAlertConfigFieldRule].MaxItems)
+	assert.True(t, schemaMap[WebsiteAlertConfigFieldThreshold].Required)
+	assert.True(t, schemaMap[WebsiteAlertConfigFieldTimeThreshold].Required)
+	assert.True(t, schemaMap[WebsiteAlertConfigFieldTagFilter].Optional)
+}
+
+func TestWebsiteAlertConfigSchemaShouldDefineOneNestedBlockPerRuleType(t *testing.T) {
+	ruleSchema := NewWebsiteAlertConfigResourceHandle().Schema[WebsiteAlertConfigFieldRule].Elem.(*schema.Resource).Schema
+
+	for _, ruleType := range []string{WebsiteAlertConfigFieldRuleSlowness, WebsiteAlertConfigFieldRuleStatusCode, WebsiteAlertConfigFieldRuleSpecificJsError, WebsiteAlertConfigFieldRuleThroughput} {
+		assert.Equal(t, schema.TypeList, ruleSchema[ruleType].Type)
+		assert.True(t, ruleSchema[ruleType].Optional)
+		assert.Equal(t, 1, ruleSchema[ruleType].MaxItems)
+		assert.Len(t, ruleSchema[ruleType].ConflictsWith, 3)
+	}
+}
+
+func TestWebsiteAlertConfigSchemaShouldDefineOneNestedBlockPerTimeThresholdType(t *testing.T) {
+	timeThresholdSchema := NewWebsiteAlertConfigResourceHandle().Schema[WebsiteAlertConfigFieldTimeThreshold].Elem.(*schema.Resource).Schema
+
+	for _, thresholdType := range []string{WebsiteAlertConfigFieldTimeThresholdViolationsInSequence, WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod, WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence} {
+		assert.Equal(t, schema.TypeList, timeThresholdSchema[thresholdType].Type)
+		assert.True(t, timeThresholdSchema[thresholdType].Optional)
+		assert.Equal(t, 1, timeThresholdSchema[thresholdType].MaxItems)
+		assert.Len(t, timeThresholdSchema[thresholdType].ConflictsWith, 2)
+	}
+}
+
+func TestShouldReturnCorrectResourceNameForWebsiteAlertConfigResource(t *testing.T) {
+	name := NewWebsiteAlertConfigResourceHandle().ResourceName
+
+	assert.Equal(t, "instana_website_alert_config", name)
+}
+
+func TestWebsiteAlertConfigResourceShouldHaveSchemaVersionZero(t *testing.T) {
+	assert.Equal(t, 0, NewWebsiteAlertConfigResourceHandle().SchemaVersion)
+}
+
+func TestWebsiteAlertConfigResourceShouldSkipIDGeneration(t *testing.T) {
+	assert.True(t, NewWebsiteAlertConfigResourceHandle().SkipIDGeneration)
+}
+
+func TestShouldUpdateWebsiteAlertConfigTerraformResourceStateFromModelWithSlownessRuleAndViolationsInSequence(t *testing.T) {
+	aggregation := "P90"
+	config := createTestWebsiteAlertConfigModel()
+	config.Rule = restapi.WebsiteAlertRule{AlertType: restapi.WebsiteAlertRuleTypeSlowness, MetricName: "onLoadTime", Aggregation: &aggregation}
+
+	resourceData := updateWebsiteAlertConfigStateFromModel(t, config)
+
+	assert.Equal(t, websiteAlertConfigID, resourceData.Id())
+	assert.Equal(t, "name", resourceData.Get(WebsiteAlertConfigFieldFullName))
+	assert.Equal(t, "description", resourceData.Get(WebsiteAlertConfigFieldDescription))
+	assert.Equal(t, websiteAlertConfigWebsiteID, resourceData.Get(WebsiteAlertConfigFieldWebsiteID))
+	assert.Equal(t, restapi.SeverityCritical.GetTerraformRepresentation(), resourceData.Get(WebsiteAlertConfigFieldSeverity))
+	assert.True(t, resourceData.Get(WebsiteAlertConfigFieldTriggering).(bool))
+	assert.False(t, resourceData.Get(WebsiteAlertConfigFieldEnabled).(bool))
+	assert.Equal(t, 300000, resourceData.Get(WebsiteAlertConfigFieldGranularity))
+	assert.Equal(t, []string{"channel-1"}, ReadStringSetParameterFromResource(resourceData, WebsiteAlertConfigFieldAlertChannelIDs))
+	assert.Equal(t, "beacon.page.name", resourceData.Get(WebsiteAlertConfigFieldTagFilter+".0."+WebsiteAlertConfigFieldTagFilterName))
+	assert.Equal(t, "EQUALS", resourceData.Get(WebsiteAlertConfigFieldTagFilter+".0."+WebsiteAlertConfigFieldTagFilterOperator))
+	assert.Equal(t, "home", resourceData.Get(WebsiteAlertConfigFieldTagFilter+".0."+WebsiteAlertConfigFieldTagFilterValue))
+	assert.Equal(t, "onLoadTime", resourceData.Get(websiteAlertRulePath(WebsiteAlertConfigFieldRuleSlowness, WebsiteAlertConfigFieldRuleMetricName)))
+	assert.Equal(t, aggregation, resourceData.Get(websiteAlertRulePath(WebsiteAlertConfigFieldRuleSlowness, WebsiteAlertConfigFieldRuleAggregation)))
+	assert.Len(t, resourceData.Get(WebsiteAlertConfigFieldRule+".0."+WebsiteAlertConfigFieldRuleThroughput), 0)
+	assert.Equal(t, ">=", resourceData.Get(WebsiteAlertConfigFieldThreshold+".0."+WebsiteAlertConfigFieldThresholdOperator))
+	assert.Equal(t, 5000.0, resourceData.Get(WebsiteAlertConfigFieldThreshold+".0."+WebsiteAlertConfigFieldThresholdValue))
+	assert.Equal(t, 600000, resourceData.Get(websiteTimeThresholdPath(WebsiteAlertConfigFieldTimeThresholdViolationsInSequence, WebsiteAlertConfigFieldTimeThresholdTimeWindow)))
+}
+
+func TestShouldUpdateWebsiteAlertConfigTerraformResourceStateFromModelWithStatusCodeRuleAndViolationsInPeriod(t *testing.T) {
+	operator := "EQUALS"
+	value := "404"
+	violations := 3
+	config := createTestWebsiteAlertConfigModel()
+	config.Rule = restapi.WebsiteAlertRule{AlertType: restapi.WebsiteAlertRuleTypeStatusCode, MetricName: "httpxxx", Operator: &operator, Value: &value}
+	config.TimeThreshold.Type = restapi.WebsiteTimeThresholdTypeViolationsInPeriod
+	config.TimeThreshold.Violations = &violations
+
+	resourceData := updateWebsiteAlertConfigStateFromModel(t, config)
+
+	assert.Equal(t, "httpxxx", resourceData.Get(websiteAlertRulePath(WebsiteAlertConfigFieldRuleStatusCode, WebsiteAlertConfigFieldRuleMetricName)))
+	assert.Equal(t, operator, resourceData.Get(websiteAlertRulePath(WebsiteAlertConfigFieldRuleStatusCode, WebsiteAlertConfigFieldRuleOperator)))
+	assert.Equal(t, value, resourceData.Get(websiteAlertRulePath(WebsiteAlertConfigFieldRuleStatusCode, WebsiteAlertConfigFieldRuleValue)))
+	assert.Equal(t, 600000, resourceData.Get(websiteTimeThresholdPath(WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod, WebsiteAlertConfigFieldTimeThresholdTimeWindow)))
+	assert.Equal(t, violations, resourceData.Get(websiteTimeThresholdPath(WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod, WebsiteAlertConfigFieldTimeThresholdViolations)))
+}
+
+func TestShouldUpdateWebsiteAlertConfigTerraformResourceStateFromModelWithSpecificJsErrorRuleAndUserImpact(t *testing.T) {
+	operator := "NOT_EMPTY"
+	users := 5
+	config := createTestWebsiteAlertConfigModel()
+	config.Rule = restapi.WebsiteAlertRule{AlertType: restapi.WebsiteAlertRuleTypeSpecificJsError, MetricName: "errors", Operator: &operator}
+	config.TimeThreshold.Type = restapi.WebsiteTimeThresholdTypeUserImpactOfViolationsInSequence
+	config.TimeThreshold.Users = &users
+
+	resourceData := updateWebsiteAlertConfigStateFromModel(t, config)
+
+	assert.Equal(t, "errors", resourceData.Get(websiteAlertRulePath(WebsiteAlertConfigFieldRuleSpecificJsError, WebsiteAlertConfigFieldRuleMetricName)))
+	assert.Equal(t, operator, resourceData.Get(websiteAlertRulePath(WebsiteAlertConfigFieldRuleSpecificJsError, WebsiteAlertConfigFieldRuleOperator)))
+	assert.Equal(t, "", resourceData.Get(websiteAlertRulePath(WebsiteAlertConfigFieldRuleSpecificJsError, WebsiteAlertConfigFieldRuleValue)))
+	assert.Equal(t, users, resourceData.Get(websiteTimeThresholdPath(WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence, WebsiteAlertConfigFieldTimeThresholdUsers)))
+	assert.Equal(t, 0.0, resourceData.Get(websiteTimeThresholdPath(WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence, WebsiteAlertConfigFieldTimeThresholdUserPercentage)))
+}
+
+func TestShouldUpdateWebsiteAlertConfigTerraformResourceStateFromModelWithThroughputRule(t *testing.T) {
+	config := createTestWebsiteAlertConfigModel()
+	config.Rule = restapi.WebsiteAlertRule{AlertType: restapi.WebsiteAlertRuleTypeThroughput, MetricName: "pageViews"}
+
+	resourceData := updateWebsiteAlertConfigStateFromModel(t, config)
+
+	assert.Equal(t, "pageViews", resourceData.Get(websiteAlertRulePath(WebsiteAlertConfigFieldRuleThroughput, WebsiteAlertConfigFieldRuleMetricName)))
+	assert.Equal(t, "", resourceData.Get(websiteAlertRulePath(WebsiteAlertConfigFieldRuleThroughput, WebsiteAlertConfigFieldRuleAggregation)))
+	assert.Len(t, resourceData.Get(WebsiteAlertConfigFieldRule+".0."+WebsiteAlertConfigFieldRuleSlowness), 0)
+}
+
+func TestShouldFailToUpdateWebsiteAlertConfigTerraformResourceStateWhenSeverityIsNotValid(t *testing.T) {
+	config := createTestWebsiteAlertConfigModel()
+	config.Severity = 123
+
+	testHelper := NewTestHelper(t)
+	sut := NewWebsiteAlertConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config)
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldSuccessfullyConvertWebsiteAlertConfigStateWithSlownessRuleAndViolationsInSequenceToDataModel(t *testing.T) {
+	resourceHandle := NewWebsiteAlertConfigResourceHandle()
+	resourceData := createWebsiteAlertConfigResourceData(t, map[string]interface{}{
+		WebsiteAlertConfigFieldRuleSlowness: []interface{}{map[string]interface{}{WebsiteAlertConfigFieldRuleMetricName: "onLoadTime", WebsiteAlertConfigFieldRuleAggregation: "P90"}},
+	}, map[string]interface{}{
+		WebsiteAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{map[string]interface{}{WebsiteAlertConfigFieldTimeThresholdTimeWindow: 600000}},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.Nil(t, err)
+	assert.IsType(t, restapi.WebsiteAlertConfig{}, result)
+	config := result.(restapi.WebsiteAlertConfig)
+	assert.Equal(t, websiteAlertConfigID, config.ID)
+	assert.Equal(t, "prefix name suffix", config.Name)
+	assert.Equal(t, "description", config.Description)
+	assert.Equal(t, websiteAlertConfigWebsiteID, config.WebsiteID)
+	assert.Equal(t, restapi.SeverityWarning.GetAPIRepresentation(), config.Severity)
+	assert.True(t, config.Enabled)
+	assert.Equal(t, 600000, config.Granularity)
+	assert.Equal(t, []string{"channel-1"}, config.AlertChannelIDs)
+	assert.Equal(t, []restapi.TagFilter{{Name: "beacon.page.name", Operator: "EQUALS", Value: "home"}}, config.TagFilters)
+	assert.Equal(t, restapi.WebsiteAlertRuleTypeSlowness, config.Rule.AlertType)
+	assert.Equal(t, "onLoadTime", config.Rule.MetricName)
+	assert.Equal(t, "P90", *config.Rule.Aggregation)
+	assert.Equal(t, restapi.ThresholdTypeStatic, config.Threshold.Type)
+	assert.Equal(t, ">=", config.Threshold.Operator)
+	assert.Equal(t, 5000.0, *config.Threshold.Value)
+	assert.Equal(t, restapi.WebsiteTimeThresholdTypeViolationsInSequence, config.TimeThreshold.Type)
+	assert.Equal(t, int64(600000), *config.TimeThreshold.TimeWindow)
+	assert.Nil(t, config.Validate())
+}
+
+func TestShouldSuccessfullyConvertWebsiteAlertConfigStateWithStatusCodeRuleAndViolationsInPeriodToDataModel(t *testing.T) {
+	resourceHandle := NewWebsiteAlertConfigResourceHandle()
+	resourceData := createWebsiteAlertConfigResourceData(t, map[string]interface{}{
+		WebsiteAlertConfigFieldRuleStatusCode: []interface{}{map[string]interface{}{WebsiteAlertConfigFieldRuleMetricName: "httpxxx", WebsiteAlertConfigFieldRuleOperator: "EQUALS", WebsiteAlertConfigFieldRuleValue: "404"}},
+	}, map[string]interface{}{
+		WebsiteAlertConfigFieldTimeThresholdViolationsInPeriod: []interface{}{map[string]interface{}{WebsiteAlertConfigFieldTimeThresholdTimeWindow: 600000, WebsiteAlertConfigFieldTimeThresholdViolations: 3}},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.Nil(t, err)
+	config := result.(restapi.WebsiteAlertConfig)
+	assert.Equal(t, restapi.WebsiteAlertRuleTypeStatusCode, config.Rule.AlertType)
+	assert.Equal(t, "EQUALS", *config.Rule.Operator)
+	assert.Equal(t, "404", *config.Rule.Value)
+	assert.Nil(t, config.Rule.Aggregation)
+	assert.Equal(t, restapi.WebsiteTimeThresholdTypeViolationsInPeriod, config.TimeThreshold.Type)
+	assert.Equal(t, 3, *config.TimeThreshold.Violations)
+	assert.Nil(t, config.Validate())
+}
+
+func TestShouldSuccessfullyConvertWebsiteAlertConfigStateWithSpecificJsErrorRuleAndUserImpactToDataModel(t *testing.T) {
+	resourceHandle := NewWebsiteAlertConfigResourceHandle()
+	resourceData := createWebsiteAlertConfigResourceData(t, map[string]interface{}{
+		WebsiteAlertConfigFieldRuleSpecificJsError: []interface{}{map[string]interface{}{WebsiteAlertConfigFieldRuleMetricName: "errors", WebsiteAlertConfigFieldRuleOperator: "NOT_EMPTY"}},
+	}, map[string]interface{}{
+		WebsiteAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence: []interface{}{map[string]interface{}{WebsiteAlertConfigFieldTimeThresholdTimeWindow: 600000, WebsiteAlertConfigFieldTimeThresholdUserPercentage: 0.5}},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.Nil(t, err)
+	config := result.(restapi.WebsiteAlertConfig)
+	assert.Equal(t, restapi.WebsiteAlertRuleTypeSpecificJsError, config.Rule.AlertType)
+	assert.Equal(t, "NOT_EMPTY", *config.Rule.Operator)
+	assert.Nil(t, config.Rule.Value)
+	assert.Equal(t, restapi.WebsiteTimeThresholdTypeUserImpactOfViolationsInSequence, config.TimeThreshold.Type)
+	assert.Nil(t, config.TimeThreshold.Users)
+	assert.Equal(t, 0.5, *config.TimeThreshold.UserPercentage)
+	assert.Nil(t, config.Validate())
+}
+
+func TestShouldSuccessfullyConvertWebsiteAlertConfigStateWithThroughputRuleToDataModel(t *testing.T) {
+	resourceHandle := NewWebsiteAlertConfigResourceHandle()
+	resourceData := createWebsiteAlertConfigResourceData(t, map[string]interface{}{
+		WebsiteAlertConfigFieldRuleThroughput: []interface{}{map[string]interface{}{WebsiteAlertConfigFieldRuleMetricName: "pageViews"}},
+	}, map[string]interface{}{
+		WebsiteAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{map[string]interface{}{WebsiteAlertConfigFieldTimeThresholdTimeWindow: 600000}},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.Nil(t, err)
+	config := result.(restapi.WebsiteAlertConfig)
+	assert.Equal(t, restapi.WebsiteAlertRuleTypeThroughput, config.Rule.AlertType)
+	assert.Equal(t, "pageViews", config.Rule.MetricName)
+	assert.Nil(t, config.Rule.Aggregation)
+	assert.Nil(t, config.Validate())
+}
+
+func TestShouldFailToConvertWebsiteAlertConfigStateToDataModelWhenNoRuleTypeIsConfigured(t *testing.T) {
+	resourceHandle := NewWebsiteAlertConfigResourceHandle()
+	resourceData := createWebsiteAlertConfigResourceData(t, map[string]interface{}{}, map[string]interface{}{
+		WebsiteAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{map[string]interface{}{WebsiteAlertConfigFieldTimeThresholdTimeWindow: 600000}},
+	})
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "exactly one rule type")
+}
+
+func TestShouldFailToConvertWebsiteAlertConfigStateToDataModelWhenNoTimeThresholdTypeIsConfigured(t *testing.T) {
+	resourceHandle := NewWebsiteAlertConfigResourceHandle()
+	resourceData := createWebsiteAlertConfigResourceData(t, map[string]interface{}{
+		WebsiteAlertConfigFieldRuleThroughput: []interface{}{map[string]interface{}{WebsiteAlertConfigFieldRuleMetricName: "pageViews"}},
+	}, map[string]interface{}{})
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix", "suffix"))
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "exactly one time threshold type")
+}
+
+func updateWebsiteAlertConfigStateFromModel(t *testing.T, config restapi.WebsiteAlertConfig) *schema.ResourceData {
+	testHelper := NewTestHelper(t)
+	sut := NewWebsiteAlertConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config)
+
+	assert.Nil(t, err)
+	return resourceData
+}
+
+func createTestWebsiteAlertConfigModel() restapi.WebsiteAlertConfig {
+	thresholdValue := 5000.0
+	timeWindow := int64(600000)
+	return restapi.WebsiteAlertConfig{
+		ID:              websiteAlertConfigID,
+		Name:            "name",
+		Description:     "description",
+		WebsiteID:       websiteAlertConfigWebsiteID,
+		Severity:        restapi.SeverityCritical.GetAPIRepresentation(),
+		Triggering:      true,
+		TagFilters:      []restapi.TagFilter{{Name: "beacon.page.name", Operator: "EQUALS", Value: "home"}},
+		Threshold:       restapi.Threshold{Type: restapi.ThresholdTypeStatic, Operator: ">=", Value: &thresholdValue},
+		AlertChannelIDs: []string{"channel-1"},
+		Granularity:     300000,
+		TimeThreshold:   restapi.WebsiteTimeThreshold{Type: restapi.WebsiteTimeThresholdTypeViolationsInSequence, TimeWindow: &timeWindow},
+		Enabled:         false,
+	}
+}
+
+func createWebsiteAlertConfigResourceData(t *testing.T, rule map[string]interface{}, timeThreshold map[string]interface{}) *schema.ResourceData {
+	data := map[string]interface{}{
+		WebsiteAlertConfigFieldName:            "name",
+		WebsiteAlertConfigFieldDescription:     "description",
+		WebsiteAlertConfigFieldWebsiteID:       websiteAlertConfigWebsiteID,
+		WebsiteAlertConfigFieldSeverity:        restapi.SeverityWarning.GetTerraformRepresentation(),
+		WebsiteAlertConfigFieldAlertChannelIDs: []interface{}{"channel-1"},
+		WebsiteAlertConfigFieldTagFilter: []interface{}{
+			map[string]interface{}{
+				WebsiteAlertConfigFieldTagFilterName:     "beacon.page.name",
+				WebsiteAlertConfigFieldTagFilterOperator: "EQUALS",
+				WebsiteAlertConfigFieldTagFilterValue:    "home",
+			},
+		},
+		WebsiteAlertConfigFieldRule: []interface{}{rule},
+		WebsiteAlertConfigFieldThreshold: []interface{}{
+			map[string]interface{}{
+				WebsiteAlertConfigFieldThresholdOperator: ">=",
+				WebsiteAlertConfigFieldThresholdValue:    5000.0,
+			},
+		},
+		WebsiteAlertConfigFieldTimeThreshold: []interface{}{timeThreshold},
+	}
+	resourceData := schema.TestResourceDataRaw(t, NewWebsiteAlertConfigResourceHandle().Schema, data)
+	resourceData.SetId(websiteAlertConfigID)
+	return resourceData
+}
+
+func websiteAlertRulePath(ruleType string, field string) string {
+	return WebsiteAlertConfigFieldRule + ".0." + ruleType + ".0." + field
+}
+
+func websiteTimeThresholdPath(thresholdType string, field string) string {
+	return WebsiteAlertConfigFieldTimeThreshold + ".0." + thresholdType + ".0." + field
+}
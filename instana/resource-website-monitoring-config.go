@@ -0,0 +1,84 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+//ResourceInstanaWebsiteMonitoringConfig the name of the terraform-provider-instana resource to manage website monitoring configurations
+const ResourceInstanaWebsiteMonitoringConfig = "instana_website_monitoring_config"
+
+const (
+	//WebsiteMonitoringConfigFieldName constant value for the schema field name
+	WebsiteMonitoringConfigFieldName = "name"
+	//WebsiteMonitoringConfigFieldFullName constant value for the schema field full_name. The field is computed and contains the name which is sent to instana. The computation depends on the configured default_name_prefix and default_name_suffix at provider level
+	WebsiteMonitoringConfigFieldFullName = "full_name"
+	//WebsiteMonitoringConfigFieldAppName constant value for the schema field app_name
+	WebsiteMonitoringConfigFieldAppName = "app_name"
+)
+
+//WebsiteMonitoringConfigSchemaName schema field definition of instana_website_monitoring_config field name
+var WebsiteMonitoringConfigSchemaName = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	Description:  "Configures the name of the website monitoring configuration",
+	ValidateFunc: validation.StringLenBetween(1, 256),
+}
+
+//WebsiteMonitoringConfigSchemaFullName schema field definition of instana_website_monitoring_config field full_name
+var WebsiteMonitoringConfigSchemaFullName = &schema.Schema{
+	Type:        schema.TypeString,
+	Computed:    true,
+	Description: "The computed full name of the website monitoring configuration. The field contains the name which is sent to instana. The computation depends on the configured default_name_prefix and default_name_suffix at provider level",
+}
+
+//WebsiteMonitoringConfigSchemaAppName schema field definition of instana_website_monitoring_config field app_name
+var WebsiteMonitoringConfigSchemaAppName = &schema.Schema{
+	Type:        schema.TypeString,
+	Computed:    true,
+	Description: "The computed app name of the website monitoring configuration. The app name is assigned by Instana and is required to configure the EUM script of the website",
+}
+
+//NewWebsiteMonitoringConfigResourceHandle creates the resource handle for Website Monitoring Configurations
+func NewWebsiteMonitoringConfigResourceHandle() *ResourceHandle {
+	return &ResourceHandle{
+		ResourceName: ResourceInstanaWebsiteMonitoringConfig,
+		Schema: map[string]*schema.Schema{
+			WebsiteMonitoringConfigFieldName:     WebsiteMonitoringConfigSchemaName,
+			WebsiteMonitoringConfigFieldFullName: WebsiteMonitoringConfigSchemaFullName,
+			WebsiteMonitoringConfigFieldAppName:  WebsiteMonitoringConfigSchemaAppName,
+		},
+		SchemaVersion:        0,
+		NameField:            WebsiteMonitoringConfigFieldName,
+		FullNameField:        WebsiteMonitoringConfigFieldFullName,
+		SkipIDGeneration:     true,
+		RestResourceFactory:  func(api restapi.InstanaAPI) restapi.RestResource { return api.WebsiteMonitoringConfig() },
+		UpdateState:          updateStateForWebsiteMonitoringConfig,
+		MapStateToDataObject: mapStateToDataObjectForWebsiteMonitoringConfig,
+	}
+}
+
+func updateStateForWebsiteMonitoringConfig(d *schema.ResourceData, obj restapi.InstanaDataObject) error {
+	config := obj.(restapi.WebsiteMonitoringConfig)
+	d.Set(WebsiteMonitoringConfigFieldFullName, config.Name)
+	d.Set(WebsiteMonitoringConfigFieldAppName, config.AppName)
+	d.SetId(config.ID)
+	return nil
+}
+
+func mapStateToDataObjectForWebsiteMonitoringConfig(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	return restapi.WebsiteMonitoringConfig{
+		ID:      d.Id(),
+		Name:    computeFullWebsiteMonitoringConfigNameString(d, formatter),
+		AppName: d.Get(WebsiteMonitoringConfigFieldAppName).(string),
+	}, nil
+}
+
+func computeFullWebsiteMonitoringConfigNameString(d *schema.ResourceData, formatter utils.ResourceNameFormatter) string {
+	if d.HasChange(WebsiteMonitoringConfigFieldName) {
+		return formatter.Format(d.Get(WebsiteMonitoringConfigFieldName).(string))
+	}
+	return d.Get(WebsiteMonitoringConfigFieldFullName).(string)
+}
@@ -0,0 +1,156 @@
+package instana_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+var testWebsiteMonitoringConfigProviders = map[string]terraform.ResourceProvider{
+	"instana": Provider(),
+}
+
+const resourceWebsiteMonitoringConfigDefinitionTemplate = `
+provider "instana" {
+  api_token = "test-token"
+  endpoint = "localhost:{{PORT}}"
+  default_name_prefix = "prefix"
+  default_name_suffix = "suffix"
+}
+
+resource "instana_website_monitoring_config" "example" {
+  name = "name {{ITERATOR}}"
+}
+`
+
+const websiteMonitoringConfigServerResponseTemplate = `
+{
+	"id"      : "%s",
+	"name"    : "%s",
+	"appName" : "website-monitoring-config-app-name"
+}
+`
+
+const websiteMonitoringConfigApiPath = restapi.WebsiteMonitoringConfigResourcePath + "/{id}"
+const testWebsiteMonitoringConfigDefinition = "instana_website_monitoring_config.example"
+const websiteMonitoringConfigID = "website-monitoring-config-id"
+const websiteMonitoringConfigAppName = "website-monitoring-config-app-name"
+
+func TestCRUDOfWebsiteMonitoringConfigResourceWithMockServer(t *testing.T) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	serverResponseHandler := func(id string, w http.ResponseWriter, r *http.Request) {
+		json := fmt.Sprintf(websiteMonitoringConfigServerResponseTemplate, id, r.URL.Query().Get("name"))
+		w.Header().Set(contentType, "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(json))
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.WebsiteMonitoringConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		serverResponseHandler(websiteMonitoringConfigID, w, r)
+	})
+	httpServer.AddRoute(http.MethodPut, websiteMonitoringConfigApiPath, func(w http.ResponseWriter, r *http.Request) {
+		serverResponseHandler(mux.Vars(r)["id"], w, r)
+	})
+	httpServer.AddRoute(http.MethodDelete, websiteMonitoringConfigApiPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, websiteMonitoringConfigApiPath, func(w http.ResponseWriter, r *http.Request) {
+		json := fmt.Sprintf(websiteMonitoringConfigServerResponseTemplate, mux.Vars(r)["id"], "prefix name 0 suffix")
+		w.Header().Set(contentType, "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(json))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := strings.ReplaceAll(resourceWebsiteMonitoringConfigDefinitionTemplate, "{{PORT}}", strconv.Itoa(httpServer.GetPort()))
+	resourceDefinition = strings.ReplaceAll(resourceDefinition, iteratorPlaceholder, "0")
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testWebsiteMonitoringConfigProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinition,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testWebsiteMonitoringConfigDefinition, "id", websiteMonitoringConfigID),
+					resource.TestCheckResourceAttr(testWebsiteMonitoringConfigDefinition, WebsiteMonitoringConfigFieldName, "name 0"),
+					resource.TestCheckResourceAttr(testWebsiteMonitoringConfigDefinition, WebsiteMonitoringConfigFieldFullName, "prefix name 0 suffix"),
+					resource.TestCheckResourceAttr(testWebsiteMonitoringConfigDefinition, WebsiteMonitoringConfigFieldAppName, websiteMonitoringConfigAppName),
+				),
+			},
+		},
+	})
+}
+
+func TestWebsiteMonitoringConfigSchemaDefinitionIsValid(t *testing.T) {
+	schema := NewWebsiteMonitoringConfigResourceHandle().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteMonitoringConfigFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(WebsiteMonitoringConfigFieldFullName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(WebsiteMonitoringConfigFieldAppName)
+}
+
+func TestShouldReturnCorrectResourceNameForWebsiteMonitoringConfigResource(t *testing.T) {
+	name := NewWebsiteMonitoringConfigResourceHandle().ResourceName
+
+	assert.Equal(t, "instana_website_monitoring_config", name)
+}
+
+func TestWebsiteMonitoringConfigResourceShouldHaveSchemaVersionZero(t *testing.T) {
+	assert.Equal(t, 0, NewWebsiteMonitoringConfigResourceHandle().SchemaVersion)
+}
+
+func TestWebsiteMonitoringConfigResourceShouldSkipIDGeneration(t *testing.T) {
+	assert.True(t, NewWebsiteMonitoringConfigResourceHandle().SkipIDGeneration)
+}
+
+func TestShouldUpdateWebsiteMonitoringConfigTerraformResourceStateFromModel(t *testing.T) {
+	name := "name"
+	config := restapi.WebsiteMonitoringConfig{
+		ID:      websiteMonitoringConfigID,
+		Name:    name,
+		AppName: websiteMonitoringConfigAppName,
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewWebsiteMonitoringConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config)
+
+	assert.Nil(t, err)
+	assert.Equal(t, websiteMonitoringConfigID, resourceData.Id())
+	assert.Equal(t, name, resourceData.Get(WebsiteMonitoringConfigFieldFullName))
+	assert.Equal(t, websiteMonitoringConfigAppName, resourceData.Get(WebsiteMonitoringConfigFieldAppName))
+}
+
+func TestShouldSuccessfullyConvertWebsiteMonitoringConfigStateToDataModel(t *testing.T) {
+	name := "name"
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewWebsiteMonitoringConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(websiteMonitoringConfigID)
+	resourceData.Set(WebsiteMonitoringConfigFieldFullName, name)
+	resourceData.Set(WebsiteMonitoringConfigFieldAppName, websiteMonitoringConfigAppName)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, utils.NewResourceNameFormatter("prefix ", " suffix"))
+
+	assert.Nil(t, err)
+	assert.IsType(t, restapi.WebsiteMonitoringConfig{}, result)
+	config := result.(restapi.WebsiteMonitoringConfig)
+	assert.Equal(t, websiteMonitoringConfigID, config.ID)
+	assert.Equal(t, name, config.Name)
+	assert.Equal(t, websiteMonitoringConfigAppName, config.AppName)
+}
@@ -16,15 +16,27 @@ const (
 //InstanaAPI is the interface to all resources of the Instana Rest API
 type InstanaAPI interface {
 	CustomEventSpecifications() RestResource
+	BuiltinEventSpecifications() BuiltinEventSpecificationResource
 	UserRoles() RestResource
 	ApplicationConfigs() RestResource
-	AlertingChannels() RestResource
+	AlertingChannels() AlertingChannelResource
 	AlertingConfigurations() RestResource
+	MaintenanceConfigurations() RestResource
+	WebsiteMonitoringConfig() RestResource
+	WebsiteAlertConfig() RestResource
+	ServiceConfigs() ServiceConfigResource
+	HTTPEndpointConfigs() RestResource
+	SyntheticCallConfig() RestResource
+	APITokens() RestResource
+	Groups() RestResource
+	Users() RestResource
+	TenantUsers() RestResource
+	Releases() RestResource
 }
 
-//NewInstanaAPI creates a new instance of the instana API
-func NewInstanaAPI(apiToken string, endpoint string) InstanaAPI {
-	client := NewClient(apiToken, endpoint)
+//NewInstanaAPI creates a new instance of the instana API for the Instana backend with the given base URL
+func NewInstanaAPI(apiToken string, baseURL string, config ClientConfig) InstanaAPI {
+	client := NewClient(apiToken, baseURL, config)
 	return &baseInstanaAPI{client: client}
 }
 
@@ -37,6 +49,11 @@ func (api *baseInstanaAPI) CustomEventSpecifications() RestResource {
 	return NewRestResource(CustomEventSpecificationResourcePath, NewCustomEventSpecificationUnmarshaller(), api.client)
 }
 
+//BuiltinEventSpecifications implementation of InstanaAPI interface
+func (api *baseInstanaAPI) BuiltinEventSpecifications() BuiltinEventSpecificationResource {
+	return NewBuiltinEventSpecificationResource(api.client)
+}
+
 //UserRoles implementation of InstanaAPI interface
 func (api *baseInstanaAPI) UserRoles() RestResource {
 	return NewRestResource(UserRolesResourcePath, NewUserRoleUnmarshaller(), api.client)
@@ -48,11 +65,66 @@ func (api *baseInstanaAPI) ApplicationConfigs() RestResource {
 }
 
 //AlertingChannels implementation of InstanaAPI interface
-func (api *baseInstanaAPI) AlertingChannels() RestResource {
-	return NewRestResource(AlertingChannelsResourcePath, NewAlertingChannelUnmarshaller(), api.client)
+func (api *baseInstanaAPI) AlertingChannels() AlertingChannelResource {
+	return NewAlertingChannelResource(api.client)
 }
 
 //AlertingConfigurations implementation of InstanaAPI interface
 func (api *baseInstanaAPI) AlertingConfigurations() RestResource {
 	return NewRestResource(AlertsResourcePath, NewAlertingConfigurationUnmarshaller(), api.client)
 }
+
+//MaintenanceConfigurations implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MaintenanceConfigurations() RestResource {
+	return NewRestResource(MaintenanceConfigurationsResourcePath, NewMaintenanceConfigurationUnmarshaller(), api.client)
+}
+
+//WebsiteMonitoringConfig implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteMonitoringConfig() RestResource {
+	return NewWebsiteMonitoringConfigRestResource(api.client)
+}
+
+//WebsiteAlertConfig implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteAlertConfig() RestResource {
+	return NewWebsiteAlertConfigRestResource(api.client)
+}
+
+//ServiceConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ServiceConfigs() ServiceConfigResource {
+	return NewServiceConfigResource(api.client)
+}
+
+//HTTPEndpointConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) HTTPEndpointConfigs() RestResource {
+	return NewRestResource(HTTPEndpointConfigsResourcePath, NewHTTPEndpointConfigUnmarshaller(), api.client)
+}
+
+//SyntheticCallConfig implementation of InstanaAPI interface
+func (api *baseInstanaAPI) SyntheticCallConfig() RestResource {
+	return NewSyntheticCallConfigRestResource(api.client)
+}
+
+//APITokens implementation of InstanaAPI interface
+func (api *baseInstanaAPI) APITokens() RestResource {
+	return NewRestResource(APITokensResourcePath, NewAPITokenUnmarshaller(), api.client)
+}
+
+//Groups implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Groups() RestResource {
+	return NewCreateByPostRestResource(GroupsResourcePath, NewGroupUnmarshaller(), api.client)
+}
+
+//Users implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Users() RestResource {
+	return NewRestResource(UsersResourcePath, NewUserUnmarshaller(), api.client)
+}
+
+//TenantUsers implementation of InstanaAPI interface
+func (api *baseInstanaAPI) TenantUsers() RestResource {
+	return NewTenantUserRestResource(api.client)
+}
+
+//Releases implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Releases() RestResource {
+	return NewCreateByPostRestResource(ReleasesResourcePath, NewReleaseUnmarshaller(), api.client)
+}
@@ -8,13 +8,18 @@ import (
 )
 
 func TestShouldReturnResourcesFromInstanaAPI(t *testing.T) {
-	api := NewInstanaAPI("api-token", "endpoint")
+	api := NewInstanaAPI("api-token", "https://endpoint", DefaultClientConfig)
 
 	t.Run("Should return CustomEventSpecification instance", func(t *testing.T) {
 		resource := api.CustomEventSpecifications()
 
 		assert.NotNil(t, resource)
 	})
+	t.Run("Should return BuiltinEventSpecification instance", func(t *testing.T) {
+		resource := api.BuiltinEventSpecifications()
+
+		assert.NotNil(t, resource)
+	})
 	t.Run("Should return UserRole instance", func(t *testing.T) {
 		resource := api.UserRoles()
 
@@ -33,6 +38,61 @@ func TestShouldReturnResourcesFromInstanaAPI(t *testing.T) {
 	t.Run("Should return AlertingConfiguration instance", func(t *testing.T) {
 		resource := api.AlertingConfigurations()
 
+		assert.NotNil(t, resource)
+	})
+	t.Run("Should return MaintenanceConfiguration instance", func(t *testing.T) {
+		resource := api.MaintenanceConfigurations()
+
+		assert.NotNil(t, resource)
+	})
+	t.Run("Should return WebsiteMonitoringConfig instance", func(t *testing.T) {
+		resource := api.WebsiteMonitoringConfig()
+
+		assert.NotNil(t, resource)
+	})
+	t.Run("Should return WebsiteAlertConfig instance", func(t *testing.T) {
+		resource := api.WebsiteAlertConfig()
+
+		assert.NotNil(t, resource)
+	})
+	t.Run("Should return ServiceConfigs instance", func(t *testing.T) {
+		resource := api.ServiceConfigs()
+
+		assert.NotNil(t, resource)
+	})
+	t.Run("Should return HTTPEndpointConfigs instance", func(t *testing.T) {
+		resource := api.HTTPEndpointConfigs()
+
+		assert.NotNil(t, resource)
+	})
+	t.Run("Should return SyntheticCallConfig instance", func(t *testing.T) {
+		resource := api.SyntheticCallConfig()
+
+		assert.NotNil(t, resource)
+	})
+	t.Run("Should return APITokens instance", func(t *testing.T) {
+		resource := api.APITokens()
+
+		assert.NotNil(t, resource)
+	})
+	t.Run("Should return Groups instance", func(t *testing.T) {
+		resource := api.Groups()
+
+		assert.NotNil(t, resource)
+	})
+	t.Run("Should return Users instance", func(t *testing.T) {
+		resource := api.Users()
+
+		assert.NotNil(t, resource)
+	})
+	t.Run("Should return TenantUsers instance", func(t *testing.T) {
+		resource := api.TenantUsers()
+
+		assert.NotNil(t, resource)
+	})
+	t.Run("Should return Releases instance", func(t *testing.T) {
+		resource := api.Releases()
+
 		assert.NotNil(t, resource)
 	})
 }
@@ -0,0 +1,32 @@
+package restapi
+
+import "context"
+
+//AlertingChannelResource extension of the RestResource for alerting channels which provides the functionality to send test notifications
+type AlertingChannelResource interface {
+	RestResource
+	Test(ctx context.Context, channel AlertingChannel) error
+}
+
+//NewAlertingChannelResource creates a new REST resource for alerting channels
+func NewAlertingChannelResource(client RestClient) AlertingChannelResource {
+	return &alertingChannelResource{
+		RestResource: NewRestResource(AlertingChannelsResourcePath, NewAlertingChannelUnmarshaller(), client),
+		client:       client,
+	}
+}
+
+type alertingChannelResource struct {
+	RestResource
+	client RestClient
+}
+
+//Test sends a test notification through the given alerting channel. An error is returned when Instana is not able to deliver the test notification
+func (r *alertingChannelResource) Test(ctx context.Context, channel AlertingChannel) error {
+	test := AlertingChannelTest(channel)
+	if err := test.Validate(); err != nil {
+		return err
+	}
+	_, err := r.client.Put(ctx, test, AlertingChannelsResourcePath)
+	return err
+}
@@ -0,0 +1,63 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldSendTestNotificationOfAlertingChannel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewAlertingChannelResource(client)
+	channel := createTestAlertingChannelForTestNotification()
+
+	client.EXPECT().Put(gomock.Any(), AlertingChannelTest(channel), AlertingChannelsResourcePath).Return([]byte{}, nil)
+
+	err := sut.Test(context.Background(), channel)
+
+	assert.Nil(t, err)
+}
+
+func TestShouldFailToSendTestNotificationOfAlertingChannelWhenChannelIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewAlertingChannelResource(client)
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	err := sut.Test(context.Background(), AlertingChannel{ID: idFieldValue, Name: nameFieldValue, Kind: SlackChannelType})
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToSendTestNotificationOfAlertingChannelWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewAlertingChannelResource(client)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), AlertingChannelsResourcePath).Return(nil, expectedError)
+
+	err := sut.Test(context.Background(), createTestAlertingChannelForTestNotification())
+
+	assert.Equal(t, expectedError, err)
+}
+
+func createTestAlertingChannelForTestNotification() AlertingChannel {
+	webhookURL := "https://my-webhook.example.com"
+	return AlertingChannel{
+		ID:         idFieldValue,
+		Name:       nameFieldValue,
+		Kind:       SlackChannelType,
+		WebhookURL: &webhookURL,
+	}
+}
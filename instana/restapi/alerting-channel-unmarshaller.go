@@ -20,3 +20,8 @@ func (u *alertingChannelUnmarshaller) Unmarshal(data []byte) (InstanaDataObject,
 	}
 	return alertingChannel, nil
 }
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *alertingChannelUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
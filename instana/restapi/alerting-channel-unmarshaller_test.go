@@ -53,3 +53,32 @@ func TestShouldReturnEmptyAlertingChannelWhenJsonObjectIsReturnWhereNoFiledMatch
 	assert.Nil(t, err)
 	assert.Equal(t, AlertingChannel{}, result)
 }
+
+func TestShouldSuccessfullyUnmarshalArrayOfAlertingChannels(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"name" : "test-name-1",
+		"kind" : "EMAIL",
+		"emails" : ["test-email1"]
+	},{
+		"id" : "test-id-2",
+		"name" : "test-name-2",
+		"kind" : "SLACK",
+		"webhookUrl" : "test-url"
+	}]`
+
+	result, err := NewAlertingChannelUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "test-id-1", result[0].(AlertingChannel).ID)
+	assert.Equal(t, "test-id-2", result[1].(AlertingChannel).ID)
+}
+
+func TestShouldFailToUnmarshalArrayOfAlertingChannelsWhenResponseIsAJsonObject(t *testing.T) {
+	response := `{"id" : "test-id"}`
+
+	_, err := NewAlertingChannelUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.NotNil(t, err)
+}
@@ -10,6 +10,9 @@ import (
 //AlertingChannelsResourcePath path to Alerting channels resource of Instana RESTful API
 const AlertingChannelsResourcePath = EventSettingsBasePath + "/alertingChannels"
 
+//AlertingChannelTestPathElement the path element of the test sub resource of the alerting channel resource
+const AlertingChannelTestPathElement = "test"
+
 //AlertingChannelType type of the alerting channel
 type AlertingChannelType string
 
@@ -24,12 +27,16 @@ const (
 	OpsGenieChannelType = AlertingChannelType("OPS_GENIE")
 	//PagerDutyChannelType constant value for alerting channel type PAGER_DUTY
 	PagerDutyChannelType = AlertingChannelType("PAGER_DUTY")
+	//PrometheusWebhookChannelType constant value for alerting channel type PROMETHEUS_WEBHOOK
+	PrometheusWebhookChannelType = AlertingChannelType("PROMETHEUS_WEBHOOK")
 	//SlackChannelType constant value for alerting channel type SLACK
 	SlackChannelType = AlertingChannelType("SLACK")
 	//SplunkChannelType constant value for alerting channel type SPLUNK
 	SplunkChannelType = AlertingChannelType("SPLUNK")
 	//VictorOpsChannelType constant value for alerting channel type VICTOR_OPS
 	VictorOpsChannelType = AlertingChannelType("VICTOR_OPS")
+	//WebexTeamsWebhookChannelType constant value for alerting channel type WEBEX_TEAMS_WEBHOOK
+	WebexTeamsWebhookChannelType = AlertingChannelType("WEBEX_TEAMS_WEBHOOK")
 	//WebhookChannelType constant value for alerting channel type WEB_HOOK
 	WebhookChannelType = AlertingChannelType("WEB_HOOK")
 )
@@ -41,9 +48,11 @@ var SupportedAlertingChannels = []AlertingChannelType{
 	Office365ChannelType,
 	OpsGenieChannelType,
 	PagerDutyChannelType,
+	PrometheusWebhookChannelType,
 	SlackChannelType,
 	SplunkChannelType,
 	VictorOpsChannelType,
+	WebexTeamsWebhookChannelType,
 	WebhookChannelType,
 }
 
@@ -92,6 +101,7 @@ type AlertingChannel struct {
 	Token                 *string             `json:"token"`
 	WebhookURLs           []string            `json:"webhookUrls"`
 	Headers               []string            `json:"headers"`
+	Receiver              *string             `json:"receiver"`
 }
 
 //GetID implemention of the interface InstanaDataObject
@@ -114,7 +124,7 @@ func (r AlertingChannel) Validate() error {
 	switch r.Kind {
 	case EmailChannelType:
 		return r.validateEmailIntegration()
-	case GoogleChatChannelType, Office365ChannelType, SlackChannelType:
+	case GoogleChatChannelType, Office365ChannelType, PrometheusWebhookChannelType, SlackChannelType, WebexTeamsWebhookChannelType:
 		return r.validateWebHookBasedIntegrations()
 	case OpsGenieChannelType:
 		return r.validateOpsGenieIntegration()
@@ -194,3 +204,17 @@ func (r AlertingChannel) validateGenericWebHookIntegration() error {
 	}
 	return nil
 }
+
+//AlertingChannelTest is the representation of a test notification of an alerting channel in Instana. The test notification is sent
+//through the sub resource test of the alerting channel resource. Therefore, the ID of the test is the constant path element of the sub resource.
+type AlertingChannelTest AlertingChannel
+
+//GetID implemention of the interface InstanaDataObject
+func (t AlertingChannelTest) GetID() string {
+	return AlertingChannelTestPathElement
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (t AlertingChannelTest) Validate() error {
+	return AlertingChannel(t).Validate()
+}
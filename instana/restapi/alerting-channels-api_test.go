@@ -1,6 +1,7 @@
 package restapi_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -139,7 +140,7 @@ func TestShouldFailToValidateEmailAlteringChannelWhenNoEmailIsProvided(t *testin
 }
 
 func TestShouldSuccussullyValidateConsistentWebhhokBasedAlteringChannel(t *testing.T) {
-	for _, channelType := range []AlertingChannelType{GoogleChatChannelType, Office365ChannelType, SlackChannelType} {
+	for _, channelType := range []AlertingChannelType{GoogleChatChannelType, Office365ChannelType, PrometheusWebhookChannelType, SlackChannelType, WebexTeamsWebhookChannelType} {
 		t.Run(fmt.Sprintf("TestShouldSuccussullyValidateConsistentWebhhokBasedAlteringChannel%s", channelType), func(t *testing.T) {
 			webhookURL := "https://my-webhook.example.com"
 			alertingChannel := AlertingChannel{
@@ -157,7 +158,7 @@ func TestShouldSuccussullyValidateConsistentWebhhokBasedAlteringChannel(t *testi
 }
 
 func TestShouldFailToValidateWebhhokBasedAlteringChannelWhenWebhookUrlIsMissing(t *testing.T) {
-	for _, channelType := range []AlertingChannelType{GoogleChatChannelType, Office365ChannelType, SlackChannelType} {
+	for _, channelType := range []AlertingChannelType{GoogleChatChannelType, Office365ChannelType, PrometheusWebhookChannelType, SlackChannelType, WebexTeamsWebhookChannelType} {
 		t.Run(fmt.Sprintf("TestShouldFailToValidateWebhhokBasedAlteringChannel%sWhenWebhookUrlIsMissing", channelType), func(t *testing.T) {
 			alertingChannel := AlertingChannel{
 				ID:   idFieldValue,
@@ -174,7 +175,7 @@ func TestShouldFailToValidateWebhhokBasedAlteringChannelWhenWebhookUrlIsMissing(
 }
 
 func TestShouldFailToValidateWebhhokBasedAlteringChannelWhenWebhookUrlIsBlank(t *testing.T) {
-	for _, channelType := range []AlertingChannelType{GoogleChatChannelType, Office365ChannelType, SlackChannelType} {
+	for _, channelType := range []AlertingChannelType{GoogleChatChannelType, Office365ChannelType, PrometheusWebhookChannelType, SlackChannelType, WebexTeamsWebhookChannelType} {
 		t.Run(fmt.Sprintf("TestShouldFailToValidateWebhhokBasedAlteringChannel%sWhenWebhookUrlIsBlank", channelType), func(t *testing.T) {
 			webhookURL := " "
 			alertingChannel := AlertingChannel{
@@ -192,6 +193,22 @@ func TestShouldFailToValidateWebhhokBasedAlteringChannelWhenWebhookUrlIsBlank(t
 	}
 }
 
+func TestShouldSuccussullyValidateConsistentPrometheusWebhookAlteringChannelWithReceiver(t *testing.T) {
+	webhookURL := "https://my-alertmanager.example.com/api/v1/alerts"
+	receiver := "receiver"
+	alertingChannel := AlertingChannel{
+		ID:         idFieldValue,
+		Name:       nameFieldValue,
+		Kind:       PrometheusWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Receiver:   &receiver,
+	}
+
+	err := alertingChannel.Validate()
+
+	assert.Nil(t, err)
+}
+
 func TestShouldSuccussullyValidateConsistentOpsGenieAlteringChannel(t *testing.T) {
 	apiKey := apiKeyFieldValue
 	region := EuOpsGenieRegion
@@ -578,3 +595,28 @@ func TestShouldFailToValidateGenericWebhookAlteringChannelWhenNoWebhookUrlIsProv
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "Webhook URLs")
 }
+
+func TestShouldReturnTestPathElementAsIDOfAlertingChannelTest(t *testing.T) {
+	alertingChannelTest := AlertingChannelTest(AlertingChannel{ID: idFieldValue})
+
+	assert.Equal(t, AlertingChannelTestPathElement, alertingChannelTest.GetID())
+}
+
+func TestShouldValidateAlertingChannelTestLikeTheAlertingChannel(t *testing.T) {
+	alertingChannel := AlertingChannel{ID: idFieldValue, Name: nameFieldValue, Kind: EmailChannelType, Emails: []string{email1FieldValue}}
+
+	assert.Nil(t, AlertingChannelTest(alertingChannel).Validate())
+
+	alertingChannel.Emails = []string{}
+	assert.NotNil(t, AlertingChannelTest(alertingChannel).Validate())
+}
+
+func TestShouldMarshalAlertingChannelTestLikeTheAlertingChannel(t *testing.T) {
+	alertingChannel := AlertingChannel{ID: idFieldValue, Name: nameFieldValue, Kind: EmailChannelType, Emails: []string{email1FieldValue}}
+
+	expected, _ := json.Marshal(alertingChannel)
+	result, err := json.Marshal(AlertingChannelTest(alertingChannel))
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, result)
+}
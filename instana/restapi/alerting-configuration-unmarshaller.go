@@ -20,3 +20,8 @@ func (u *alertingConfigurationUnmarshaller) Unmarshal(data []byte) (InstanaDataO
 	}
 	return config, nil
 }
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *alertingConfigurationUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
@@ -56,6 +56,26 @@ func TestShouldSuccessfullyUnmarshalAlertingConfigWithEventTypes(t *testing.T) {
 	assert.Equal(t, []AlertEventType{IncidentAlertEventType, CriticalAlertEventType}, config.EventFilteringConfiguration.EventTypes)
 }
 
+func TestShouldSuccessfullyUnmarshalAlertingConfigWithCustomPayloadAndMuteUntil(t *testing.T) {
+	response := `{
+		"id" : "id",
+		"alertName" : "name",
+		"integrationIds" : [ "integrationId-1" ],
+		"eventFilteringConfiguration" : {
+			"ruleIds" : [ "rule-1" ]
+		},
+		"customPayload" : "team: ops",
+		"muteUntil" : 1609459200000
+	}`
+
+	result, err := NewAlertingConfigurationUnmarshaller().Unmarshal([]byte(response))
+
+	assert.Nil(t, err)
+	config := result.(AlertingConfiguration)
+	assert.Equal(t, "team: ops", *config.CustomPayload)
+	assert.Equal(t, int64(1609459200000), *config.MuteUntil)
+}
+
 func TestShouldFailToUnmarshalAlertingConfigurationWhenResponseIsAJsonArray(t *testing.T) {
 	response := `["test1","test2"]`
 
@@ -80,3 +100,32 @@ func TestShouldReturnEmptyAlertingConfigurationWhenJsonObjectIsReturnWhereNoFile
 	assert.Nil(t, err)
 	assert.Equal(t, AlertingConfiguration{}, result)
 }
+
+func TestShouldSuccessfullyUnmarshalArrayOfAlertingConfigurations(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"alertName" : "test-name-1",
+		"integrationIds" : [ "integration-id-1" ],
+		"eventFilteringConfiguration" : { "ruleIds" : [ "rule-1" ] }
+	},{
+		"id" : "test-id-2",
+		"alertName" : "test-name-2",
+		"integrationIds" : [ "integration-id-2" ],
+		"eventFilteringConfiguration" : { "eventTypes" : [ "incident" ] }
+	}]`
+
+	result, err := NewAlertingConfigurationUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "test-id-1", result[0].(AlertingConfiguration).ID)
+	assert.Equal(t, "test-id-2", result[1].(AlertingConfiguration).ID)
+}
+
+func TestShouldFailToUnmarshalArrayOfAlertingConfigurationsWhenResponseIsAJsonObject(t *testing.T) {
+	response := `{"id" : "test-id"}`
+
+	_, err := NewAlertingConfigurationUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.NotNil(t, err)
+}
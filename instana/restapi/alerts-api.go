@@ -121,6 +121,8 @@ type AlertingConfiguration struct {
 	AlertName                   string                      `json:"alertName"`
 	IntegrationIDs              []string                    `json:"integrationIds"`
 	EventFilteringConfiguration EventFilteringConfiguration `json:"eventFilteringConfiguration"`
+	CustomPayload               *string                     `json:"customPayload,omitempty"`
+	MuteUntil                   *int64                      `json:"muteUntil,omitempty"`
 }
 
 //GetID implemention of the interface InstanaDataObject
@@ -146,5 +148,8 @@ func (c AlertingConfiguration) Validate() error {
 	if !utils.StringSliceElementsAreUnique(c.IntegrationIDs) {
 		return errors.New("IntegrationIDs must be unique")
 	}
+	if c.CustomPayload != nil && len(*c.CustomPayload) > 65536 {
+		return errors.New("CustomPayload not valid; Maximum length of CustomPayload is 65536 characters")
+	}
 	return c.EventFilteringConfiguration.Validate()
 }
@@ -351,3 +351,37 @@ func TestShouldFailToValidateAlertingConfigurationWhenQueryExceedsTheMaximumNumb
 	assert.Contains(t, err.Error(), "Query")
 	assert.Contains(t, err.Error(), "length")
 }
+
+func TestShouldSuccessfullyValidateAlertingConfigurationWithCustomPayloadAndMuteUntil(t *testing.T) {
+	customPayload := "team: ops"
+	muteUntil := int64(1609459200000)
+	config := AlertingConfiguration{
+		ID:        alertingConfigID,
+		AlertName: alertingConfigName,
+		EventFilteringConfiguration: EventFilteringConfiguration{
+			RuleIDs: []string{alertingConfigRuleId1},
+		},
+		CustomPayload: &customPayload,
+		MuteUntil:     &muteUntil,
+	}
+
+	err := config.Validate()
+	assert.Nil(t, err)
+}
+
+func TestShouldFailToValidateAlertingConfigurationWhenCustomPayloadExceedsTheMaximumNumberOfCharacters(t *testing.T) {
+	customPayload := utils.RandomString(65537)
+	config := AlertingConfiguration{
+		ID:        alertingConfigID,
+		AlertName: alertingConfigName,
+		EventFilteringConfiguration: EventFilteringConfiguration{
+			RuleIDs: []string{alertingConfigRuleId1},
+		},
+		CustomPayload: &customPayload,
+	}
+
+	err := config.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "CustomPayload")
+	assert.Contains(t, err.Error(), "length")
+}
@@ -0,0 +1,27 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//NewAPITokenUnmarshaller creates a new Unmarshaller instance for API tokens
+func NewAPITokenUnmarshaller() Unmarshaller {
+	return &apiTokenUnmarshaller{}
+}
+
+type apiTokenUnmarshaller struct{}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *apiTokenUnmarshaller) Unmarshal(data []byte) (InstanaDataObject, error) {
+	apiToken := APIToken{}
+	if err := json.Unmarshal(data, &apiToken); err != nil {
+		return apiToken, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return apiToken, nil
+}
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *apiTokenUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
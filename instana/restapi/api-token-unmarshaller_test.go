@@ -0,0 +1,101 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+func TestShouldSuccessfullyUnmarshalAPIToken(t *testing.T) {
+	apiToken := APIToken{
+		ID:                  "api-token-id",
+		AccessGrantingToken: "api-token-access-granting-token",
+		Name:                "api-token-name",
+		Permissions: Permissions{
+			CanConfigureServiceMapping:        true,
+			CanConfigureEumApplications:       true,
+			CanConfigureMobileAppMonitoring:   true,
+			CanConfigureUsers:                 true,
+			CanInstallNewAgents:               true,
+			CanSeeUsageInformation:            true,
+			CanConfigureIntegrations:          true,
+			CanSeeOnPremiseLicenseInformation: true,
+			CanConfigureRoles:                 true,
+			CanConfigureCustomAlerts:          true,
+			CanConfigureAPITokens:             true,
+			CanConfigureAgentRunMode:          true,
+			CanViewAuditLog:                   true,
+			CanConfigureObjectives:            true,
+			CanConfigureAgents:                true,
+			CanConfigureAuthenticationMethods: true,
+			CanConfigureApplications:          true,
+			CanConfigureTeams:                 true,
+			CanConfigureReleases:              true,
+			CanConfigureLogManagement:         true,
+			CanCreatePublicCustomDashboards:   true,
+			CanViewLogs:                       true,
+			CanViewTraceDetails:               true,
+		},
+	}
+
+	serializedJSON, _ := json.Marshal(apiToken)
+
+	result, err := NewAPITokenUnmarshaller().Unmarshal(serializedJSON)
+
+	assert.Nil(t, err)
+
+	assert.Equal(t, apiToken, result)
+}
+
+func TestShouldFailToUnmarshalAPITokenWhenResponseIsAJsonArray(t *testing.T) {
+	response := `["foo","bar"]`
+
+	_, err := NewAPITokenUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUnmarshalAPITokenWhenResponseIsNotAJsonMessage(t *testing.T) {
+	response := `foo bar`
+
+	_, err := NewAPITokenUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldReturnEmptyAPITokenWhenJsonObjectIsReturnWhereNoFiledMatches(t *testing.T) {
+	response := `{"foo" : "bar" }`
+
+	result, err := NewAPITokenUnmarshaller().Unmarshal([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Equal(t, APIToken{}, result)
+}
+
+func TestShouldSuccessfullyUnmarshalArrayOfAPITokens(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"name" : "test-name-1"
+	},{
+		"id" : "test-id-2",
+		"name" : "test-name-2"
+	}]`
+
+	result, err := NewAPITokenUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "test-id-1", result[0].(APIToken).ID)
+	assert.Equal(t, "test-id-2", result[1].(APIToken).ID)
+}
+
+func TestShouldFailToUnmarshalArrayOfAPITokensWhenResponseIsAJsonObject(t *testing.T) {
+	response := `{"id" : "test-id"}`
+
+	_, err := NewAPITokenUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.NotNil(t, err)
+}
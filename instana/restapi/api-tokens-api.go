@@ -0,0 +1,35 @@
+package restapi
+
+import "errors"
+
+//APITokensResourcePath path to API Token resource of Instana RESTful API
+const APITokensResourcePath = SettingsBasePath + "/api-tokens"
+
+//APIToken is the representation of a API Token in Instana. The ID identifies the API token in the REST API while the
+//AccessGrantingToken is the secret token value which is used to access the Instana API. The permission flags are the same as
+//the ones of user roles and are provided by the embedded Permissions
+type APIToken struct {
+	ID                  string `json:"id"`
+	AccessGrantingToken string `json:"accessGrantingToken"`
+	Name                string `json:"name"`
+	Permissions
+}
+
+//GetID implemention of the interface InstanaDataObject
+func (t APIToken) GetID() string {
+	return t.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (t APIToken) Validate() error {
+	if len(t.ID) == 0 {
+		return errors.New("ID is missing")
+	}
+	if len(t.AccessGrantingToken) == 0 {
+		return errors.New("AccessGrantingToken is missing")
+	}
+	if len(t.Name) == 0 {
+		return errors.New("Name is missing")
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	apiTokenID                  = "api-token-id"
+	apiTokenAccessGrantingToken = "api-token-access-granting-token"
+	apiTokenName                = "api-token-name"
+)
+
+func TestValidMinimalAPIToken(t *testing.T) {
+	apiToken := APIToken{
+		ID:                  apiTokenID,
+		AccessGrantingToken: apiTokenAccessGrantingToken,
+		Name:                apiTokenName,
+	}
+
+	assert.Equal(t, apiTokenID, apiToken.GetID())
+
+	err := apiToken.Validate()
+	assert.Nil(t, err)
+}
+
+func TestValidFullAPIToken(t *testing.T) {
+	apiToken := APIToken{
+		ID:                  apiTokenID,
+		AccessGrantingToken: apiTokenAccessGrantingToken,
+		Name:                apiTokenName,
+		Permissions: Permissions{
+			CanConfigureServiceMapping:        true,
+			CanConfigureEumApplications:       true,
+			CanConfigureMobileAppMonitoring:   true,
+			CanConfigureUsers:                 true,
+			CanInstallNewAgents:               true,
+			CanSeeUsageInformation:            true,
+			CanConfigureIntegrations:          true,
+			CanSeeOnPremiseLicenseInformation: true,
+			CanConfigureRoles:                 true,
+			CanConfigureCustomAlerts:          true,
+			CanConfigureAPITokens:             true,
+			CanConfigureAgentRunMode:          true,
+			CanViewAuditLog:                   true,
+			CanConfigureObjectives:            true,
+			CanConfigureAgents:                true,
+			CanConfigureAuthenticationMethods: true,
+			CanConfigureApplications:          true,
+			CanConfigureTeams:                 true,
+			CanConfigureReleases:              true,
+			CanConfigureLogManagement:         true,
+			CanCreatePublicCustomDashboards:   true,
+			CanViewLogs:                       true,
+			CanViewTraceDetails:               true,
+		},
+	}
+
+	assert.Equal(t, apiTokenID, apiToken.GetID())
+
+	err := apiToken.Validate()
+	assert.Nil(t, err)
+}
+
+func TestInvalidAPITokenBecauseOfMissingId(t *testing.T) {
+	apiToken := APIToken{
+		AccessGrantingToken: apiTokenAccessGrantingToken,
+		Name:                apiTokenName,
+	}
+
+	err := apiToken.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "ID")
+}
+
+func TestInvalidAPITokenBecauseOfMissingAccessGrantingToken(t *testing.T) {
+	apiToken := APIToken{
+		ID:   apiTokenID,
+		Name: apiTokenName,
+	}
+
+	err := apiToken.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "AccessGrantingToken")
+}
+
+func TestInvalidAPITokenBecauseOfMissingName(t *testing.T) {
+	apiToken := APIToken{
+		ID:                  apiTokenID,
+		AccessGrantingToken: apiTokenAccessGrantingToken,
+	}
+
+	err := apiToken.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Name")
+}
@@ -34,6 +34,11 @@ func (u *applicationConfigUnmarshaller) Unmarshal(data []byte) (InstanaDataObjec
 	}, nil
 }
 
+//UnmarshalArray Unmarshaller interface implementation
+func (u *applicationConfigUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
+
 func (u *applicationConfigUnmarshaller) unmarshalMatchSpecification(raw json.RawMessage) (MatchExpression, error) {
 	temp := struct {
 		Dtype MatchExpressionType `json:"type"`
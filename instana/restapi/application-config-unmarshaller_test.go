@@ -102,3 +102,32 @@ func testShouldFailToUnmarashalApplicationConfigWhenOneSideOfBinaryExpressionIsN
 
 	assert.NotNil(t, err)
 }
+
+func TestShouldSuccessfullyUnmarshalArrayOfApplicationConfigs(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"label" : "test-label-1",
+		"scope" : "INCLUDE_NO_DOWNSTREAM",
+		"matchSpecification" : { "type" : "LEAF", "key" : "key", "operator" : "EQUALS", "value" : "value" }
+	},{
+		"id" : "test-id-2",
+		"label" : "test-label-2",
+		"scope" : "INCLUDE_NO_DOWNSTREAM",
+		"matchSpecification" : { "type" : "LEAF", "key" : "key", "operator" : "NOT_EMPTY" }
+	}]`
+
+	result, err := NewApplicationConfigUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "test-id-1", result[0].(ApplicationConfig).ID)
+	assert.Equal(t, "test-id-2", result[1].(ApplicationConfig).ID)
+}
+
+func TestShouldFailToUnmarshalArrayOfApplicationConfigsWhenResponseIsAJsonObject(t *testing.T) {
+	response := `{"id" : "test-id"}`
+
+	_, err := NewApplicationConfigUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,47 @@
+package restapi
+
+import (
+	"context"
+	"fmt"
+)
+
+//BuiltinEventSpecificationResource extension of the RestResource for builtin event specifications which provides the functionality to enable and disable builtin event specifications
+type BuiltinEventSpecificationResource interface {
+	RestResource
+	Enable(ctx context.Context, id string) (InstanaDataObject, error)
+	Disable(ctx context.Context, id string) (InstanaDataObject, error)
+}
+
+//NewBuiltinEventSpecificationResource creates a new REST resource for builtin event specifications
+func NewBuiltinEventSpecificationResource(client RestClient) BuiltinEventSpecificationResource {
+	unmarshaller := NewBuiltinEventSpecificationUnmarshaller()
+	return &builtinEventSpecificationResource{
+		RestResource: NewRestResource(BuiltinEventSpecificationResourcePath, unmarshaller, client),
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type builtinEventSpecificationResource struct {
+	RestResource
+	unmarshaller Unmarshaller
+	client       RestClient
+}
+
+//Enable enables the builtin event specification with the given ID
+func (r *builtinEventSpecificationResource) Enable(ctx context.Context, id string) (InstanaDataObject, error) {
+	return r.toggle(ctx, id, "enable")
+}
+
+//Disable disables the builtin event specification with the given ID
+func (r *builtinEventSpecificationResource) Disable(ctx context.Context, id string) (InstanaDataObject, error) {
+	return r.toggle(ctx, id, "disable")
+}
+
+func (r *builtinEventSpecificationResource) toggle(ctx context.Context, id string, operation string) (InstanaDataObject, error) {
+	response, err := r.client.Post(ctx, nil, fmt.Sprintf("%s/%s/%s", BuiltinEventSpecificationResourcePath, id, operation))
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalAndValidate(r.unmarshaller, response)
+}
@@ -0,0 +1,90 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const builtinEventSpecificationResponse = `{
+	"id" : "builtin-event-specification-id",
+	"shortPluginId" : "host",
+	"name" : "builtin-event-specification-name",
+	"severity" : 5,
+	"triggering" : false,
+	"enabled" : true
+}`
+
+func TestShouldEnableBuiltinEventSpecification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewBuiltinEventSpecificationResource(client)
+
+	client.EXPECT().Post(gomock.Any(), nil, BuiltinEventSpecificationResourcePath+"/"+builtinEventSpecificationID+"/enable").Return([]byte(builtinEventSpecificationResponse), nil)
+
+	result, err := sut.Enable(context.Background(), builtinEventSpecificationID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, builtinEventSpecificationID, result.GetID())
+	assert.True(t, result.(BuiltinEventSpecification).Enabled)
+}
+
+func TestShouldDisableBuiltinEventSpecification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewBuiltinEventSpecificationResource(client)
+
+	client.EXPECT().Post(gomock.Any(), nil, BuiltinEventSpecificationResourcePath+"/"+builtinEventSpecificationID+"/disable").Return([]byte(builtinEventSpecificationResponse), nil)
+
+	result, err := sut.Disable(context.Background(), builtinEventSpecificationID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, builtinEventSpecificationID, result.GetID())
+}
+
+func TestShouldFailToEnableBuiltinEventSpecificationWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewBuiltinEventSpecificationResource(client)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Post(gomock.Any(), nil, gomock.Any()).Return(nil, expectedError)
+
+	_, err := sut.Enable(context.Background(), builtinEventSpecificationID)
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToDisableBuiltinEventSpecificationWhenResponseIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewBuiltinEventSpecificationResource(client)
+
+	client.EXPECT().Post(gomock.Any(), nil, gomock.Any()).Return([]byte(`{"id" : "builtin-event-specification-id"}`), nil)
+
+	_, err := sut.Disable(context.Background(), builtinEventSpecificationID)
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToDisableBuiltinEventSpecificationWhenResponseIsNotAJsonMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewBuiltinEventSpecificationResource(client)
+
+	client.EXPECT().Post(gomock.Any(), nil, gomock.Any()).Return([]byte("foo bar"), nil)
+
+	_, err := sut.Disable(context.Background(), builtinEventSpecificationID)
+
+	assert.NotNil(t, err)
+}
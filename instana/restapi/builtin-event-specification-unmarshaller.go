@@ -0,0 +1,27 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//NewBuiltinEventSpecificationUnmarshaller creates a new Unmarshaller instance for builtin event specifications
+func NewBuiltinEventSpecificationUnmarshaller() Unmarshaller {
+	return &builtinEventSpecificationUnmarshaller{}
+}
+
+type builtinEventSpecificationUnmarshaller struct{}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *builtinEventSpecificationUnmarshaller) Unmarshal(data []byte) (InstanaDataObject, error) {
+	spec := BuiltinEventSpecification{}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return spec, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return spec, nil
+}
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *builtinEventSpecificationUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
@@ -0,0 +1,80 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+func TestShouldSuccessfullyUnmarshalBuiltinEventSpecification(t *testing.T) {
+	description := "event-description"
+	spec := BuiltinEventSpecification{
+		ID:            "event-id",
+		ShortPluginID: "host",
+		Name:          "event-name",
+		Description:   &description,
+		Severity:      SeverityCritical.GetAPIRepresentation(),
+		Triggering:    true,
+		Enabled:       true,
+	}
+
+	serializedJSON, _ := json.Marshal(spec)
+
+	result, err := NewBuiltinEventSpecificationUnmarshaller().Unmarshal(serializedJSON)
+
+	assert.Nil(t, err)
+	assert.Equal(t, spec, result)
+}
+
+func TestShouldFailToUnmarshalBuiltinEventSpecificationWhenResponseIsAJsonArray(t *testing.T) {
+	response := `["foo","bar"]`
+
+	_, err := NewBuiltinEventSpecificationUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUnmarshalBuiltinEventSpecificationWhenResponseIsNotAJsonMessage(t *testing.T) {
+	response := `foo bar`
+
+	_, err := NewBuiltinEventSpecificationUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldSuccessfullyUnmarshalArrayOfBuiltinEventSpecifications(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"shortPluginId" : "host",
+		"name" : "test-name-1",
+		"severity" : 5,
+		"triggering" : false,
+		"enabled" : true
+	},{
+		"id" : "test-id-2",
+		"shortPluginId" : "jvmRuntimePlatform",
+		"name" : "test-name-2",
+		"severity" : 10,
+		"triggering" : true,
+		"enabled" : false
+	}]`
+
+	result, err := NewBuiltinEventSpecificationUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "test-id-1", result[0].(BuiltinEventSpecification).ID)
+	assert.Equal(t, "jvmRuntimePlatform", result[1].(BuiltinEventSpecification).ShortPluginID)
+	assert.Equal(t, 10, result[1].(BuiltinEventSpecification).Severity)
+}
+
+func TestShouldFailToUnmarshalArrayOfBuiltinEventSpecificationsWhenResponseIsAJsonObject(t *testing.T) {
+	response := `{"id" : "test-id"}`
+
+	_, err := NewBuiltinEventSpecificationUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.NotNil(t, err)
+}
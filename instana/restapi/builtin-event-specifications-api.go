@@ -0,0 +1,40 @@
+package restapi
+
+import (
+	"errors"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//BuiltinEventSpecificationResourcePath path to Builtin Event Specification settings resource of Instana RESTful API
+const BuiltinEventSpecificationResourcePath = EventSpecificationBasePath + "/built-in"
+
+//BuiltinEventSpecification is the representation of a builtin event specification in Instana
+type BuiltinEventSpecification struct {
+	ID            string  `json:"id"`
+	ShortPluginID string  `json:"shortPluginId"`
+	Name          string  `json:"name"`
+	Description   *string `json:"description"`
+	Severity      int     `json:"severity"`
+	Triggering    bool    `json:"triggering"`
+	Enabled       bool    `json:"enabled"`
+}
+
+//GetID implemention of the interface InstanaDataObject
+func (spec BuiltinEventSpecification) GetID() string {
+	return spec.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (spec BuiltinEventSpecification) Validate() error {
+	if utils.IsBlank(spec.ID) {
+		return errors.New("ID is missing")
+	}
+	if utils.IsBlank(spec.ShortPluginID) {
+		return errors.New("short plugin ID is missing")
+	}
+	if utils.IsBlank(spec.Name) {
+		return errors.New("name is missing")
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	builtinEventSpecificationID            = "builtin-event-specification-id"
+	builtinEventSpecificationShortPluginID = "host"
+	builtinEventSpecificationName          = "builtin-event-specification-name"
+)
+
+func TestValidBuiltinEventSpecification(t *testing.T) {
+	description := "description"
+	spec := BuiltinEventSpecification{
+		ID:            builtinEventSpecificationID,
+		ShortPluginID: builtinEventSpecificationShortPluginID,
+		Name:          builtinEventSpecificationName,
+		Description:   &description,
+		Severity:      SeverityWarning.GetAPIRepresentation(),
+		Triggering:    true,
+		Enabled:       true,
+	}
+
+	assert.Equal(t, builtinEventSpecificationID, spec.GetID())
+	assert.Nil(t, spec.Validate())
+}
+
+func TestInvalidBuiltinEventSpecificationBecauseOfMissingID(t *testing.T) {
+	spec := BuiltinEventSpecification{
+		ShortPluginID: builtinEventSpecificationShortPluginID,
+		Name:          builtinEventSpecificationName,
+	}
+
+	err := spec.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "ID")
+}
+
+func TestInvalidBuiltinEventSpecificationBecauseOfMissingShortPluginID(t *testing.T) {
+	spec := BuiltinEventSpecification{
+		ID:   builtinEventSpecificationID,
+		Name: builtinEventSpecificationName,
+	}
+
+	err := spec.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "short plugin ID")
+}
+
+func TestInvalidBuiltinEventSpecificationBecauseOfMissingName(t *testing.T) {
+	spec := BuiltinEventSpecification{
+		ID:            builtinEventSpecificationID,
+		ShortPluginID: builtinEventSpecificationShortPluginID,
+	}
+
+	err := spec.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name")
+}
@@ -0,0 +1,41 @@
+package restapi
+
+import "context"
+
+//NewCreateByPostRestResource creates a new REST resource for resources where the ID is assigned by Instana. New resources are created by a
+//HTTP POST request to the resource path. Existing resources are updated by a HTTP PUT request to the path of the resource
+func NewCreateByPostRestResource(resourcePath string, unmarshaller Unmarshaller, client RestClient) RestResource {
+	return &createByPostRestResource{
+		RestResource: NewRestResource(resourcePath, unmarshaller, client),
+		resourcePath: resourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type createByPostRestResource struct {
+	RestResource
+	resourcePath string
+	unmarshaller Unmarshaller
+	client       RestClient
+}
+
+//Upsert creates a new resource when the ID of the given data object is empty. Otherwise the resource with the given ID is updated
+func (r *createByPostRestResource) Upsert(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+
+	var response []byte
+	var err error
+	if data.GetID() == "" {
+		response, err = r.client.Post(ctx, data, r.resourcePath)
+	} else {
+		response, err = r.client.Put(ctx, data, r.resourcePath)
+	}
+	if err != nil {
+		return data, err
+	}
+
+	return unmarshalAndValidate(r.unmarshaller, response)
+}
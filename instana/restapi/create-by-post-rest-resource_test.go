@@ -0,0 +1,105 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const groupResponse = `{
+	"id" : "group-id",
+	"name" : "group-name",
+	"members" : [ { "userId" : "group-member-id", "email" : "member@example.com" } ],
+	"permissionSet" : { "permissions" : [ "CAN_CONFIGURE_APPLICATIONS" ] }
+}`
+
+func TestShouldCreateResourceByPostRequestWhenIDIsNotSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewCreateByPostRestResource(GroupsResourcePath, NewGroupUnmarshaller(), client)
+	group := Group{Name: groupName}
+
+	client.EXPECT().Post(gomock.Any(), group, GroupsResourcePath).Return([]byte(groupResponse), nil)
+
+	result, err := sut.Upsert(context.Background(), group)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Group{
+		ID:            groupID,
+		Name:          groupName,
+		Members:       []GroupMember{{UserID: groupMemberID, Email: groupMemberEmail}},
+		PermissionSet: GroupPermissionSet{Permissions: []string{"CAN_CONFIGURE_APPLICATIONS"}},
+	}, result)
+}
+
+func TestShouldUpdateResourceByPutRequestWhenIDIsSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewCreateByPostRestResource(GroupsResourcePath, NewGroupUnmarshaller(), client)
+	group := Group{ID: groupID, Name: groupName}
+
+	client.EXPECT().Put(gomock.Any(), group, GroupsResourcePath).Return([]byte(groupResponse), nil)
+
+	result, err := sut.Upsert(context.Background(), group)
+
+	assert.Nil(t, err)
+	assert.Equal(t, groupID, result.GetID())
+}
+
+func TestShouldFailToUpsertResourceCreatedByPostWhenDataObjectIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewCreateByPostRestResource(GroupsResourcePath, NewGroupUnmarshaller(), client)
+
+	_, err := sut.Upsert(context.Background(), Group{ID: groupID})
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUpsertResourceCreatedByPostWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewCreateByPostRestResource(GroupsResourcePath, NewGroupUnmarshaller(), client)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedError)
+
+	_, err := sut.Upsert(context.Background(), Group{Name: groupName})
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToUpsertResourceCreatedByPostWhenResponseIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewCreateByPostRestResource(GroupsResourcePath, NewGroupUnmarshaller(), client)
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte(`{"id" : "group-id"}`), nil)
+
+	_, err := sut.Upsert(context.Background(), Group{ID: groupID, Name: groupName})
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUpsertResourceCreatedByPostWhenResponseIsNotAJsonDocument(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewCreateByPostRestResource(GroupsResourcePath, NewGroupUnmarshaller(), client)
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("invalid"), nil)
+
+	_, err := sut.Upsert(context.Background(), Group{ID: groupID, Name: groupName})
+
+	assert.NotNil(t, err)
+}
@@ -20,3 +20,8 @@ func (u *customEventSpecificationUnmarshaller) Unmarshal(data []byte) (InstanaDa
 	}
 	return customEventSpecification, nil
 }
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *customEventSpecificationUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
@@ -58,3 +58,32 @@ func TestShouldReturnEmptyCustomEventSpecificationWhenJsonObjectIsReturnWhereNoF
 	assert.Nil(t, err)
 	assert.Equal(t, CustomEventSpecification{}, result)
 }
+
+func TestShouldSuccessfullyUnmarshalArrayOfCustomEventSpecifications(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"name" : "test-name-1",
+		"entityType" : "host",
+		"rules" : [ { "ruleType" : "system", "severity" : 5, "systemRuleId" : "system-rule-id" } ]
+	},{
+		"id" : "test-id-2",
+		"name" : "test-name-2",
+		"entityType" : "host",
+		"rules" : [ { "ruleType" : "system", "severity" : 10, "systemRuleId" : "system-rule-id" } ]
+	}]`
+
+	result, err := NewCustomEventSpecificationUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "test-id-1", result[0].(CustomEventSpecification).ID)
+	assert.Equal(t, "test-id-2", result[1].(CustomEventSpecification).ID)
+}
+
+func TestShouldFailToUnmarshalArrayOfCustomEventSpecificationsWhenResponseIsAJsonObject(t *testing.T) {
+	response := `{"id" : "test-id"}`
+
+	_, err := NewCustomEventSpecificationUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,27 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//NewGroupUnmarshaller creates a new Unmarshaller instance for groups
+func NewGroupUnmarshaller() Unmarshaller {
+	return &groupUnmarshaller{}
+}
+
+type groupUnmarshaller struct{}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *groupUnmarshaller) Unmarshal(data []byte) (InstanaDataObject, error) {
+	group := Group{}
+	if err := json.Unmarshal(data, &group); err != nil {
+		return group, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return group, nil
+}
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *groupUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
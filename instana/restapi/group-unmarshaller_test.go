@@ -0,0 +1,79 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+func TestShouldSuccessfullyUnmarshalGroup(t *testing.T) {
+	infraDFQFilter := "entity.zone:test"
+	group := Group{
+		ID:      "group-id",
+		Name:    "group-name",
+		Members: []GroupMember{{UserID: "user-id", Email: "user@example.com"}},
+		PermissionSet: GroupPermissionSet{
+			ID:                      "permission-set-id",
+			Name:                    "permission-set-name",
+			Permissions:             []string{"CAN_CONFIGURE_APPLICATIONS"},
+			ApplicationIDs:          []string{"app-id"},
+			KubernetesClusterUUIDs:  []string{"cluster-uuid"},
+			KubernetesNamespaceUIDs: []string{"namespace-uid"},
+			WebsiteIDs:              []string{"website-id"},
+			MobileAppIDs:            []string{"mobile-app-id"},
+			InfraDFQFilter:          &infraDFQFilter,
+		},
+	}
+
+	serializedJSON, _ := json.Marshal(group)
+
+	result, err := NewGroupUnmarshaller().Unmarshal(serializedJSON)
+
+	assert.Nil(t, err)
+	assert.Equal(t, group, result)
+}
+
+func TestShouldFailToUnmarshalGroupWhenResponseIsAJsonArray(t *testing.T) {
+	response := `["foo","bar"]`
+
+	_, err := NewGroupUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUnmarshalGroupWhenResponseIsNotAJsonMessage(t *testing.T) {
+	response := `foo bar`
+
+	_, err := NewGroupUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldReturnEmptyGroupWhenJsonObjectIsReturnWhereNoFiledMatches(t *testing.T) {
+	response := `{"foo" : "bar" }`
+
+	result, err := NewGroupUnmarshaller().Unmarshal([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Equal(t, Group{}, result)
+}
+
+func TestShouldSuccessfullyUnmarshalArrayOfGroups(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"name" : "test-name-1"
+	},{
+		"id" : "test-id-2",
+		"name" : "test-name-2"
+	}]`
+
+	result, err := NewGroupUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "test-id-1", result[0].(Group).ID)
+	assert.Equal(t, "test-id-2", result[1].(Group).ID)
+}
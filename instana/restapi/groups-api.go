@@ -0,0 +1,75 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//GroupsResourcePath path to group resource of Instana RESTful API
+const GroupsResourcePath = SettingsBasePath + "/group"
+
+//GroupMaxMembers the maximum number of members of a group
+const GroupMaxMembers = 1024
+
+//GroupMember is the representation of a member (user) of a group in Instana
+type GroupMember struct {
+	UserID string `json:"userId"`
+	Email  string `json:"email"`
+}
+
+//Validate verifies if the group member is correct
+func (m GroupMember) Validate() error {
+	if utils.IsBlank(m.UserID) {
+		return errors.New("user ID of group member is missing")
+	}
+	if utils.IsBlank(m.Email) {
+		return errors.New("email of group member is missing")
+	}
+	return nil
+}
+
+//GroupPermissionSet is the representation of the permission set of a group in Instana. The permission set defines the permissions of the
+//members of the group and the scopes (applications, kubernetes clusters and namespaces, websites, mobile apps and infrastructure) the permissions apply to.
+//The ID of the permission set is assigned by Instana
+type GroupPermissionSet struct {
+	ID                      string   `json:"id,omitempty"`
+	Name                    string   `json:"name"`
+	Permissions             []string `json:"permissions"`
+	ApplicationIDs          []string `json:"applicationIds"`
+	KubernetesClusterUUIDs  []string `json:"kubernetesClusterUUIDs"`
+	KubernetesNamespaceUIDs []string `json:"kubernetesNamespaceUIDs"`
+	WebsiteIDs              []string `json:"websiteIds"`
+	MobileAppIDs            []string `json:"mobileAppIds"`
+	InfraDFQFilter          *string  `json:"infraDfqFilter,omitempty"`
+}
+
+//Group is the representation of a group in Instana. The ID is assigned by Instana when the group is created
+type Group struct {
+	ID            string             `json:"id"`
+	Name          string             `json:"name"`
+	Members       []GroupMember      `json:"members"`
+	PermissionSet GroupPermissionSet `json:"permissionSet"`
+}
+
+//GetID implemention of the interface InstanaDataObject
+func (g Group) GetID() string {
+	return g.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct. The ID is not validated as it is not available before the group is created
+func (g Group) Validate() error {
+	if utils.IsBlank(g.Name) {
+		return errors.New("name is missing")
+	}
+	if len(g.Members) > GroupMaxMembers {
+		return fmt.Errorf("at most %d members are supported", GroupMaxMembers)
+	}
+	for _, m := range g.Members {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	groupID          = "group-id"
+	groupName        = "group-name"
+	groupMemberID    = "group-member-id"
+	groupMemberEmail = "member@example.com"
+)
+
+func TestValidMinimalGroup(t *testing.T) {
+	group := Group{
+		ID:   groupID,
+		Name: groupName,
+	}
+
+	assert.Equal(t, groupID, group.GetID())
+
+	err := group.Validate()
+	assert.Nil(t, err)
+}
+
+func TestValidFullGroup(t *testing.T) {
+	infraDFQFilter := "entity.zone:test"
+	group := Group{
+		ID:      groupID,
+		Name:    groupName,
+		Members: []GroupMember{{UserID: groupMemberID, Email: groupMemberEmail}},
+		PermissionSet: GroupPermissionSet{
+			ID:                      "permission-set-id",
+			Name:                    "permission-set-name",
+			Permissions:             []string{"CAN_CONFIGURE_APPLICATIONS"},
+			ApplicationIDs:          []string{"app-id"},
+			KubernetesClusterUUIDs:  []string{"cluster-uuid"},
+			KubernetesNamespaceUIDs: []string{"namespace-uid"},
+			WebsiteIDs:              []string{"website-id"},
+			MobileAppIDs:            []string{"mobile-app-id"},
+			InfraDFQFilter:          &infraDFQFilter,
+		},
+	}
+
+	err := group.Validate()
+	assert.Nil(t, err)
+}
+
+func TestGroupWithoutIDShouldBeValidAsTheIDIsAssignedByInstana(t *testing.T) {
+	group := Group{
+		Name: groupName,
+	}
+
+	err := group.Validate()
+	assert.Nil(t, err)
+}
+
+func TestInvalidGroupBecauseOfMissingName(t *testing.T) {
+	group := Group{
+		ID: groupID,
+	}
+
+	err := group.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name")
+}
+
+func TestInvalidGroupBecauseOfTooManyMembers(t *testing.T) {
+	members := make([]GroupMember, GroupMaxMembers+1)
+	for i := range members {
+		members[i] = GroupMember{UserID: groupMemberID, Email: groupMemberEmail}
+	}
+	group := Group{
+		ID:      groupID,
+		Name:    groupName,
+		Members: members,
+	}
+
+	err := group.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "members")
+}
+
+func TestInvalidGroupBecauseOfMemberWithoutUserID(t *testing.T) {
+	group := Group{
+		ID:      groupID,
+		Name:    groupName,
+		Members: []GroupMember{{Email: groupMemberEmail}},
+	}
+
+	err := group.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "user ID")
+}
+
+func TestInvalidGroupBecauseOfMemberWithoutEmail(t *testing.T) {
+	group := Group{
+		ID:      groupID,
+		Name:    groupName,
+		Members: []GroupMember{{UserID: groupMemberID}},
+	}
+
+	err := group.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "email")
+}
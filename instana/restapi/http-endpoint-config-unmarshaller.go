@@ -0,0 +1,27 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//NewHTTPEndpointConfigUnmarshaller creates a new Unmarshaller instance for HTTP endpoint configs
+func NewHTTPEndpointConfigUnmarshaller() Unmarshaller {
+	return &httpEndpointConfigUnmarshaller{}
+}
+
+type httpEndpointConfigUnmarshaller struct{}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *httpEndpointConfigUnmarshaller) Unmarshal(data []byte) (InstanaDataObject, error) {
+	httpEndpointConfig := HTTPEndpointConfig{}
+	if err := json.Unmarshal(data, &httpEndpointConfig); err != nil {
+		return httpEndpointConfig, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return httpEndpointConfig, nil
+}
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *httpEndpointConfigUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
@@ -0,0 +1,96 @@
+package restapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+func TestShouldSuccessfullyUnmarshalHTTPEndpointConfig(t *testing.T) {
+	response := `{
+		"serviceId" : "service-id",
+		"endpointNameByFirstPathSegmentRuleEnabled" : true,
+		"endpointNameByCollectedPathTemplateRuleEnabled" : false,
+		"rules" : [{
+			"enabled" : true,
+			"pathSegments" : [
+				{ "type" : "FIXED", "name" : "api" },
+				{ "type" : "PARAMETER", "name" : "id" },
+				{ "type" : "MATCH_ALL" },
+				{ "type" : "UNSUPPORTED", "unsupportedType" : "regex" }
+			],
+			"testCases" : [ "/api/123/foo" ]
+		}]
+	}`
+
+	result, err := NewHTTPEndpointConfigUnmarshaller().Unmarshal([]byte(response))
+
+	name := "api"
+	parameter := "id"
+	unsupportedType := "regex"
+	expected := HTTPEndpointConfig{
+		ServiceID: "service-id",
+		EndpointNameByFirstPathSegmentRuleEnabled:      true,
+		EndpointNameByCollectedPathTemplateRuleEnabled: false,
+		Rules: []HTTPEndpointRule{
+			{
+				Enabled: true,
+				PathSegments: []HTTPPathSegmentMatchingRule{
+					{Type: HTTPPathSegmentMatchingTypeFixed, Name: &name},
+					{Type: HTTPPathSegmentMatchingTypeParameter, Name: &parameter},
+					{Type: HTTPPathSegmentMatchingTypeMatchAll},
+					{Type: HTTPPathSegmentMatchingTypeUnsupported, UnsupportedType: &unsupportedType},
+				},
+				TestCases: []string{"/api/123/foo"},
+			},
+		},
+	}
+	assert.Nil(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestShouldFailToUnmarshalHTTPEndpointConfigWhenResponseIsAJsonArray(t *testing.T) {
+	response := `["foo","bar"]`
+
+	_, err := NewHTTPEndpointConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUnmarshalHTTPEndpointConfigWhenResponseIsNotAJsonMessage(t *testing.T) {
+	response := `foo bar`
+
+	_, err := NewHTTPEndpointConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldReturnEmptyHTTPEndpointConfigWhenJsonObjectIsReturnWhereNoFiledMatches(t *testing.T) {
+	response := `{"foo" : "bar" }`
+
+	result, err := NewHTTPEndpointConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Equal(t, HTTPEndpointConfig{}, result)
+}
+
+func TestShouldSuccessfullyUnmarshalArrayOfHTTPEndpointConfigs(t *testing.T) {
+	response := `[{ "serviceId" : "service-id-1" },{ "serviceId" : "service-id-2" }]`
+
+	result, err := NewHTTPEndpointConfigUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "service-id-1", result[0].GetID())
+	assert.Equal(t, "service-id-2", result[1].GetID())
+}
+
+func TestShouldFailToUnmarshalArrayOfHTTPEndpointConfigsWhenResponseIsAJsonObject(t *testing.T) {
+	response := `{"serviceId" : "service-id"}`
+
+	_, err := NewHTTPEndpointConfigUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,153 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//HTTPEndpointConfigsResourcePath path to HTTP endpoint config resource of Instana RESTful API
+const HTTPEndpointConfigsResourcePath = ApplicationMonitoringSettingsBasePath + "/http-endpoint"
+
+const (
+	//HTTPEndpointConfigMaxRules the maximum number of rules supported by a HTTP endpoint config
+	HTTPEndpointConfigMaxRules = 500
+	//HTTPEndpointRuleMaxPathSegments the maximum number of path segments supported by a HTTP endpoint rule
+	HTTPEndpointRuleMaxPathSegments = 16
+	//HTTPEndpointRuleMaxTestCases the maximum number of test cases supported by a HTTP endpoint rule
+	HTTPEndpointRuleMaxTestCases = 32
+)
+
+//HTTPPathSegmentMatchingType custom type for the type of HTTP path segment matching rules
+type HTTPPathSegmentMatchingType string
+
+//HTTPPathSegmentMatchingTypes custom type for a slice of HTTPPathSegmentMatchingType
+type HTTPPathSegmentMatchingTypes []HTTPPathSegmentMatchingType
+
+//ToStringSlice returns a slice containing the string representations of the given path segment matching types
+func (types HTTPPathSegmentMatchingTypes) ToStringSlice() []string {
+	result := make([]string, len(types))
+	for i, t := range types {
+		result[i] = string(t)
+	}
+	return result
+}
+
+const (
+	//HTTPPathSegmentMatchingTypeFixed constant value for the path segment matching type FIXED
+	HTTPPathSegmentMatchingTypeFixed = HTTPPathSegmentMatchingType("FIXED")
+	//HTTPPathSegmentMatchingTypeMatchAll constant value for the path segment matching type MATCH_ALL
+	HTTPPathSegmentMatchingTypeMatchAll = HTTPPathSegmentMatchingType("MATCH_ALL")
+	//HTTPPathSegmentMatchingTypeParameter constant value for the path segment matching type PARAMETER
+	HTTPPathSegmentMatchingTypeParameter = HTTPPathSegmentMatchingType("PARAMETER")
+	//HTTPPathSegmentMatchingTypeUnsupported constant value for the path segment matching type UNSUPPORTED
+	HTTPPathSegmentMatchingTypeUnsupported = HTTPPathSegmentMatchingType("UNSUPPORTED")
+)
+
+//SupportedHTTPPathSegmentMatchingTypes slice of all supported HTTP path segment matching types of the Instana Web REST API
+var SupportedHTTPPathSegmentMatchingTypes = HTTPPathSegmentMatchingTypes{
+	HTTPPathSegmentMatchingTypeFixed,
+	HTTPPathSegmentMatchingTypeMatchAll,
+	HTTPPathSegmentMatchingTypeParameter,
+	HTTPPathSegmentMatchingTypeUnsupported,
+}
+
+//HTTPPathSegmentMatchingRule is the representation of a path segment matcher of a HTTP endpoint rule. Depending on the type different fields are required
+type HTTPPathSegmentMatchingRule struct {
+	Type HTTPPathSegmentMatchingType `json:"type"`
+
+	//Fixed and parameter path segment fields
+	Name *string `json:"name,omitempty"`
+
+	//Unsupported path segment fields
+	UnsupportedType *string `json:"unsupportedType,omitempty"`
+}
+
+//Validate checks if the path segment matching rule is consistent
+func (s HTTPPathSegmentMatchingRule) Validate() error {
+	if s.Type == HTTPPathSegmentMatchingTypeFixed || s.Type == HTTPPathSegmentMatchingTypeParameter {
+		return s.validateNamedPathSegment()
+	} else if s.Type == HTTPPathSegmentMatchingTypeMatchAll {
+		return s.validateMatchAllPathSegment()
+	} else if s.Type == HTTPPathSegmentMatchingTypeUnsupported {
+		return s.validateUnsupportedPathSegment()
+	}
+	return fmt.Errorf("unsupported path segment type '%s'", s.Type)
+}
+
+func (s HTTPPathSegmentMatchingRule) validateNamedPathSegment() error {
+	if s.Name == nil || utils.IsBlank(*s.Name) {
+		return fmt.Errorf("name of %s path segment is missing", s.Type)
+	}
+	if s.UnsupportedType != nil {
+		return fmt.Errorf("unsupported type is not allowed for %s path segment", s.Type)
+	}
+	return nil
+}
+
+func (s HTTPPathSegmentMatchingRule) validateMatchAllPathSegment() error {
+	if s.Name != nil || s.UnsupportedType != nil {
+		return fmt.Errorf("neither name nor unsupported type are allowed for %s path segment", s.Type)
+	}
+	return nil
+}
+
+func (s HTTPPathSegmentMatchingRule) validateUnsupportedPathSegment() error {
+	if s.Name != nil {
+		return fmt.Errorf("name is not allowed for %s path segment", s.Type)
+	}
+	return nil
+}
+
+//HTTPEndpointRule is the representation of a rule of a HTTP endpoint config
+type HTTPEndpointRule struct {
+	Enabled      bool                          `json:"enabled"`
+	PathSegments []HTTPPathSegmentMatchingRule `json:"pathSegments"`
+	TestCases    []string                      `json:"testCases"`
+}
+
+//Validate checks if the HTTP endpoint rule is consistent
+func (r HTTPEndpointRule) Validate() error {
+	if len(r.PathSegments) == 0 || len(r.PathSegments) > HTTPEndpointRuleMaxPathSegments {
+		return fmt.Errorf("between 1 and %d path segments are required for a rule", HTTPEndpointRuleMaxPathSegments)
+	}
+	for _, s := range r.PathSegments {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+	}
+	if len(r.TestCases) > HTTPEndpointRuleMaxTestCases {
+		return fmt.Errorf("at most %d test cases are supported for a rule", HTTPEndpointRuleMaxTestCases)
+	}
+	return nil
+}
+
+//HTTPEndpointConfig is the representation of the HTTP endpoint naming configuration of a service in Instana. The config is identified by the ID of the service
+type HTTPEndpointConfig struct {
+	ServiceID                                      string             `json:"serviceId"`
+	EndpointNameByFirstPathSegmentRuleEnabled      bool               `json:"endpointNameByFirstPathSegmentRuleEnabled"`
+	EndpointNameByCollectedPathTemplateRuleEnabled bool               `json:"endpointNameByCollectedPathTemplateRuleEnabled"`
+	Rules                                          []HTTPEndpointRule `json:"rules"`
+}
+
+//GetID implemention of the interface InstanaDataObject
+func (c HTTPEndpointConfig) GetID() string {
+	return c.ServiceID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (c HTTPEndpointConfig) Validate() error {
+	if utils.IsBlank(c.ServiceID) {
+		return errors.New("service ID is missing")
+	}
+	if len(c.Rules) > HTTPEndpointConfigMaxRules {
+		return fmt.Errorf("at most %d rules are supported", HTTPEndpointConfigMaxRules)
+	}
+	for _, r := range c.Rules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
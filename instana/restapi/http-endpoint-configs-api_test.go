@@ -0,0 +1,163 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	httpEndpointConfigServiceID = "service-id"
+	httpPathSegmentName         = "api"
+)
+
+func TestValidMinimalHTTPEndpointConfig(t *testing.T) {
+	config := HTTPEndpointConfig{ServiceID: httpEndpointConfigServiceID}
+
+	assert.Equal(t, httpEndpointConfigServiceID, config.GetID())
+	assert.Nil(t, config.Validate())
+}
+
+func TestValidHTTPEndpointConfigWithAllPathSegmentTypes(t *testing.T) {
+	name := httpPathSegmentName
+	parameter := "id"
+	unsupportedType := "regex"
+	config := HTTPEndpointConfig{
+		ServiceID: httpEndpointConfigServiceID,
+		EndpointNameByFirstPathSegmentRuleEnabled:      true,
+		EndpointNameByCollectedPathTemplateRuleEnabled: true,
+		Rules: []HTTPEndpointRule{
+			{
+				Enabled: true,
+				PathSegments: []HTTPPathSegmentMatchingRule{
+					{Type: HTTPPathSegmentMatchingTypeFixed, Name: &name},
+					{Type: HTTPPathSegmentMatchingTypeParameter, Name: &parameter},
+					{Type: HTTPPathSegmentMatchingTypeMatchAll},
+					{Type: HTTPPathSegmentMatchingTypeUnsupported, UnsupportedType: &unsupportedType},
+				},
+				TestCases: []string{"/api/123/foo"},
+			},
+		},
+	}
+
+	assert.Nil(t, config.Validate())
+}
+
+func TestInvalidHTTPEndpointConfigBecauseOfMissingServiceID(t *testing.T) {
+	err := HTTPEndpointConfig{}.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "service ID")
+}
+
+func TestInvalidHTTPEndpointConfigBecauseOfTooManyRules(t *testing.T) {
+	rules := make([]HTTPEndpointRule, HTTPEndpointConfigMaxRules+1)
+	for i := range rules {
+		rules[i] = HTTPEndpointRule{PathSegments: []HTTPPathSegmentMatchingRule{{Type: HTTPPathSegmentMatchingTypeMatchAll}}}
+	}
+	config := HTTPEndpointConfig{ServiceID: httpEndpointConfigServiceID, Rules: rules}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "rules")
+}
+
+func TestInvalidHTTPEndpointRuleBecauseOfMissingPathSegments(t *testing.T) {
+	err := HTTPEndpointRule{}.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "path segments")
+}
+
+func TestInvalidHTTPEndpointRuleBecauseOfTooManyPathSegments(t *testing.T) {
+	segments := make([]HTTPPathSegmentMatchingRule, HTTPEndpointRuleMaxPathSegments+1)
+	for i := range segments {
+		segments[i] = HTTPPathSegmentMatchingRule{Type: HTTPPathSegmentMatchingTypeMatchAll}
+	}
+
+	err := HTTPEndpointRule{PathSegments: segments}.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "path segments")
+}
+
+func TestInvalidHTTPEndpointRuleBecauseOfTooManyTestCases(t *testing.T) {
+	rule := HTTPEndpointRule{
+		PathSegments: []HTTPPathSegmentMatchingRule{{Type: HTTPPathSegmentMatchingTypeMatchAll}},
+		TestCases:    make([]string, HTTPEndpointRuleMaxTestCases+1),
+	}
+
+	err := rule.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "test cases")
+}
+
+func TestInvalidHTTPEndpointConfigBecauseOfInvalidPathSegment(t *testing.T) {
+	config := HTTPEndpointConfig{
+		ServiceID: httpEndpointConfigServiceID,
+		Rules:     []HTTPEndpointRule{{PathSegments: []HTTPPathSegmentMatchingRule{{Type: HTTPPathSegmentMatchingTypeFixed}}}},
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name of FIXED path segment")
+}
+
+func TestShouldFailToValidateNamedPathSegmentsWhenNameIsMissingOrBlank(t *testing.T) {
+	blank := " "
+	for _, segmentType := range []HTTPPathSegmentMatchingType{HTTPPathSegmentMatchingTypeFixed, HTTPPathSegmentMatchingTypeParameter} {
+		t.Run(string(segmentType), func(t *testing.T) {
+			assert.NotNil(t, HTTPPathSegmentMatchingRule{Type: segmentType}.Validate())
+			assert.NotNil(t, HTTPPathSegmentMatchingRule{Type: segmentType, Name: &blank}.Validate())
+		})
+	}
+}
+
+func TestShouldFailToValidateNamedPathSegmentsWhenUnsupportedTypeIsSet(t *testing.T) {
+	name := httpPathSegmentName
+	unsupportedType := "regex"
+	for _, segmentType := range []HTTPPathSegmentMatchingType{HTTPPathSegmentMatchingTypeFixed, HTTPPathSegmentMatchingTypeParameter} {
+		t.Run(string(segmentType), func(t *testing.T) {
+			err := HTTPPathSegmentMatchingRule{Type: segmentType, Name: &name, UnsupportedType: &unsupportedType}.Validate()
+
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "unsupported type is not allowed")
+		})
+	}
+}
+
+func TestShouldFailToValidateMatchAllPathSegmentWhenNameOrUnsupportedTypeIsSet(t *testing.T) {
+	name := httpPathSegmentName
+	unsupportedType := "regex"
+
+	assert.NotNil(t, HTTPPathSegmentMatchingRule{Type: HTTPPathSegmentMatchingTypeMatchAll, Name: &name}.Validate())
+	assert.NotNil(t, HTTPPathSegmentMatchingRule{Type: HTTPPathSegmentMatchingTypeMatchAll, UnsupportedType: &unsupportedType}.Validate())
+}
+
+func TestShouldValidateUnsupportedPathSegmentWithoutUnsupportedType(t *testing.T) {
+	assert.Nil(t, HTTPPathSegmentMatchingRule{Type: HTTPPathSegmentMatchingTypeUnsupported}.Validate())
+}
+
+func TestShouldFailToValidateUnsupportedPathSegmentWhenNameIsSet(t *testing.T) {
+	name := httpPathSegmentName
+
+	err := HTTPPathSegmentMatchingRule{Type: HTTPPathSegmentMatchingTypeUnsupported, Name: &name}.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name is not allowed")
+}
+
+func TestShouldFailToValidatePathSegmentWithUnknownType(t *testing.T) {
+	err := HTTPPathSegmentMatchingRule{Type: HTTPPathSegmentMatchingType("INVALID")}.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "INVALID")
+}
+
+func TestShouldReturnSupportedHTTPPathSegmentMatchingTypesAsStringSlice(t *testing.T) {
+	assert.Equal(t, []string{"FIXED", "MATCH_ALL", "PARAMETER", "UNSUPPORTED"}, SupportedHTTPPathSegmentMatchingTypes.ToStringSlice())
+}
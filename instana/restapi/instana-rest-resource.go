@@ -1,22 +1,60 @@
 package restapi
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
 //InstanaDataObject is a marker interface for any data object provided by any resource of the Instana REST API
 type InstanaDataObject interface {
 	GetID() string
 	Validate() error
 }
 
-//RestResource interface definition of a instana REST resource.
+//RestResource interface definition of a instana REST resource. The context is passed to the RestClient to cancel the requests when the context is done
 type RestResource interface {
-	GetOne(id string) (InstanaDataObject, error)
-	Upsert(data InstanaDataObject) (InstanaDataObject, error)
-	Delete(data InstanaDataObject) error
-	DeleteByID(id string) error
+	GetAll(ctx context.Context) ([]InstanaDataObject, error)
+	GetOne(ctx context.Context, id string) (InstanaDataObject, error)
+	Upsert(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error)
+	Delete(ctx context.Context, data InstanaDataObject) error
+	DeleteByID(ctx context.Context, id string) error
 }
 
-//Unmarshaller interface definition for unmarshalling the binary data to the desired struct
+//Unmarshaller interface definition for unmarshalling the binary data to the desired struct or to a slice of the desired struct when the binary data contains a JSON array
 type Unmarshaller interface {
 	Unmarshal(data []byte) (InstanaDataObject, error)
+	UnmarshalArray(data []byte) ([]InstanaDataObject, error)
+}
+
+//unmarshalArray splits the given JSON array into its elements and unmarshals each element with the given unmarshal function
+func unmarshalArray(data []byte, unmarshal func(data []byte) (InstanaDataObject, error)) ([]InstanaDataObject, error) {
+	var rawElements []json.RawMessage
+	if err := json.Unmarshal(data, &rawElements); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	result := make([]InstanaDataObject, len(rawElements))
+	for i, raw := range rawElements {
+		object, err := unmarshal(raw)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = object
+	}
+	return result, nil
+}
+
+//unmarshalAndValidate converts the response of the REST API to the corresponding InstanaDataObject and validates it. The object is
+//returned along with the error when it is not valid, so that callers can still access the ID of an object created by Instana
+func unmarshalAndValidate(unmarshaller Unmarshaller, data []byte) (InstanaDataObject, error) {
+	object, err := unmarshaller.Unmarshal(data)
+	if err != nil {
+		return object, err
+	}
+	if err := object.Validate(); err != nil {
+		return object, err
+	}
+	return object, nil
 }
 
 //NewRestResource creates a new REST resource using the provided unmarshaller function to convert the response from the REST API to the corresponding InstanaDataObject
@@ -34,41 +72,38 @@ type genericRestResource struct {
 	client       RestClient
 }
 
-func (r *genericRestResource) GetOne(id string) (InstanaDataObject, error) {
-	data, err := r.client.GetOne(id, r.resourcePath)
+//GetAll returns all objects of the resource. The objects are not validated as the resource may contain objects which are not supported by the provider
+func (r *genericRestResource) GetAll(ctx context.Context) ([]InstanaDataObject, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
 	if err != nil {
 		return nil, err
 	}
-	return r.validateResponseAndConvertToStruct(data)
+	return r.unmarshaller.UnmarshalArray(data)
 }
 
-func (r *genericRestResource) Upsert(data InstanaDataObject) (InstanaDataObject, error) {
-	if err := data.Validate(); err != nil {
-		return data, err
-	}
-	response, err := r.client.Put(data, r.resourcePath)
+func (r *genericRestResource) GetOne(ctx context.Context, id string) (InstanaDataObject, error) {
+	data, err := r.client.GetOne(ctx, id, r.resourcePath)
 	if err != nil {
-		return data, err
+		return nil, err
 	}
-	return r.validateResponseAndConvertToStruct(response)
+	return unmarshalAndValidate(r.unmarshaller, data)
 }
 
-func (r *genericRestResource) validateResponseAndConvertToStruct(data []byte) (InstanaDataObject, error) {
-	object, err := r.unmarshaller.Unmarshal(data)
-	if err != nil {
-		return object, err
+func (r *genericRestResource) Upsert(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
 	}
-
-	if err := object.Validate(); err != nil {
-		return object, err
+	response, err := r.client.Put(ctx, data, r.resourcePath)
+	if err != nil {
+		return data, err
 	}
-	return object, nil
+	return unmarshalAndValidate(r.unmarshaller, response)
 }
 
-func (r *genericRestResource) Delete(data InstanaDataObject) error {
-	return r.DeleteByID(data.GetID())
+func (r *genericRestResource) Delete(ctx context.Context, data InstanaDataObject) error {
+	return r.DeleteByID(ctx, data.GetID())
 }
 
-func (r *genericRestResource) DeleteByID(id string) error {
-	return r.client.Delete(id, r.resourcePath)
+func (r *genericRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, id, r.resourcePath)
 }
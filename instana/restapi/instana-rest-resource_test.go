@@ -1,6 +1,7 @@
 package restapi_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -48,11 +49,70 @@ func (t *testUnmarshaller) Unmarshal(data []byte) (InstanaDataObject, error) {
 	return &obj, nil
 }
 
+func (t *testUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	objects := make([]*testObject, 0)
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	result := make([]InstanaDataObject, len(objects))
+	for i, o := range objects {
+		result[i] = o
+	}
+	return result, nil
+}
+
 func makeInstanaRestResourceSUT(client RestClient) RestResource {
 	unmarshaller := &testUnmarshaller{}
 	return NewRestResource(testObjectResourcePath, unmarshaller, client)
 }
 
+func TestSuccessfulGetAllTestObjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	sut := makeInstanaRestResourceSUT(client)
+
+	testObject1 := makeTestObject()
+	testObject2 := &testObject{ID: "test-object-id-2", Name: "other-name"}
+	serializedJSON, _ := json.Marshal([]*testObject{testObject1, testObject2})
+
+	client.EXPECT().Get(gomock.Any(), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
+
+	data, err := sut.GetAll(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, []InstanaDataObject{testObject1, testObject2}, data)
+}
+
+func TestShouldFailToGetAllTestObjectsWhenErrorIsRetrievedFromRestClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	sut := makeInstanaRestResourceSUT(client)
+
+	client.EXPECT().Get(gomock.Any(), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("error during test"))
+
+	_, err := sut.GetAll(context.Background())
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToGetAllTestObjectsWhenResponseIsNotAJsonArray(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	sut := makeInstanaRestResourceSUT(client)
+
+	client.EXPECT().Get(gomock.Any(), gomock.Eq(testObjectResourcePath)).Return([]byte("{ \"invalid\" : \"data\" }"), nil)
+
+	_, err := sut.GetAll(context.Background())
+
+	assert.NotNil(t, err)
+}
+
 func TestSuccessfulGetOneTestObject(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -63,9 +123,9 @@ func TestSuccessfulGetOneTestObject(t *testing.T) {
 	testObject := makeTestObject()
 	serializedJSON, _ := json.Marshal(testObject)
 
-	client.EXPECT().GetOne(gomock.Eq(testObject.ID), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
+	client.EXPECT().GetOne(gomock.Any(), gomock.Eq(testObject.ID), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
 
-	data, err := sut.GetOne(testObject.ID)
+	data, err := sut.GetOne(context.Background(), testObject.ID)
 
 	assert.Nil(t, err)
 	assert.Equal(t, testObject, data)
@@ -78,9 +138,9 @@ func TestShouldFailToGetOneTestObjectWhenErrorIsRetrievedFromRestClient(t *testi
 
 	sut := makeInstanaRestResourceSUT(client)
 
-	client.EXPECT().GetOne(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("error during test"))
+	client.EXPECT().GetOne(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("error during test"))
 
-	_, err := sut.GetOne(testObjectID)
+	_, err := sut.GetOne(context.Background(), testObjectID)
 
 	assert.NotNil(t, err)
 }
@@ -92,9 +152,9 @@ func TestShouldFailToGetOneTestObjectWhenResponseContainsAnInvalidJsonArray(t *t
 
 	sut := makeInstanaRestResourceSUT(client)
 
-	client.EXPECT().GetOne(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return([]byte("[{ \"invalid\" : \"data\" }]"), nil)
+	client.EXPECT().GetOne(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return([]byte("[{ \"invalid\" : \"data\" }]"), nil)
 
-	_, err := sut.GetOne(testObjectID)
+	_, err := sut.GetOne(context.Background(), testObjectID)
 
 	assert.NotNil(t, err)
 }
@@ -106,9 +166,9 @@ func TestShouldFailToGetOneTestObjectWhenResponseContainsAnInvalidJsonObject(t *
 
 	sut := makeInstanaRestResourceSUT(client)
 
-	client.EXPECT().GetOne(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return([]byte("{ \"invalid\" : \"data\" }"), nil)
+	client.EXPECT().GetOne(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return([]byte("{ \"invalid\" : \"data\" }"), nil)
 
-	_, err := sut.GetOne(testObjectID)
+	_, err := sut.GetOne(context.Background(), testObjectID)
 
 	assert.NotNil(t, err)
 }
@@ -120,9 +180,9 @@ func TestShouldFailToGetOneTestObjectWhenResponseIsNotAJsonObject(t *testing.T)
 
 	sut := makeInstanaRestResourceSUT(client)
 
-	client.EXPECT().GetOne(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return([]byte("Invalid Data"), nil)
+	client.EXPECT().GetOne(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return([]byte("Invalid Data"), nil)
 
-	_, err := sut.GetOne(testObjectID)
+	_, err := sut.GetOne(context.Background(), testObjectID)
 
 	assert.NotNil(t, err)
 }
@@ -136,9 +196,9 @@ func TestSuccessfulUpsertOfTestObject(t *testing.T) {
 	testObject := makeTestObject()
 	serializedJSON, _ := json.Marshal(testObject)
 
-	client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
+	client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
 
-	result, err := sut.Upsert(testObject)
+	result, err := sut.Upsert(context.Background(), testObject)
 
 	assert.Nil(t, err)
 	assert.Equal(t, testObject, result)
@@ -152,9 +212,9 @@ func TestShouldFailToUpsertTestObjectWhenErrorIsReturnedFromRestClient(t *testin
 	sut := makeInstanaRestResourceSUT(client)
 	testObject := makeTestObject()
 
-	client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
+	client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
 
-	_, err := sut.Upsert(testObject)
+	_, err := sut.Upsert(context.Background(), testObject)
 
 	assert.NotNil(t, err)
 }
@@ -167,9 +227,9 @@ func TestShouldFailToUpsertTestObjectWhenResponseMessageIsNotAValidJsonObject(t
 	sut := makeInstanaRestResourceSUT(client)
 	testObject := makeTestObject()
 
-	client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return([]byte("invalid response"), nil)
+	client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return([]byte("invalid response"), nil)
 
-	_, err := sut.Upsert(testObject)
+	_, err := sut.Upsert(context.Background(), testObject)
 
 	assert.NotNil(t, err)
 }
@@ -182,9 +242,9 @@ func TestShouldFailToUpsertTestObjectWhenResponseMessageContainsAnInvalidTestObj
 	sut := makeInstanaRestResourceSUT(client)
 	testObject := makeTestObject()
 
-	client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return([]byte("{ \"invalid\" : \"testObject\" }"), nil)
+	client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return([]byte("{ \"invalid\" : \"testObject\" }"), nil)
 
-	_, err := sut.Upsert(testObject)
+	_, err := sut.Upsert(context.Background(), testObject)
 
 	assert.NotNil(t, err)
 }
@@ -200,9 +260,9 @@ func TestShouldFailedToUpsertTestObjectWhenAnInvalidTestObjectIsProvided(t *test
 		Name: "invalid name",
 	}
 
-	client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Times(0)
+	client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Times(0)
 
-	_, err := sut.Upsert(testObject)
+	_, err := sut.Upsert(context.Background(), testObject)
 
 	assert.NotNil(t, err)
 }
@@ -215,9 +275,9 @@ func TestSuccessfulDeleteOfTestObjectByObject(t *testing.T) {
 	sut := makeInstanaRestResourceSUT(client)
 	testObject := makeTestObject()
 
-	client.EXPECT().Delete(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(nil)
+	client.EXPECT().Delete(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(nil)
 
-	err := sut.Delete(testObject)
+	err := sut.Delete(context.Background(), testObject)
 
 	assert.Nil(t, err)
 }
@@ -230,9 +290,9 @@ func TestShouldFailToDeleteTestObjectWhenErrorIsRetrunedFromRestClient(t *testin
 	sut := makeInstanaRestResourceSUT(client)
 	testObject := makeTestObject()
 
-	client.EXPECT().Delete(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(errors.New("Error during test"))
+	client.EXPECT().Delete(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(errors.New("Error during test"))
 
-	err := sut.Delete(testObject)
+	err := sut.Delete(context.Background(), testObject)
 
 	assert.NotNil(t, err)
 }
@@ -0,0 +1,27 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//NewMaintenanceConfigurationUnmarshaller creates a new Unmarshaller instance for maintenance configurations
+func NewMaintenanceConfigurationUnmarshaller() Unmarshaller {
+	return &maintenanceConfigurationUnmarshaller{}
+}
+
+type maintenanceConfigurationUnmarshaller struct{}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *maintenanceConfigurationUnmarshaller) Unmarshal(data []byte) (InstanaDataObject, error) {
+	config := MaintenanceConfiguration{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return config, nil
+}
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *maintenanceConfigurationUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
@@ -0,0 +1,79 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+func TestShouldSuccessfullyUnmarshalMaintenanceConfiguration(t *testing.T) {
+	config := MaintenanceConfiguration{
+		ID:      "config-id",
+		Name:    "config-name",
+		Query:   "entity.zone:\"test\"",
+		Windows: []MaintenanceWindow{{ID: "window-id", Start: 1000, End: 2000}},
+	}
+
+	serializedJSON, _ := json.Marshal(config)
+
+	result, err := NewMaintenanceConfigurationUnmarshaller().Unmarshal(serializedJSON)
+
+	assert.Nil(t, err)
+	assert.Equal(t, config, result)
+}
+
+func TestShouldFailToUnmarshalMaintenanceConfigurationWhenResponseIsAJsonArray(t *testing.T) {
+	response := `["foo","bar"]`
+
+	_, err := NewMaintenanceConfigurationUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUnmarshalMaintenanceConfigurationWhenResponseIsNotAJsonMessage(t *testing.T) {
+	response := `foo bar`
+
+	_, err := NewMaintenanceConfigurationUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldReturnEmptyMaintenanceConfigurationWhenJsonObjectIsReturnWhereNoFiledMatches(t *testing.T) {
+	response := `{"foo" : "bar" }`
+
+	result, err := NewMaintenanceConfigurationUnmarshaller().Unmarshal([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Equal(t, MaintenanceConfiguration{}, result)
+}
+
+func TestShouldSuccessfullyUnmarshalArrayOfMaintenanceConfigurations(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"name" : "test-name-1",
+		"query" : "query-1"
+	},{
+		"id" : "test-id-2",
+		"name" : "test-name-2",
+		"query" : "query-2",
+		"windows" : [ { "id" : "window-id", "start" : 1000, "end" : 2000 } ]
+	}]`
+
+	result, err := NewMaintenanceConfigurationUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "test-id-1", result[0].(MaintenanceConfiguration).ID)
+	assert.Equal(t, int64(2000), result[1].(MaintenanceConfiguration).Windows[0].End)
+}
+
+func TestShouldFailToUnmarshalArrayOfMaintenanceConfigurationsWhenResponseIsAJsonObject(t *testing.T) {
+	response := `{"id" : "test-id"}`
+
+	_, err := NewMaintenanceConfigurationUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.NotNil(t, err)
+}
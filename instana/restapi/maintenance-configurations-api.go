@@ -0,0 +1,66 @@
+package restapi
+
+import (
+	"errors"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//MaintenanceConfigurationsResourcePath path to Maintenance Configuration resource of Instana RESTful API
+const MaintenanceConfigurationsResourcePath = SettingsBasePath + "/maintenance"
+
+//MaintenanceWindow is the representation of a time window of a maintenance configuration in Instana. Start and end are provided as unix timestamps in milliseconds
+type MaintenanceWindow struct {
+	ID    string `json:"id"`
+	Start int64  `json:"start"`
+	End   int64  `json:"end"`
+}
+
+//Validate verifies if the maintenance window is correct
+func (w MaintenanceWindow) Validate() error {
+	if utils.IsBlank(w.ID) {
+		return errors.New("ID of maintenance window is missing")
+	}
+	if w.Start < 0 {
+		return errors.New("start of maintenance window must not be negative")
+	}
+	if w.End <= w.Start {
+		return errors.New("end of maintenance window must be after the start of the maintenance window")
+	}
+	return nil
+}
+
+//MaintenanceConfiguration is the representation of a maintenance configuration in Instana
+type MaintenanceConfiguration struct {
+	ID      string              `json:"id"`
+	Name    string              `json:"name"`
+	Query   string              `json:"query"`
+	Windows []MaintenanceWindow `json:"windows"`
+}
+
+//GetID implemention of the interface InstanaDataObject
+func (c MaintenanceConfiguration) GetID() string {
+	return c.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (c MaintenanceConfiguration) Validate() error {
+	if utils.IsBlank(c.ID) {
+		return errors.New("ID is missing")
+	}
+	if utils.IsBlank(c.Name) {
+		return errors.New("name is missing")
+	}
+	if utils.IsBlank(c.Query) {
+		return errors.New("query is missing")
+	}
+	if len(c.Windows) > 1 {
+		return errors.New("at most one maintenance window is supported")
+	}
+	for _, w := range c.Windows {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
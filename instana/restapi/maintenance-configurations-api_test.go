@@ -0,0 +1,122 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	maintenanceConfigID    = "maintenance-config-id"
+	maintenanceConfigName  = "maintenance-config-name"
+	maintenanceConfigQuery = "entity.zone:\"test\""
+	maintenanceWindowID    = "maintenance-window-id"
+)
+
+func TestValidMinimalMaintenanceConfiguration(t *testing.T) {
+	config := MaintenanceConfiguration{
+		ID:    maintenanceConfigID,
+		Name:  maintenanceConfigName,
+		Query: maintenanceConfigQuery,
+	}
+
+	assert.Equal(t, maintenanceConfigID, config.GetID())
+	assert.Nil(t, config.Validate())
+}
+
+func TestValidMaintenanceConfigurationWithWindow(t *testing.T) {
+	config := MaintenanceConfiguration{
+		ID:      maintenanceConfigID,
+		Name:    maintenanceConfigName,
+		Query:   maintenanceConfigQuery,
+		Windows: []MaintenanceWindow{{ID: maintenanceWindowID, Start: 1000, End: 2000}},
+	}
+
+	assert.Nil(t, config.Validate())
+}
+
+func TestInvalidMaintenanceConfigurationBecauseOfMissingID(t *testing.T) {
+	config := MaintenanceConfiguration{
+		Name:  maintenanceConfigName,
+		Query: maintenanceConfigQuery,
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "ID")
+}
+
+func TestInvalidMaintenanceConfigurationBecauseOfMissingName(t *testing.T) {
+	config := MaintenanceConfiguration{
+		ID:    maintenanceConfigID,
+		Query: maintenanceConfigQuery,
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name")
+}
+
+func TestInvalidMaintenanceConfigurationBecauseOfMissingQuery(t *testing.T) {
+	config := MaintenanceConfiguration{
+		ID:   maintenanceConfigID,
+		Name: maintenanceConfigName,
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "query")
+}
+
+func TestInvalidMaintenanceConfigurationBecauseOfMoreThanOneWindow(t *testing.T) {
+	config := MaintenanceConfiguration{
+		ID:    maintenanceConfigID,
+		Name:  maintenanceConfigName,
+		Query: maintenanceConfigQuery,
+		Windows: []MaintenanceWindow{
+			{ID: maintenanceWindowID, Start: 1000, End: 2000},
+			{ID: "other-window-id", Start: 3000, End: 4000},
+		},
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "at most one maintenance window")
+}
+
+func TestInvalidMaintenanceConfigurationBecauseOfInvalidWindow(t *testing.T) {
+	config := MaintenanceConfiguration{
+		ID:      maintenanceConfigID,
+		Name:    maintenanceConfigName,
+		Query:   maintenanceConfigQuery,
+		Windows: []MaintenanceWindow{{Start: 1000, End: 2000}},
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "ID of maintenance window")
+}
+
+func TestInvalidMaintenanceWindowBecauseOfNegativeStart(t *testing.T) {
+	window := MaintenanceWindow{ID: maintenanceWindowID, Start: -1, End: 2000}
+
+	err := window.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "start")
+}
+
+func TestInvalidMaintenanceWindowBecauseEndIsNotAfterStart(t *testing.T) {
+	window := MaintenanceWindow{ID: maintenanceWindowID, Start: 2000, End: 2000}
+
+	err := window.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "end")
+}
@@ -0,0 +1,27 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//NewReleaseUnmarshaller creates a new Unmarshaller instance for releases
+func NewReleaseUnmarshaller() Unmarshaller {
+	return &releaseUnmarshaller{}
+}
+
+type releaseUnmarshaller struct{}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *releaseUnmarshaller) Unmarshal(data []byte) (InstanaDataObject, error) {
+	release := Release{}
+	if err := json.Unmarshal(data, &release); err != nil {
+		return release, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return release, nil
+}
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *releaseUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
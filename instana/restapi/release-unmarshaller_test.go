@@ -0,0 +1,76 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+func TestShouldSuccessfullyUnmarshalRelease(t *testing.T) {
+	release := Release{
+		ID:           "release-id",
+		Name:         "release-name",
+		Start:        1609459200000,
+		Applications: []ReleaseApplication{{Name: "app"}},
+		Services: []ReleaseService{
+			{Name: "service", ScopedTo: &ReleaseServiceScope{Applications: []ReleaseApplication{{Name: "app"}}}},
+		},
+	}
+
+	serializedJSON, _ := json.Marshal(release)
+
+	result, err := NewReleaseUnmarshaller().Unmarshal(serializedJSON)
+
+	assert.Nil(t, err)
+	assert.Equal(t, release, result)
+}
+
+func TestShouldIgnoreLastUpdatedWhenUnmarshallingRelease(t *testing.T) {
+	response := `{
+		"id" : "release-id",
+		"name" : "release-name",
+		"start" : 1609459200000,
+		"lastUpdated" : 1609459300000
+	}`
+
+	result, err := NewReleaseUnmarshaller().Unmarshal([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Equal(t, Release{ID: "release-id", Name: "release-name", Start: 1609459200000}, result)
+}
+
+func TestShouldFailToUnmarshalReleaseWhenResponseIsAJsonArray(t *testing.T) {
+	response := `["foo","bar"]`
+
+	_, err := NewReleaseUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUnmarshalReleaseWhenResponseIsNotAJsonMessage(t *testing.T) {
+	response := `foo bar`
+
+	_, err := NewReleaseUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldSuccessfullyUnmarshalArrayOfReleases(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"name" : "test-name-1"
+	},{
+		"id" : "test-id-2",
+		"name" : "test-name-2"
+	}]`
+
+	result, err := NewReleaseUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "test-id-1", result[0].(Release).ID)
+	assert.Equal(t, "test-id-2", result[1].(Release).ID)
+}
@@ -0,0 +1,69 @@
+package restapi
+
+import (
+	"errors"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//ReleasesResourcePath path to releases resource of Instana RESTful API
+const ReleasesResourcePath = InstanaAPIBasePath + "/releases"
+
+//ReleaseApplication is the representation of an application a release is scoped to
+type ReleaseApplication struct {
+	Name string `json:"name"`
+}
+
+//ReleaseServiceScope is the representation of the applications a service of a release is scoped to
+type ReleaseServiceScope struct {
+	Applications []ReleaseApplication `json:"applications"`
+}
+
+//ReleaseService is the representation of a service a release is scoped to. The service can optionally be restricted to the given applications
+type ReleaseService struct {
+	Name     string               `json:"name"`
+	ScopedTo *ReleaseServiceScope `json:"scopedTo,omitempty"`
+}
+
+//Release is the representation of a release marker in Instana. The start is provided as unix timestamp in milliseconds. When no applications
+//and services are provided the release is a global release
+type Release struct {
+	ID           string               `json:"id,omitempty"`
+	Name         string               `json:"name"`
+	Start        int64                `json:"start"`
+	Applications []ReleaseApplication `json:"applications,omitempty"`
+	Services     []ReleaseService     `json:"services,omitempty"`
+}
+
+//GetID implemention of the interface InstanaDataObject
+func (r Release) GetID() string {
+	return r.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct. The ID is not validated as it is not available before the release is created
+func (r Release) Validate() error {
+	if utils.IsBlank(r.Name) {
+		return errors.New("name is missing")
+	}
+	if r.Start < 1 {
+		return errors.New("start is missing")
+	}
+	for _, a := range r.Applications {
+		if utils.IsBlank(a.Name) {
+			return errors.New("name of application is missing")
+		}
+	}
+	for _, s := range r.Services {
+		if utils.IsBlank(s.Name) {
+			return errors.New("name of service is missing")
+		}
+		if s.ScopedTo != nil {
+			for _, a := range s.ScopedTo.Applications {
+				if utils.IsBlank(a.Name) {
+					return errors.New("name of application of service scope is missing")
+				}
+			}
+		}
+	}
+	return nil
+}
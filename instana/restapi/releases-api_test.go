@@ -0,0 +1,114 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	releaseID    = "release-id"
+	releaseName  = "release-name"
+	releaseStart = int64(1609459200000)
+)
+
+func TestValidMinimalRelease(t *testing.T) {
+	release := Release{
+		ID:    releaseID,
+		Name:  releaseName,
+		Start: releaseStart,
+	}
+
+	assert.Equal(t, releaseID, release.GetID())
+
+	err := release.Validate()
+	assert.Nil(t, err)
+}
+
+func TestValidReleaseWithScope(t *testing.T) {
+	release := Release{
+		ID:           releaseID,
+		Name:         releaseName,
+		Start:        releaseStart,
+		Applications: []ReleaseApplication{{Name: "app"}},
+		Services: []ReleaseService{
+			{Name: "service-1"},
+			{Name: "service-2", ScopedTo: &ReleaseServiceScope{Applications: []ReleaseApplication{{Name: "app"}}}},
+		},
+	}
+
+	err := release.Validate()
+	assert.Nil(t, err)
+}
+
+func TestReleaseWithoutIDShouldBeValidAsTheIDIsAssignedByInstana(t *testing.T) {
+	release := Release{
+		Name:  releaseName,
+		Start: releaseStart,
+	}
+
+	err := release.Validate()
+	assert.Nil(t, err)
+}
+
+func TestInvalidReleaseBecauseOfMissingName(t *testing.T) {
+	release := Release{
+		ID:    releaseID,
+		Start: releaseStart,
+	}
+
+	err := release.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name")
+}
+
+func TestInvalidReleaseBecauseOfMissingStart(t *testing.T) {
+	release := Release{
+		ID:   releaseID,
+		Name: releaseName,
+	}
+
+	err := release.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "start")
+}
+
+func TestInvalidReleaseBecauseOfApplicationWithoutName(t *testing.T) {
+	release := Release{
+		ID:           releaseID,
+		Name:         releaseName,
+		Start:        releaseStart,
+		Applications: []ReleaseApplication{{Name: ""}},
+	}
+
+	err := release.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "application")
+}
+
+func TestInvalidReleaseBecauseOfServiceWithoutName(t *testing.T) {
+	release := Release{
+		ID:       releaseID,
+		Name:     releaseName,
+		Start:    releaseStart,
+		Services: []ReleaseService{{Name: ""}},
+	}
+
+	err := release.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "service")
+}
+
+func TestInvalidReleaseBecauseOfServiceScopedToApplicationWithoutName(t *testing.T) {
+	release := Release{
+		ID:       releaseID,
+		Name:     releaseName,
+		Start:    releaseStart,
+		Services: []ReleaseService{{Name: "service", ScopedTo: &ReleaseServiceScope{Applications: []ReleaseApplication{{Name: ""}}}}},
+	}
+
+	err := release.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "service scope")
+}
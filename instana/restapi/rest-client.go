@@ -2,8 +2,14 @@ package restapi
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,73 +20,194 @@ import (
 //ErrEntityNotFound error message which is returned when the entity cannot be found at the server
 var ErrEntityNotFound = errors.New("Failed to get resource from Instana API. 404 - Resource not found")
 
-//RestClient interface to access REST resources of the Instana API
+//errThrottleTimeout error which is returned when a throttled request times out. Such requests are not retried
+var errThrottleTimeout = errors.New("API request timed out while waiting for the throttle")
+
+//RestClient interface to access REST resources of the Instana API. Requests are cancelled when the provided context is done
 type RestClient interface {
-	GetOne(id string, resourcePath string) ([]byte, error)
-	Put(data InstanaDataObject, resourcePath string) ([]byte, error)
-	Delete(resourceID string, resourceBasePath string) error
+	Get(ctx context.Context, resourcePath string) ([]byte, error)
+	GetOne(ctx context.Context, id string, resourcePath string) ([]byte, error)
+	Post(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error)
+	PostByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error)
+	Put(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error)
+	PutByQuery(ctx context.Context, resourcePath string, id string, queryParams map[string]string) ([]byte, error)
+	PutByPath(ctx context.Context, resourcePath string) ([]byte, error)
+	Delete(ctx context.Context, resourceID string, resourceBasePath string) error
+	DeleteByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) error
 }
 
-type apiRequest struct {
-	method          string
-	url             string
-	request         resty.Request
-	responseChannel chan *apiResponse
-	ctx             context.Context
+//RetryPolicy defines how requests to the Instana API are retried when they fail temporarily. Requests are retried
+//when the API responds with 429, 502, 503 or 504 or when the request cannot be sent at all. Requests which time out
+//while waiting for the throttle are not retried. POST requests are not idempotent and are therefore only retried when
+//the API responds with 429 or 503 or when no connection to the API could be established.
+type RetryPolicy struct {
+	//MaxRetries the maximum number of retries of a single request. Retries are disabled when set to 0
+	MaxRetries int
+	//MaxWait the maximum duration to wait before a request is retried
+	MaxWait time.Duration
 }
 
-type apiResponse struct {
-	data []byte
-	err  error
+//DefaultRetryPolicy the retry policy which is used when no explicit retry policy is configured
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MaxWait:    30 * time.Second,
 }
 
-//NewClient creates a new instance of the Instana REST API client
-func NewClient(apiToken string, host string) RestClient {
+//retryBaseWait the wait duration of the first retry which is doubled for every further retry
+const retryBaseWait = 500 * time.Millisecond
+
+const (
+	headerRetryAfter         = "Retry-After"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+//nonIdempotentRetryableStatusCodes the status codes of non idempotent requests which guarantee that the request was
+//not processed by the API
+var nonIdempotentRetryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusServiceUnavailable: true,
+}
+
+//ClientConfig configuration of the Instana REST API client
+type ClientConfig struct {
+	//RetryPolicy the policy how temporarily failing requests are retried
+	RetryPolicy RetryPolicy
+	//Throttling the configuration how requests are throttled
+	Throttling ThrottlingConfig
+	//TLSConfig the optional TLS configuration of the HTTP connections. The default TLS configuration is used when not set
+	TLSConfig *tls.Config
+	//ProxyURL the optional URL of the proxy server for all requests. The proxy is determined from the environment when not set
+	ProxyURL *url.URL
+	//Clock the optional clock used for throttling and for waiting between retries. The system clock is used when not set
+	Clock Clock
+}
+
+//DefaultClientConfig the client configuration which is used when no explicit configuration is provided
+var DefaultClientConfig = ClientConfig{
+	RetryPolicy: DefaultRetryPolicy,
+	Throttling:  DefaultThrottlingConfig,
+}
+
+type requestSender func(ctx context.Context, method string, url string, req *resty.Request) (*resty.Response, error)
+
+//NewClient creates a new instance of the Instana REST API client using the given configuration. The base URL consists of
+//the scheme, the host and optionally the port of the Instana backend (e.g. https://tenant-unit.instana.io)
+func NewClient(apiToken string, baseURL string, config ClientConfig) RestClient {
 	restyClient := resty.New()
+	if config.TLSConfig != nil || config.ProxyURL != nil {
+		restyClient.SetTransport(createTransport(config))
+	}
+
+	clock := config.Clock
+	if clock == nil {
+		clock = NewSystemClock()
+	}
 
-	throttleRate := time.Second / 5 //5 write requests per second
-	throttledRequests := make(chan *apiRequest, 1000)
-	client := &restClientImpl{
-		apiToken:          apiToken,
-		host:              host,
-		restyClient:       restyClient,
-		throttledRequests: throttledRequests,
-		throttleRate:      throttleRate,
+	return &restClientImpl{
+		apiToken:    apiToken,
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+		restyClient: restyClient,
+		config:      config,
+		clock:       clock,
+		throttle:    NewThrottle(config.Throttling, clock),
 	}
+}
 
-	go client.processThrottledRequests()
-	return client
+func createTransport(config ClientConfig) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.TLSConfig != nil {
+		transport.TLSClientConfig = config.TLSConfig
+	}
+	if config.ProxyURL != nil {
+		transport.Proxy = http.ProxyURL(config.ProxyURL)
+	}
+	return transport
 }
 
 type restClientImpl struct {
-	apiToken          string
-	host              string
-	restyClient       *resty.Client
-	throttledRequests chan *apiRequest
-	throttleRate      time.Duration
+	apiToken    string
+	baseURL     string
+	restyClient *resty.Client
+	config      ClientConfig
+	clock       Clock
+	throttle    Throttle
 }
 
 var emptyResponse = make([]byte, 0)
 
+//Get request all elements of the resource with the given resource path
+func (client *restClientImpl) Get(ctx context.Context, resourcePath string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	return client.executeRequest(ctx, resty.MethodGet, url, req)
+}
+
 //GetOne request the resource with the given ID
-func (client *restClientImpl) GetOne(id string, resourcePath string) ([]byte, error) {
+func (client *restClientImpl) GetOne(ctx context.Context, id string, resourcePath string) ([]byte, error) {
 	url := client.buildResourceURL(resourcePath, id)
 	req := client.createRequest()
-	return client.executeRequest(resty.MethodGet, url, req)
+	return client.executeRequest(ctx, resty.MethodGet, url, req)
+}
+
+//Post executes a HTTP POST request to the given resource path. The request body is omitted when no data is provided
+func (client *restClientImpl) Post(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	if data != nil {
+		req = req.SetHeader("Content-Type", "application/json; charset=utf-8").SetBody(data)
+	}
+	return client.executeRequestWithThrottling(ctx, resty.MethodPost, url, req)
+}
+
+//PostByQuery executes a HTTP POST request without body to the given resource path using the provided query parameters
+func (client *restClientImpl) PostByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest().SetQueryParams(queryParams)
+	return client.executeRequestWithThrottling(ctx, resty.MethodPost, url, req)
 }
 
 //Put executes a HTTP PUT request to create or update the given resource
-func (client *restClientImpl) Put(data InstanaDataObject, resourcePath string) ([]byte, error) {
+func (client *restClientImpl) Put(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error) {
 	url := client.buildResourceURL(resourcePath, data.GetID())
 	req := client.createRequest().SetHeader("Content-Type", "application/json; charset=utf-8").SetBody(data)
-	return client.executeRequestWithThrottling(resty.MethodPut, url, req)
+	return client.executeRequestWithThrottling(ctx, resty.MethodPut, url, req)
+}
+
+//PutByQuery executes a HTTP PUT request without body to update the resource with the given ID using the provided query parameters
+func (client *restClientImpl) PutByQuery(ctx context.Context, resourcePath string, id string, queryParams map[string]string) ([]byte, error) {
+	url := client.buildResourceURL(resourcePath, id)
+	req := client.createRequest().SetQueryParams(queryParams)
+	return client.executeRequestWithThrottling(ctx, resty.MethodPut, url, req)
+}
+
+//PutByPath executes a HTTP PUT request without body to the given resource path
+func (client *restClientImpl) PutByPath(ctx context.Context, resourcePath string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	return client.executeRequestWithThrottling(ctx, resty.MethodPut, url, req)
 }
 
 //Delete executes a HTTP DELETE request to delete the resource with the given ID
-func (client *restClientImpl) Delete(resourceID string, resourceBasePath string) error {
+func (client *restClientImpl) Delete(ctx context.Context, resourceID string, resourceBasePath string) error {
 	url := client.buildResourceURL(resourceBasePath, resourceID)
 	req := client.createRequest()
-	_, err := client.executeRequestWithThrottling(resty.MethodDelete, url, req)
+	_, err := client.executeRequestWithThrottling(ctx, resty.MethodDelete, url, req)
+	return err
+}
+
+//DeleteByQuery executes a HTTP DELETE request to the given resource path using the provided query parameters
+func (client *restClientImpl) DeleteByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) error {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest().SetQueryParams(queryParams)
+	_, err := client.executeRequestWithThrottling(ctx, resty.MethodDelete, url, req)
 	return err
 }
 
@@ -88,54 +215,57 @@ func (client *restClientImpl) createRequest() *resty.Request {
 	return client.restyClient.R().SetHeader("Accept", "application/json").SetHeader("Authorization", fmt.Sprintf("apiToken %s", client.apiToken))
 }
 
-func (client *restClientImpl) executeRequestWithThrottling(method string, url string, req *resty.Request) ([]byte, error) {
-	responseChannel := make(chan *apiResponse)
-	ctx, cancel := context.WithCancel(context.Background())
-	defer close(responseChannel)
-	defer cancel()
-
-	client.throttledRequests <- &apiRequest{
-		method:          method,
-		url:             url,
-		request:         *req,
-		ctx:             ctx,
-		responseChannel: responseChannel,
+func (client *restClientImpl) executeRequest(ctx context.Context, method string, url string, req *resty.Request) ([]byte, error) {
+	if client.config.Throttling.ThrottleReadRequests {
+		return client.executeRequestWithRetries(ctx, method, url, req, client.sendThrottledRequest)
 	}
+	return client.executeRequestWithRetries(ctx, method, url, req, client.sendRequest)
+}
 
-	select {
-	case r := <-responseChannel:
-		return r.data, r.err
-	case <-time.After(30 * time.Second):
-		return nil, errors.New("API request timed out")
-	}
+func (client *restClientImpl) executeRequestWithThrottling(ctx context.Context, method string, url string, req *resty.Request) ([]byte, error) {
+	return client.executeRequestWithRetries(ctx, method, url, req, client.sendThrottledRequest)
 }
 
-func (client *restClientImpl) processThrottledRequests() {
-	throttle := time.Tick(client.throttleRate)
-	for req := range client.throttledRequests {
-		<-throttle
-		go client.handleThrottledAPIRequest(req)
+func (client *restClientImpl) executeRequestWithRetries(ctx context.Context, method string, url string, req *resty.Request, send requestSender) ([]byte, error) {
+	retryPolicy := client.config.RetryPolicy
+	for attempt := 0; ; attempt++ {
+		resp, err := send(ctx, method, url, req)
+		if err == nil {
+			client.throttle.Observe(resp.StatusCode(), resp.Header())
+		}
+		if attempt >= retryPolicy.MaxRetries || ctx.Err() != nil || !isRetryable(method, resp, err) {
+			return client.handleResponse(method, resp, err)
+		}
+		wait := client.calculateRetryWait(attempt, resp)
+		log.Warnf("HTTP %s request to %s failed temporarily; retry %d of %d in %s", method, url, attempt+1, retryPolicy.MaxRetries, wait)
+		select {
+		case <-client.clock.After(wait):
+		case <-ctx.Done():
+			return emptyResponse, fmt.Errorf("HTTP %s request to %s cancelled before retry; %s", method, url, ctx.Err())
+		}
 	}
 }
 
-func (client *restClientImpl) handleThrottledAPIRequest(req *apiRequest) {
-	data, err := client.executeRequest(req.method, req.url, &req.request)
-	responseMessage := &apiResponse{
-		data: data,
-		err:  err,
-	}
-	select {
-	case <-req.ctx.Done():
-		return
-	default:
-		req.responseChannel <- responseMessage
+func (client *restClientImpl) sendThrottledRequest(ctx context.Context, method string, url string, req *resty.Request) (*resty.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.config.Throttling.Timeout)
+	defer cancel()
+
+	if err := client.throttle.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("%w; %s", errThrottleTimeout, err)
 	}
+	return client.sendRequest(ctx, method, url, req)
 }
 
-func (client *restClientImpl) executeRequest(method string, url string, req *resty.Request) ([]byte, error) {
+func (client *restClientImpl) sendRequest(ctx context.Context, method string, url string, req *resty.Request) (*resty.Response, error) {
 	log.Infof("Call %s %s", method, url)
-	resp, err := req.Execute(method, url)
+	return req.SetContext(ctx).Execute(method, url)
+}
+
+func (client *restClientImpl) handleResponse(method string, resp *resty.Response, err error) ([]byte, error) {
 	if err != nil {
+		if resp == nil {
+			return emptyResponse, fmt.Errorf("failed to send HTTP %s request to Instana API; %s", method, err)
+		}
 		return emptyResponse, fmt.Errorf("failed to send HTTP %s request to Instana API; status code = %d; status message = %s; Headers %s, %s", method, resp.StatusCode(), resp.Status(), resp.Header(), err)
 	}
 	statusCode := resp.StatusCode()
@@ -148,6 +278,78 @@ func (client *restClientImpl) executeRequest(method string, url string, req *res
 	return resp.Body(), nil
 }
 
+func isRetryable(method string, resp *resty.Response, err error) bool {
+	idempotent := method != resty.MethodPost
+	if err != nil {
+		if errors.Is(err, errThrottleTimeout) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		return idempotent || isConnectionError(err)
+	}
+	if idempotent {
+		return retryableStatusCodes[resp.StatusCode()]
+	}
+	return nonIdempotentRetryableStatusCodes[resp.StatusCode()]
+}
+
+//isConnectionError returns true when the request failed because no connection to the API could be established. In
+//this case the request was not sent at all
+func isConnectionError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+//calculateRetryWait returns the duration to wait before the next attempt. The wait duration requested by the server
+//is used when provided. Otherwise an exponential backoff with jitter is applied. The result is limited by the maximum
+//wait duration of the retry policy
+func (client *restClientImpl) calculateRetryWait(attempt int, resp *resty.Response) time.Duration {
+	maxWait := client.config.RetryPolicy.MaxWait
+	if wait, ok := getServerRequestedWait(resp, client.clock.Now()); ok {
+		return minDuration(wait, maxWait)
+	}
+	backoff := minDuration(retryBaseWait<<uint(attempt), maxWait)
+	if backoff <= 0 {
+		return 0
+	}
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(backoff-half)+1))
+}
+
+func getServerRequestedWait(resp *resty.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil || resp.RawResponse == nil {
+		return 0, false
+	}
+	headers := resp.Header()
+	if retryAfter := headers.Get(headerRetryAfter); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return nonNegativeDuration(time.Duration(seconds) * time.Second), true
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return nonNegativeDuration(date.Sub(now)), true
+		}
+	}
+	if headers.Get(headerRateLimitRemaining) == "0" {
+		if reset, err := strconv.ParseInt(headers.Get(headerRateLimitReset), 10, 64); err == nil {
+			return nonNegativeDuration(time.Unix(reset, 0).Sub(now)), true
+		}
+	}
+	return 0, false
+}
+
+func minDuration(a time.Duration, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func nonNegativeDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
 func (client *restClientImpl) buildResourceURL(resourceBasePath string, id string) string {
 	pattern := "%s/%s"
 	if strings.HasSuffix(resourceBasePath, "/") {
@@ -158,5 +360,5 @@ func (client *restClientImpl) buildResourceURL(resourceBasePath string, id strin
 }
 
 func (client *restClientImpl) buildURL(resourcePath string) string {
-	return fmt.Sprintf("https://%s%s", client.host, resourcePath)
+	return fmt.Sprintf("%s%s", client.baseURL, resourcePath)
 }
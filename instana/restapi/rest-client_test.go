@@ -1,10 +1,19 @@
 package restapi_test
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
@@ -16,12 +25,33 @@ const testID = "test-1234"
 const testData = "testData"
 const testPathWithID = testPath + "/" + testID
 
+func TestShouldReturnDataForSuccessfulGetRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodGet, testPath)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessfullGetOrPut(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForGetRequestWhenStatusIsNotASuccessStatusAndNotEnityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodGet, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.Get(context.Background(), testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
 func TestShouldReturnDataForSuccessfulGetOneRequest(t *testing.T) {
 	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodGet, testPathWithID)
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	response, err := restClient.GetOne(testID, testPath)
+	response, err := restClient.GetOne(context.Background(), testID, testPath)
 
 	verifySuccessfullGetOrPut(response, err, t)
 }
@@ -31,7 +61,7 @@ func TestShouldReturnDataForSuccessfulGetOneRequestWhenResourcePathEndsWithASlas
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	response, err := restClient.GetOne(testID, testPath+"/")
+	response, err := restClient.GetOne(context.Background(), testID, testPath+"/")
 
 	verifySuccessfullGetOrPut(response, err, t)
 }
@@ -42,7 +72,7 @@ func TestShouldReturnErrorMessageForGetOneRequestWhenStatusIsNotASuccessStatusAn
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	_, err := restClient.GetOne(testID, testPath)
+	_, err := restClient.GetOne(context.Background(), testID, testPath)
 
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
@@ -52,17 +82,69 @@ func TestShouldReturnNotFoundErrorMessageForGetOneRequestWhenStatusIsNotEnityNot
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	data, err := restClient.GetOne(testID, testPath)
+	data, err := restClient.GetOne(context.Background(), testID, testPath)
 
 	verifyNotFoundResponse(data, err, t)
 }
 
+func TestShouldReturnDataForSuccessfulPostRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPost, testPath)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.Post(context.Background(), testDataObject{id: testID}, testPath)
+
+	verifySuccessfullGetOrPut(response, err, t)
+}
+
+func TestShouldReturnDataForSuccessfulPostRequestWithoutBody(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPost, testPath)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.Post(context.Background(), nil, testPath)
+
+	verifySuccessfullGetOrPut(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPostRequestWhenStatusIsNotASuccessStatusAndNotEnityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPost, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.Post(context.Background(), testDataObject{id: testID}, testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnDataForSuccessfulPostByQueryRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerEchoingQueryParameter(http.MethodPost, testPath, "name")
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PostByQuery(context.Background(), testPath, map[string]string{"name": testData})
+
+	verifySuccessfullGetOrPut(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPostByQueryRequestWhenStatusIsNotASuccessStatusAndNotEnityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPost, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PostByQuery(context.Background(), testPath, map[string]string{"name": testData})
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
 func TestShouldReturnDataForSuccessfulPutRequest(t *testing.T) {
 	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPathWithID)
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	response, err := restClient.Put(testDataObject{id: testID}, testPath)
+	response, err := restClient.Put(context.Background(), testDataObject{id: testID}, testPath)
 
 	verifySuccessfullGetOrPut(response, err, t)
 }
@@ -73,7 +155,49 @@ func TestShouldReturnErrorMessageForPutRequestWhenStatusIsNotASuccessStatusAndNo
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	_, err := restClient.Put(testDataObject{id: testID}, testPath)
+	_, err := restClient.Put(context.Background(), testDataObject{id: testID}, testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnDataForSuccessfulPutByQueryRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerEchoingQueryParameter(http.MethodPut, testPathWithID, "name")
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutByQuery(context.Background(), testPath, testID, map[string]string{"name": testData})
+
+	verifySuccessfullGetOrPut(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPutByQueryRequestWhenStatusIsNotASuccessStatusAndNotEnityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPut, testPathWithID, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PutByQuery(context.Background(), testPath, testID, map[string]string{"name": testData})
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnDataForSuccessfulPutByPathRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPathWithID)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutByPath(context.Background(), testPathWithID)
+
+	verifySuccessfullGetOrPut(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPutByPathRequestWhenStatusIsNotASuccessStatusAndNotEnityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPut, testPathWithID, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PutByPath(context.Background(), testPathWithID)
 
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
@@ -98,7 +222,7 @@ func TestShouldReturnNothingForSuccessfulDeleteRequest(t *testing.T) {
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	err := restClient.Delete(testID, testPath)
+	err := restClient.Delete(context.Background(), testID, testPath)
 
 	assert.Nil(t, err)
 }
@@ -109,11 +233,385 @@ func TestShouldReturnErrorMessageForDeleteRequestWhenStatusIsNotASuccessStatusAn
 	defer httpServer.Close()
 
 	restClient := createSut(httpServer)
-	err := restClient.Delete(testID, testPath)
+	err := restClient.Delete(context.Background(), testID, testPath)
 
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
 
+func TestShouldReturnNothingForSuccessfulDeleteByQueryRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerEchoingQueryParameter(http.MethodDelete, testPath, "name")
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteByQuery(context.Background(), testPath, map[string]string{"name": testData})
+
+	assert.Nil(t, err)
+}
+
+func TestShouldReturnErrorMessageForDeleteByQueryRequestWhenStatusIsNotASuccessStatusAndNotEnityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodDelete, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteByQuery(context.Background(), testPath, map[string]string{"name": testData})
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldRetryRequestWhenStatusIsTemporaryFailure(t *testing.T) {
+	for _, statusCode := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		t.Run(fmt.Sprintf("Should retry request when status is %d", statusCode), func(t *testing.T) {
+			httpServer, counter := setupAndStartHttpServerFailingTemporarily(http.MethodPut, testPathWithID, 2, func(w http.ResponseWriter) {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(statusCode)
+			})
+			defer httpServer.Close()
+
+			restClient := createSutWithRetryPolicy(httpServer, RetryPolicy{MaxRetries: 2, MaxWait: time.Second})
+			response, err := restClient.Put(context.Background(), &testObject{ID: testID}, testPath)
+
+			verifySuccessfullGetOrPut(response, err, t)
+			assert.Equal(t, int32(3), atomic.LoadInt32(counter))
+		})
+	}
+}
+
+func TestShouldRetryRequestWhenTransportErrorOccurs(t *testing.T) {
+	httpServer, counter := setupAndStartHttpServerFailingTemporarily(http.MethodGet, testPath, 1, func(w http.ResponseWriter) {
+		panic(http.ErrAbortHandler)
+	})
+	defer httpServer.Close()
+
+	restClient := createSutWithRetryPolicy(httpServer, RetryPolicy{MaxRetries: 1, MaxWait: 10 * time.Millisecond})
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessfullGetOrPut(response, err, t)
+	assert.Equal(t, int32(2), atomic.LoadInt32(counter))
+}
+
+func TestShouldRetryPostRequestOnlyWhenStatusGuaranteesThatRequestWasNotProcessed(t *testing.T) {
+	testCases := map[int]int32{
+		http.StatusTooManyRequests:    2,
+		http.StatusServiceUnavailable: 2,
+		http.StatusBadGateway:         1,
+		http.StatusGatewayTimeout:     1,
+	}
+	for statusCode, expectedNumberOfRequests := range testCases {
+		t.Run(fmt.Sprintf("Should send POST request %d times when status is %d", expectedNumberOfRequests, statusCode), func(t *testing.T) {
+			httpServer, counter := setupAndStartHttpServerFailingTemporarily(http.MethodPost, testPath, 1, func(w http.ResponseWriter) {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(statusCode)
+			})
+			defer httpServer.Close()
+
+			restClient := createSutWithConfig(httpServer, ClientConfig{RetryPolicy: RetryPolicy{MaxRetries: 1, MaxWait: time.Second}, Throttling: DefaultThrottlingConfig, Clock: newFakeClock()})
+			_, _ = restClient.Post(context.Background(), &testObject{ID: testID}, testPath)
+
+			assert.Equal(t, expectedNumberOfRequests, atomic.LoadInt32(counter))
+		})
+	}
+}
+
+func TestShouldNotRetryPostRequestWhenTransportErrorOccursAfterConnectionWasEstablished(t *testing.T) {
+	httpServer, counter := setupAndStartHttpServerFailingTemporarily(http.MethodPost, testPath, 1, func(w http.ResponseWriter) {
+		panic(http.ErrAbortHandler)
+	})
+	defer httpServer.Close()
+
+	restClient := createSutWithConfig(httpServer, ClientConfig{RetryPolicy: RetryPolicy{MaxRetries: 1, MaxWait: time.Second}, Throttling: DefaultThrottlingConfig, Clock: newFakeClock()})
+	_, err := restClient.Post(context.Background(), &testObject{ID: testID}, testPath)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(counter))
+}
+
+func TestShouldRetryPostRequestWhenConnectionCannotBeEstablished(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	httpServer := httptest.NewUnstartedServer(createOKHandlerFunc())
+	defer httpServer.Close()
+	var once sync.Once
+	clock := &callbackClock{fakeClock: newFakeClock(), onAfter: func() {
+		once.Do(func() {
+			listener, err := net.Listen("tcp", address)
+			assert.Nil(t, err)
+			httpServer.Listener.Close()
+			httpServer.Listener = listener
+			httpServer.Start()
+		})
+	}}
+
+	restClient := NewClient("api-token", "http://"+address, ClientConfig{RetryPolicy: RetryPolicy{MaxRetries: 1, MaxWait: time.Second}, Throttling: DefaultThrottlingConfig, Clock: clock})
+	response, err := restClient.Post(context.Background(), &testObject{ID: testID}, testPath)
+
+	verifySuccessfullGetOrPut(response, err, t)
+}
+
+func TestShouldReturnErrorWhenMaximumNumberOfRetriesIsReached(t *testing.T) {
+	httpServer, counter := setupAndStartHttpServerFailingTemporarily(http.MethodGet, testPath, 10, func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer httpServer.Close()
+
+	restClient := createSutWithRetryPolicy(httpServer, RetryPolicy{MaxRetries: 2, MaxWait: 10 * time.Millisecond})
+	_, err := restClient.Get(context.Background(), testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, http.StatusServiceUnavailable, t)
+	assert.Equal(t, int32(3), atomic.LoadInt32(counter))
+}
+
+func TestShouldNotRetryRequestWhenRetriesAreDisabled(t *testing.T) {
+	httpServer, counter := setupAndStartHttpServerFailingTemporarily(http.MethodGet, testPath, 1, func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer httpServer.Close()
+
+	restClient := createSutWithRetryPolicy(httpServer, RetryPolicy{MaxRetries: 0, MaxWait: 10 * time.Millisecond})
+	_, err := restClient.Get(context.Background(), testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, http.StatusServiceUnavailable, t)
+	assert.Equal(t, int32(1), atomic.LoadInt32(counter))
+}
+
+func TestShouldNotRetryRequestWhenStatusIsNotATemporaryFailure(t *testing.T) {
+	httpServer, counter := setupAndStartHttpServerFailingTemporarily(http.MethodGet, testPath, 1, func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	defer httpServer.Close()
+
+	restClient := createSutWithRetryPolicy(httpServer, RetryPolicy{MaxRetries: 2, MaxWait: 10 * time.Millisecond})
+	_, err := restClient.Get(context.Background(), testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, http.StatusInternalServerError, t)
+	assert.Equal(t, int32(1), atomic.LoadInt32(counter))
+}
+
+func TestShouldWaitForDurationRequestedByRetryAfterHeaderBeforeRetryingRequest(t *testing.T) {
+	httpServer, _ := setupAndStartHttpServerFailingTemporarily(http.MethodGet, testPath, 1, func(w http.ResponseWriter) {
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer httpServer.Close()
+
+	clock := newFakeClock()
+	restClient := createSutWithConfig(httpServer, ClientConfig{RetryPolicy: RetryPolicy{MaxRetries: 1, MaxWait: 5 * time.Second}, Throttling: DefaultThrottlingConfig, Clock: clock})
+	start := clock.Now()
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessfullGetOrPut(response, err, t)
+	assert.Equal(t, time.Second, clock.elapsedSince(start))
+}
+
+func TestShouldWaitUntilRateLimitIsResetBeforeRetryingRequest(t *testing.T) {
+	clock := newFakeClock()
+	httpServer, _ := setupAndStartHttpServerFailingTemporarily(http.MethodGet, testPath, 1, func(w http.ResponseWriter) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(clock.Now().Add(2*time.Second).Unix(), 10))
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer httpServer.Close()
+
+	restClient := createSutWithConfig(httpServer, ClientConfig{RetryPolicy: RetryPolicy{MaxRetries: 1, MaxWait: 5 * time.Second}, Throttling: DefaultThrottlingConfig, Clock: clock})
+	start := clock.Now()
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessfullGetOrPut(response, err, t)
+	assert.Equal(t, 2*time.Second, clock.elapsedSince(start))
+}
+
+func TestShouldLimitWaitDurationRequestedByServerToMaximumWaitOfRetryPolicy(t *testing.T) {
+	httpServer, _ := setupAndStartHttpServerFailingTemporarily(http.MethodGet, testPath, 1, func(w http.ResponseWriter) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer httpServer.Close()
+
+	clock := newFakeClock()
+	restClient := createSutWithConfig(httpServer, ClientConfig{RetryPolicy: RetryPolicy{MaxRetries: 1, MaxWait: 10 * time.Millisecond}, Throttling: DefaultThrottlingConfig, Clock: clock})
+	start := clock.Now()
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessfullGetOrPut(response, err, t)
+	assert.Equal(t, 10*time.Millisecond, clock.elapsedSince(start))
+}
+
+func TestShouldReturnErrorWhenThrottledRequestTimesOutWhileWaitingForTheThrottle(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPathWithID)
+	defer httpServer.Close()
+
+	restClient := createSutWithConfig(httpServer, ClientConfig{Throttling: ThrottlingConfig{Rate: 0.01, Burst: 1, Timeout: 50 * time.Millisecond}})
+	response, err := restClient.Put(context.Background(), &testObject{ID: testID}, testPath)
+	verifySuccessfullGetOrPut(response, err, t)
+
+	_, err = restClient.Put(context.Background(), &testObject{ID: testID}, testPath)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+}
+
+func TestShouldNotRetryThrottledRequestWhenItTimesOutWhileWaitingForTheThrottle(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPathWithID)
+	defer httpServer.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clock := &stoppedClock{now: time.Unix(1600000000, 0)}
+	restClient := createSutWithConfig(httpServer, ClientConfig{
+		RetryPolicy: RetryPolicy{MaxRetries: 3, MaxWait: time.Minute},
+		Throttling:  ThrottlingConfig{Rate: 0.01, Burst: 1, Timeout: 50 * time.Millisecond},
+		Clock:       clock,
+	})
+	response, err := restClient.Put(ctx, &testObject{ID: testID}, testPath)
+	verifySuccessfullGetOrPut(response, err, t)
+
+	_, err = restClient.Put(ctx, &testObject{ID: testID}, testPath)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "timed out while waiting for the throttle")
+	assert.Equal(t, 1, clock.numberOfWaits())
+}
+
+func TestShouldThrottleReadRequestsWhenConfigured(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodGet, testPath)
+	defer httpServer.Close()
+
+	restClient := createSutWithConfig(httpServer, ClientConfig{Throttling: ThrottlingConfig{Rate: 0.01, Burst: 1, Timeout: 50 * time.Millisecond, ThrottleReadRequests: true}})
+	response, err := restClient.Get(context.Background(), testPath)
+	verifySuccessfullGetOrPut(response, err, t)
+
+	_, err = restClient.Get(context.Background(), testPath)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+}
+
+func TestShouldReturnErrorWhenContextIsCancelled(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodGet, testPath)
+	defer httpServer.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	restClient := createSutWithRetryPolicy(httpServer, RetryPolicy{MaxRetries: 2, MaxWait: time.Second})
+	_, err := restClient.Get(ctx, testPath)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), context.Canceled.Error())
+}
+
+func TestShouldStopRetryingWhenContextIsDone(t *testing.T) {
+	httpServer, counter := setupAndStartHttpServerFailingTemporarily(http.MethodGet, testPath, 10, func(w http.ResponseWriter) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer httpServer.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	restClient := createSutWithRetryPolicy(httpServer, RetryPolicy{MaxRetries: 2, MaxWait: time.Minute})
+	start := time.Now()
+	_, err := restClient.Get(ctx, testPath)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), context.DeadlineExceeded.Error())
+	assert.Less(t, int64(time.Since(start)), int64(10*time.Second))
+	assert.Equal(t, int32(1), atomic.LoadInt32(counter))
+}
+
+func TestShouldSendRequestToPlainHttpBaseURL(t *testing.T) {
+	httpServer := httptest.NewServer(createOKHandlerFunc())
+	defer httpServer.Close()
+
+	restClient := NewClient("api-token", httpServer.URL+"/", ClientConfig{RetryPolicy: RetryPolicy{MaxRetries: 0}, Throttling: DefaultThrottlingConfig})
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessfullGetOrPut(response, err, t)
+}
+
+func TestShouldVerifyServerCertificateWithConfiguredCertificateAuthority(t *testing.T) {
+	httpServer := httptest.NewTLSServer(createOKHandlerFunc())
+	defer httpServer.Close()
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(httpServer.Certificate())
+
+	restClient := NewClient("api-token", httpServer.URL, ClientConfig{Throttling: DefaultThrottlingConfig, TLSConfig: &tls.Config{RootCAs: rootCAs}})
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessfullGetOrPut(response, err, t)
+}
+
+func TestShouldFailToVerifyServerCertificateWhenCertificateAuthorityIsUnknown(t *testing.T) {
+	httpServer := httptest.NewTLSServer(createOKHandlerFunc())
+	defer httpServer.Close()
+
+	restClient := NewClient("api-token", httpServer.URL, ClientConfig{Throttling: DefaultThrottlingConfig, TLSConfig: &tls.Config{RootCAs: x509.NewCertPool()}})
+	_, err := restClient.Get(context.Background(), testPath)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "certificate")
+}
+
+func TestShouldSendRequestThroughConfiguredProxy(t *testing.T) {
+	var proxiedURL string
+	proxyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedURL = r.URL.String()
+		createOKHandlerFunc()(w, r)
+	}))
+	defer proxyServer.Close()
+	proxyURL, _ := url.Parse(proxyServer.URL)
+
+	restClient := NewClient("api-token", "http://instana.example.com:8080", ClientConfig{Throttling: DefaultThrottlingConfig, ProxyURL: proxyURL})
+	response, err := restClient.Get(context.Background(), testPath)
+
+	verifySuccessfullGetOrPut(response, err, t)
+	assert.Equal(t, "http://instana.example.com:8080"+testPath, proxiedURL)
+}
+
+//stoppedClock is a Clock where the time does not pass. Waiting for the clock blocks until the context is done
+type stoppedClock struct {
+	mutex sync.Mutex
+	now   time.Time
+	waits int
+}
+
+func (c *stoppedClock) Now() time.Time {
+	return c.now
+}
+
+func (c *stoppedClock) After(d time.Duration) <-chan time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.waits++
+	return make(chan time.Time)
+}
+
+func (c *stoppedClock) numberOfWaits() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.waits
+}
+
+//callbackClock is a fake Clock which calls the callback whenever the caller waits for the clock
+type callbackClock struct {
+	*fakeClock
+	onAfter func()
+}
+
+func (c *callbackClock) After(d time.Duration) <-chan time.Time {
+	c.onAfter()
+	return c.fakeClock.After(d)
+}
+
+func createOKHandlerFunc() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(testData))
+	}
+}
+
 func setupAndStartHttpServerWithOKResponseCode(httpMethod string, fullPath string) *testutils.TestHTTPServer {
 	return setupAndStartHttpServer(httpMethod, fullPath, 200)
 }
@@ -130,8 +628,43 @@ func setupAndStartHttpServer(httpMethod string, fullPath string, statusCode int)
 	return httpServer
 }
 
+func setupAndStartHttpServerEchoingQueryParameter(httpMethod string, fullPath string, queryParameter string) *testutils.TestHTTPServer {
+	testutils.DeactivateTLSServerCertificateVerification()
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(httpMethod, fullPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(r.URL.Query().Get(queryParameter)))
+	})
+	httpServer.Start()
+	return httpServer
+}
+
+func setupAndStartHttpServerFailingTemporarily(httpMethod string, fullPath string, numberOfFailures int32, failure func(w http.ResponseWriter)) (*testutils.TestHTTPServer, *int32) {
+	testutils.DeactivateTLSServerCertificateVerification()
+	var counter int32
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(httpMethod, fullPath, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&counter, 1) <= numberOfFailures {
+			failure(w)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(testData))
+	})
+	httpServer.Start()
+	return httpServer, &counter
+}
+
 func createSut(httpServer *testutils.TestHTTPServer) RestClient {
-	return NewClient("api-token", fmt.Sprintf("localhost:%d", httpServer.GetPort()))
+	return createSutWithRetryPolicy(httpServer, RetryPolicy{MaxRetries: 0})
+}
+
+func createSutWithRetryPolicy(httpServer *testutils.TestHTTPServer, retryPolicy RetryPolicy) RestClient {
+	return createSutWithConfig(httpServer, ClientConfig{RetryPolicy: retryPolicy, Throttling: DefaultThrottlingConfig})
+}
+
+func createSutWithConfig(httpServer *testutils.TestHTTPServer, config ClientConfig) RestClient {
+	return NewClient("api-token", fmt.Sprintf("https://localhost:%d", httpServer.GetPort()), config)
 }
 
 func verifyNotFoundResponse(data []byte, err error, t *testing.T) {
@@ -0,0 +1,31 @@
+package restapi
+
+import "context"
+
+//ServiceConfigResource extension of the RestResource for service configs which provides the functionality to update the evaluation order of the service configs
+type ServiceConfigResource interface {
+	RestResource
+	UpdateOrder(ctx context.Context, order ServiceConfigOrder) error
+}
+
+//NewServiceConfigResource creates a new REST resource for service configs
+func NewServiceConfigResource(client RestClient) ServiceConfigResource {
+	return &serviceConfigResource{
+		RestResource: NewRestResource(ServiceConfigsResourcePath, NewServiceConfigUnmarshaller(), client),
+		client:       client,
+	}
+}
+
+type serviceConfigResource struct {
+	RestResource
+	client RestClient
+}
+
+//UpdateOrder updates the evaluation order of the service configs. The order must contain the IDs of the service configs in the desired order
+func (r *serviceConfigResource) UpdateOrder(ctx context.Context, order ServiceConfigOrder) error {
+	if err := order.Validate(); err != nil {
+		return err
+	}
+	_, err := r.client.Put(ctx, order, ServiceConfigsResourcePath)
+	return err
+}
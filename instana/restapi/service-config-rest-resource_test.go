@@ -0,0 +1,53 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldUpdateOrderOfServiceConfigs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewServiceConfigResource(client)
+	order := ServiceConfigOrder{"id-1", "id-2"}
+
+	client.EXPECT().Put(gomock.Any(), order, ServiceConfigsResourcePath).Return([]byte{}, nil)
+
+	err := sut.UpdateOrder(context.Background(), order)
+
+	assert.Nil(t, err)
+}
+
+func TestShouldFailToUpdateOrderOfServiceConfigsWhenOrderIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewServiceConfigResource(client)
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	err := sut.UpdateOrder(context.Background(), ServiceConfigOrder{})
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUpdateOrderOfServiceConfigsWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewServiceConfigResource(client)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), ServiceConfigsResourcePath).Return(nil, expectedError)
+
+	err := sut.UpdateOrder(context.Background(), ServiceConfigOrder{"id-1"})
+
+	assert.Equal(t, expectedError, err)
+}
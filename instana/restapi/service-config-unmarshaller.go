@@ -0,0 +1,27 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//NewServiceConfigUnmarshaller creates a new Unmarshaller instance for service configs
+func NewServiceConfigUnmarshaller() Unmarshaller {
+	return &serviceConfigUnmarshaller{}
+}
+
+type serviceConfigUnmarshaller struct{}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *serviceConfigUnmarshaller) Unmarshal(data []byte) (InstanaDataObject, error) {
+	serviceConfig := ServiceConfig{}
+	if err := json.Unmarshal(data, &serviceConfig); err != nil {
+		return serviceConfig, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return serviceConfig, nil
+}
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *serviceConfigUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
@@ -0,0 +1,78 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+func TestShouldSuccessfullyUnmarshalServiceConfig(t *testing.T) {
+	serviceConfig := ServiceConfig{
+		ID:                 "service-config-id",
+		Name:               "service-config-name",
+		Comment:            "comment",
+		Label:              "{kubernetes.container.name}",
+		Enabled:            true,
+		MatchSpecification: []ServiceMatchingRule{{Key: "kubernetes.container.name", Value: "{kubernetes.container.name}"}},
+	}
+
+	serializedJSON, _ := json.Marshal(serviceConfig)
+
+	result, err := NewServiceConfigUnmarshaller().Unmarshal(serializedJSON)
+
+	assert.Nil(t, err)
+	assert.Equal(t, serviceConfig, result)
+}
+
+func TestShouldFailToUnmarshalServiceConfigWhenResponseIsAJsonArray(t *testing.T) {
+	response := `["foo","bar"]`
+
+	_, err := NewServiceConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUnmarshalServiceConfigWhenResponseIsNotAJsonMessage(t *testing.T) {
+	response := `foo bar`
+
+	_, err := NewServiceConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldReturnEmptyServiceConfigWhenJsonObjectIsReturnWhereNoFiledMatches(t *testing.T) {
+	response := `{"foo" : "bar" }`
+
+	result, err := NewServiceConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Equal(t, ServiceConfig{}, result)
+}
+
+func TestShouldSuccessfullyUnmarshalArrayOfServiceConfigs(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"name" : "test-name-1"
+	},{
+		"id" : "test-id-2",
+		"name" : "test-name-2"
+	}]`
+
+	result, err := NewServiceConfigUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "test-id-1", result[0].(ServiceConfig).ID)
+	assert.Equal(t, "test-id-2", result[1].(ServiceConfig).ID)
+}
+
+func TestShouldFailToUnmarshalArrayOfServiceConfigsWhenResponseIsAJsonObject(t *testing.T) {
+	response := `{"id" : "test-id"}`
+
+	_, err := NewServiceConfigUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,98 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//ServiceConfigsResourcePath path to service config resource of Instana RESTful API
+const ServiceConfigsResourcePath = ApplicationMonitoringSettingsBasePath + "/service"
+
+//ServiceConfigMaxMatchingRules the maximum number of matching rules supported by a service config
+const ServiceConfigMaxMatchingRules = 20
+
+//ServiceMatchingRule is the representation of a single rule of the match specification of a service config in Instana
+type ServiceMatchingRule struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+//Validate verifies if the service matching rule is correct
+func (r ServiceMatchingRule) Validate() error {
+	if utils.IsBlank(r.Key) {
+		return errors.New("key of service matching rule is missing")
+	}
+	if utils.IsBlank(r.Value) {
+		return errors.New("value of service matching rule is missing")
+	}
+	return nil
+}
+
+//ServiceConfig is the representation of a custom service mapping configuration in Instana
+type ServiceConfig struct {
+	ID                 string                `json:"id"`
+	Name               string                `json:"name"`
+	Comment            string                `json:"comment"`
+	Label              string                `json:"label"`
+	Enabled            bool                  `json:"enabled"`
+	MatchSpecification []ServiceMatchingRule `json:"matchSpecification"`
+}
+
+//GetID implemention of the interface InstanaDataObject
+func (c ServiceConfig) GetID() string {
+	return c.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (c ServiceConfig) Validate() error {
+	if utils.IsBlank(c.ID) {
+		return errors.New("ID is missing")
+	}
+	if utils.IsBlank(c.Name) {
+		return errors.New("name is missing")
+	}
+	if utils.IsBlank(c.Label) {
+		return errors.New("label is missing")
+	}
+	if len(c.MatchSpecification) > ServiceConfigMaxMatchingRules {
+		return fmt.Errorf("at most %d matching rules are supported", ServiceConfigMaxMatchingRules)
+	}
+	for _, r := range c.MatchSpecification {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//ServiceConfigOrderPathElement the path element of the order sub resource of the service config resource
+const ServiceConfigOrderPathElement = "order"
+
+//ServiceConfigOrder is the representation of the evaluation order of service configs in Instana. It contains the ordered IDs of the service configs.
+//The order is managed through the sub resource order of the service config resource. Therefore, the ID of the order is the constant path element of the sub resource.
+type ServiceConfigOrder []string
+
+//GetID implemention of the interface InstanaDataObject
+func (o ServiceConfigOrder) GetID() string {
+	return ServiceConfigOrderPathElement
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (o ServiceConfigOrder) Validate() error {
+	if len(o) == 0 {
+		return errors.New("at least one service config ID is required")
+	}
+	ids := make(map[string]bool)
+	for _, id := range o {
+		if utils.IsBlank(id) {
+			return errors.New("service config ID of order must not be blank")
+		}
+		if ids[id] {
+			return fmt.Errorf("service config ID %s is contained more than once", id)
+		}
+		ids[id] = true
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package restapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	serviceConfigID    = "service-config-id"
+	serviceConfigName  = "service-config-name"
+	serviceConfigLabel = "{kubernetes.container.name}"
+)
+
+func TestValidMinimalServiceConfig(t *testing.T) {
+	config := ServiceConfig{
+		ID:    serviceConfigID,
+		Name:  serviceConfigName,
+		Label: serviceConfigLabel,
+	}
+
+	assert.Equal(t, serviceConfigID, config.GetID())
+	assert.Nil(t, config.Validate())
+}
+
+func TestValidServiceConfigWithMatchSpecification(t *testing.T) {
+	config := ServiceConfig{
+		ID:      serviceConfigID,
+		Name:    serviceConfigName,
+		Comment: "comment",
+		Label:   serviceConfigLabel,
+		Enabled: true,
+		MatchSpecification: []ServiceMatchingRule{
+			{Key: "kubernetes.container.name", Value: "{kubernetes.container.name}"},
+			{Key: "kubernetes.namespace", Value: "production"},
+		},
+	}
+
+	assert.Nil(t, config.Validate())
+}
+
+func TestInvalidServiceConfigBecauseOfMissingID(t *testing.T) {
+	config := ServiceConfig{
+		Name:  serviceConfigName,
+		Label: serviceConfigLabel,
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "ID")
+}
+
+func TestInvalidServiceConfigBecauseOfMissingName(t *testing.T) {
+	config := ServiceConfig{
+		ID:    serviceConfigID,
+		Label: serviceConfigLabel,
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name")
+}
+
+func TestInvalidServiceConfigBecauseOfMissingLabel(t *testing.T) {
+	config := ServiceConfig{
+		ID:   serviceConfigID,
+		Name: serviceConfigName,
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "label")
+}
+
+func TestInvalidServiceConfigBecauseOfTooManyMatchingRules(t *testing.T) {
+	rules := make([]ServiceMatchingRule, ServiceConfigMaxMatchingRules+1)
+	for i := range rules {
+		rules[i] = ServiceMatchingRule{Key: fmt.Sprintf("key-%d", i), Value: "value"}
+	}
+	config := ServiceConfig{
+		ID:                 serviceConfigID,
+		Name:               serviceConfigName,
+		Label:              serviceConfigLabel,
+		MatchSpecification: rules,
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "matching rules")
+}
+
+func TestInvalidServiceConfigBecauseOfMissingKeyOfMatchingRule(t *testing.T) {
+	config := ServiceConfig{
+		ID:                 serviceConfigID,
+		Name:               serviceConfigName,
+		Label:              serviceConfigLabel,
+		MatchSpecification: []ServiceMatchingRule{{Value: "value"}},
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "key")
+}
+
+func TestInvalidServiceConfigBecauseOfMissingValueOfMatchingRule(t *testing.T) {
+	config := ServiceConfig{
+		ID:                 serviceConfigID,
+		Name:               serviceConfigName,
+		Label:              serviceConfigLabel,
+		MatchSpecification: []ServiceMatchingRule{{Key: "key"}},
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "value")
+}
+
+func TestValidServiceConfigOrder(t *testing.T) {
+	order := ServiceConfigOrder{"id-1", "id-2"}
+
+	assert.Equal(t, ServiceConfigOrderPathElement, order.GetID())
+	assert.Nil(t, order.Validate())
+}
+
+func TestInvalidServiceConfigOrderBecauseOfMissingIDs(t *testing.T) {
+	err := ServiceConfigOrder{}.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "at least one")
+}
+
+func TestInvalidServiceConfigOrderBecauseOfBlankID(t *testing.T) {
+	err := ServiceConfigOrder{"id-1", " "}.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "blank")
+}
+
+func TestInvalidServiceConfigOrderBecauseOfDuplicateID(t *testing.T) {
+	err := ServiceConfigOrder{"id-1", "id-2", "id-1"}.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "id-1")
+}
@@ -0,0 +1,60 @@
+package restapi
+
+import "context"
+
+//NewSyntheticCallConfigRestResource creates a new REST resource for the synthetic call config. The synthetic call config is a singleton
+//resource which is not addressed by an ID. Updates do not return the updated config, so the config is read again after each update
+func NewSyntheticCallConfigRestResource(client RestClient) RestResource {
+	return &syntheticCallConfigRestResource{
+		unmarshaller: NewSyntheticCallConfigUnmarshaller(),
+		client:       client,
+	}
+}
+
+type syntheticCallConfigRestResource struct {
+	unmarshaller Unmarshaller
+	client       RestClient
+}
+
+//GetAll returns the synthetic call config as the only element of the result
+func (r *syntheticCallConfigRestResource) GetAll(ctx context.Context) ([]InstanaDataObject, error) {
+	data, err := r.client.Get(ctx, SyntheticCallConfigResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	object, err := r.unmarshaller.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return []InstanaDataObject{object}, nil
+}
+
+//GetOne returns the synthetic call config. The ID is ignored as there is only one synthetic call config
+func (r *syntheticCallConfigRestResource) GetOne(ctx context.Context, id string) (InstanaDataObject, error) {
+	data, err := r.client.Get(ctx, SyntheticCallConfigResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalAndValidate(r.unmarshaller, data)
+}
+
+//Upsert updates the synthetic call config and returns the config as provided by Instana after the update
+func (r *syntheticCallConfigRestResource) Upsert(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	if _, err := r.client.Put(ctx, data, SettingsBasePath); err != nil {
+		return data, err
+	}
+	return r.GetOne(ctx, data.GetID())
+}
+
+//Delete resets the synthetic call config to the defaults of Instana
+func (r *syntheticCallConfigRestResource) Delete(ctx context.Context, data InstanaDataObject) error {
+	return r.DeleteByID(ctx, data.GetID())
+}
+
+//DeleteByID resets the synthetic call config to the defaults of Instana. The ID is ignored as there is only one synthetic call config
+func (r *syntheticCallConfigRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, SyntheticCallConfigPathElement, SettingsBasePath)
+}
@@ -0,0 +1,145 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const syntheticCallConfigResponse = `{
+	"defaultRulesEnabled" : true,
+	"customRules" : [
+		{ "name" : "health-checks", "enabled" : true, "matchSpecification" : { "type" : "LEAF", "key" : "call.http.path", "operator" : "EQUALS", "value" : "/health" } }
+	],
+	"defaultRules" : []
+}`
+
+func TestShouldReadSyntheticCallConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewSyntheticCallConfigRestResource(client)
+
+	client.EXPECT().Get(gomock.Any(), SyntheticCallConfigResourcePath).Return([]byte(syntheticCallConfigResponse), nil)
+
+	result, err := sut.GetOne(context.Background(), SyntheticCallConfigPathElement)
+
+	assert.Nil(t, err)
+	config := result.(SyntheticCallConfig)
+	assert.True(t, config.DefaultRulesEnabled)
+	assert.Len(t, config.CustomRules, 1)
+	assert.Equal(t, syntheticCallRuleName, config.CustomRules[0].Name)
+}
+
+func TestShouldReturnSyntheticCallConfigAsSingleElementOfGetAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewSyntheticCallConfigRestResource(client)
+
+	client.EXPECT().Get(gomock.Any(), SyntheticCallConfigResourcePath).Return([]byte(syntheticCallConfigResponse), nil)
+
+	result, err := sut.GetAll(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, SyntheticCallConfigPathElement, result[0].GetID())
+}
+
+func TestShouldFailToReadSyntheticCallConfigWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewSyntheticCallConfigRestResource(client)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Get(gomock.Any(), SyntheticCallConfigResourcePath).Return(nil, expectedError).Times(2)
+
+	_, err := sut.GetOne(context.Background(), SyntheticCallConfigPathElement)
+	assert.Equal(t, expectedError, err)
+
+	_, err = sut.GetAll(context.Background())
+	assert.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToReadSyntheticCallConfigWhenResponseIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewSyntheticCallConfigRestResource(client)
+
+	client.EXPECT().Get(gomock.Any(), SyntheticCallConfigResourcePath).Return([]byte(`{ "customRules" : [ { "matchSpecification" : { "type" : "LEAF", "key" : "key", "operator" : "IS_EMPTY" } } ] }`), nil)
+
+	_, err := sut.GetOne(context.Background(), SyntheticCallConfigPathElement)
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldUpdateSyntheticCallConfigAndReadItAgain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewSyntheticCallConfigRestResource(client)
+	config := SyntheticCallConfig{
+		DefaultRulesEnabled: true,
+		CustomRules: []SyntheticCallRule{
+			{Name: syntheticCallRuleName, Enabled: true, MatchSpecification: NewComparisionExpression("call.http.path", EqualsOperator, "/health")},
+		},
+	}
+
+	gomock.InOrder(
+		client.EXPECT().Put(gomock.Any(), config, SettingsBasePath).Return([]byte{}, nil),
+		client.EXPECT().Get(gomock.Any(), SyntheticCallConfigResourcePath).Return([]byte(syntheticCallConfigResponse), nil),
+	)
+
+	result, err := sut.Upsert(context.Background(), config)
+
+	assert.Nil(t, err)
+	assert.Equal(t, SyntheticCallConfigPathElement, result.GetID())
+	assert.Len(t, result.(SyntheticCallConfig).CustomRules, 1)
+}
+
+func TestShouldFailToUpdateSyntheticCallConfigWhenDataObjectIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewSyntheticCallConfigRestResource(client)
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := sut.Upsert(context.Background(), SyntheticCallConfig{CustomRules: []SyntheticCallRule{{Name: syntheticCallRuleName}}})
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUpdateSyntheticCallConfigWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewSyntheticCallConfigRestResource(client)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), SettingsBasePath).Return(nil, expectedError)
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := sut.Upsert(context.Background(), SyntheticCallConfig{})
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestShouldResetSyntheticCallConfigOnDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewSyntheticCallConfigRestResource(client)
+
+	client.EXPECT().Delete(gomock.Any(), SyntheticCallConfigPathElement, SettingsBasePath).Return(nil).Times(2)
+
+	assert.Nil(t, sut.Delete(context.Background(), SyntheticCallConfig{}))
+	assert.Nil(t, sut.DeleteByID(context.Background(), "any"))
+}
@@ -0,0 +1,65 @@
+package restapi
+
+import (
+	"encoding/json"
+)
+
+//NewSyntheticCallConfigUnmarshaller creates a new Unmarshaller instance for the synthetic call config
+func NewSyntheticCallConfigUnmarshaller() Unmarshaller {
+	return &syntheticCallConfigUnmarshaller{
+		matchExpressionUnmarshaller: &applicationConfigUnmarshaller{},
+	}
+}
+
+type syntheticCallConfigUnmarshaller struct {
+	matchExpressionUnmarshaller *applicationConfigUnmarshaller
+}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *syntheticCallConfigUnmarshaller) Unmarshal(data []byte) (InstanaDataObject, error) {
+	temp := struct {
+		DefaultRulesEnabled bool              `json:"defaultRulesEnabled"`
+		CustomRules         []json.RawMessage `json:"customRules"`
+	}{}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return SyntheticCallConfig{}, err
+	}
+
+	customRules := make([]SyntheticCallRule, len(temp.CustomRules))
+	for i, rawRule := range temp.CustomRules {
+		rule, err := u.unmarshalRule(rawRule)
+		if err != nil {
+			return SyntheticCallConfig{}, err
+		}
+		customRules[i] = rule
+	}
+	return SyntheticCallConfig{
+		DefaultRulesEnabled: temp.DefaultRulesEnabled,
+		CustomRules:         customRules,
+	}, nil
+}
+
+func (u *syntheticCallConfigUnmarshaller) unmarshalRule(data []byte) (SyntheticCallRule, error) {
+	var matchExpression json.RawMessage
+	temp := SyntheticCallRule{
+		MatchSpecification: &matchExpression,
+	}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return SyntheticCallRule{}, err
+	}
+	matchSpecification, err := u.matchExpressionUnmarshaller.unmarshalMatchSpecification(matchExpression)
+	if err != nil {
+		return SyntheticCallRule{}, err
+	}
+	return SyntheticCallRule{
+		Name:               temp.Name,
+		Description:        temp.Description,
+		MatchSpecification: matchSpecification,
+		Enabled:            temp.Enabled,
+	}, nil
+}
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *syntheticCallConfigUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
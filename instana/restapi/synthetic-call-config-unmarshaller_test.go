@@ -0,0 +1,111 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+func TestShouldSuccessfullyUnmarshalSyntheticCallConfig(t *testing.T) {
+	description := "description"
+	config := SyntheticCallConfig{
+		DefaultRulesEnabled: true,
+		CustomRules: []SyntheticCallRule{
+			{
+				Name:               "rule-1",
+				Description:        &description,
+				MatchSpecification: NewComparisionExpression("call.http.path", EqualsOperator, "/health"),
+				Enabled:            true,
+			},
+			{
+				Name: "rule-2",
+				MatchSpecification: NewBinaryOperator(
+					NewComparisionExpression("call.http.path", StartsWithOperator, "/probe"),
+					LogicalOr,
+					NewUnaryOperationExpression("call.http.header", IsEmptyOperator),
+				),
+			},
+		},
+	}
+
+	serializedJSON, _ := json.Marshal(config)
+
+	result, err := NewSyntheticCallConfigUnmarshaller().Unmarshal(serializedJSON)
+
+	assert.Nil(t, err)
+	assert.Equal(t, config, result)
+}
+
+func TestShouldSuccessfullyUnmarshalSyntheticCallConfigAndIgnoreDefaultRules(t *testing.T) {
+	response := `{
+		"defaultRulesEnabled" : true,
+		"customRules" : [],
+		"defaultRules" : [
+			{ "name" : "default", "matchSpecification" : { "type" : "LEAF", "key" : "call.http.path", "operator" : "EQUALS", "value" : "/health" } }
+		]
+	}`
+
+	result, err := NewSyntheticCallConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Equal(t, SyntheticCallConfig{DefaultRulesEnabled: true, CustomRules: []SyntheticCallRule{}}, result)
+}
+
+func TestShouldFailToUnmarshalSyntheticCallConfigWhenMatchSpecificationOfCustomRuleIsNotValid(t *testing.T) {
+	response := `{
+		"customRules" : [
+			{ "name" : "rule", "matchSpecification" : { "type" : "INVALID", "key" : "call.http.path", "operator" : "EQUALS", "value" : "/health" } }
+		]
+	}`
+
+	_, err := NewSyntheticCallConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUnmarshalSyntheticCallConfigWhenCustomRuleIsNotAJsonObject(t *testing.T) {
+	response := `{ "customRules" : [ "foo" ] }`
+
+	_, err := NewSyntheticCallConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUnmarshalSyntheticCallConfigWhenResponseIsAJsonArray(t *testing.T) {
+	response := `["foo","bar"]`
+
+	_, err := NewSyntheticCallConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUnmarshalSyntheticCallConfigWhenResponseIsNotAJsonMessage(t *testing.T) {
+	response := `foo bar`
+
+	_, err := NewSyntheticCallConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldReturnEmptySyntheticCallConfigWhenJsonObjectIsReturnWhereNoFiledMatches(t *testing.T) {
+	response := `{"foo" : "bar" }`
+
+	result, err := NewSyntheticCallConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Equal(t, SyntheticCallConfig{CustomRules: []SyntheticCallRule{}}, result)
+}
+
+func TestShouldSuccessfullyUnmarshalArrayOfSyntheticCallConfigs(t *testing.T) {
+	response := `[{ "defaultRulesEnabled" : true },{ "defaultRulesEnabled" : false }]`
+
+	result, err := NewSyntheticCallConfigUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.True(t, result[0].(SyntheticCallConfig).DefaultRulesEnabled)
+	assert.False(t, result[1].(SyntheticCallConfig).DefaultRulesEnabled)
+}
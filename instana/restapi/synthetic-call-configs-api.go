@@ -0,0 +1,61 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//SyntheticCallConfigPathElement the path element of the synthetic call config resource
+const SyntheticCallConfigPathElement = "synthetic-calls"
+
+//SyntheticCallConfigResourcePath path to synthetic call config resource of Instana RESTful API
+const SyntheticCallConfigResourcePath = SettingsBasePath + "/" + SyntheticCallConfigPathElement
+
+//SyntheticCallConfigMaxCustomRules the maximum number of custom rules supported by the synthetic call config
+const SyntheticCallConfigMaxCustomRules = 500
+
+//SyntheticCallRule is the representation of a custom rule of the synthetic call config in Instana
+type SyntheticCallRule struct {
+	Name               string      `json:"name"`
+	Description        *string     `json:"description,omitempty"`
+	MatchSpecification interface{} `json:"matchSpecification"`
+	Enabled            bool        `json:"enabled"`
+}
+
+//Validate verifies if the synthetic call rule is correct
+func (r SyntheticCallRule) Validate() error {
+	if utils.IsBlank(r.Name) {
+		return errors.New("name of synthetic call rule is missing")
+	}
+	if r.MatchSpecification == nil {
+		return errors.New("match specification of synthetic call rule is missing")
+	}
+	return r.MatchSpecification.(MatchExpression).Validate()
+}
+
+//SyntheticCallConfig is the representation of the synthetic call configuration in Instana. There is only one synthetic call config per Instana tenant unit.
+//Therefore, the ID of the config is the constant path element of the resource.
+type SyntheticCallConfig struct {
+	DefaultRulesEnabled bool                `json:"defaultRulesEnabled"`
+	CustomRules         []SyntheticCallRule `json:"customRules"`
+}
+
+//GetID implemention of the interface InstanaDataObject
+func (c SyntheticCallConfig) GetID() string {
+	return SyntheticCallConfigPathElement
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (c SyntheticCallConfig) Validate() error {
+	if len(c.CustomRules) > SyntheticCallConfigMaxCustomRules {
+		return fmt.Errorf("at most %d custom rules are supported", SyntheticCallConfigMaxCustomRules)
+	}
+	for _, r := range c.CustomRules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const syntheticCallRuleName = "health-checks"
+
+func TestValidMinimalSyntheticCallConfig(t *testing.T) {
+	config := SyntheticCallConfig{}
+
+	assert.Equal(t, SyntheticCallConfigPathElement, config.GetID())
+	assert.Nil(t, config.Validate())
+}
+
+func TestValidSyntheticCallConfigWithCustomRules(t *testing.T) {
+	description := "description"
+	config := SyntheticCallConfig{
+		DefaultRulesEnabled: true,
+		CustomRules: []SyntheticCallRule{
+			{
+				Name:               syntheticCallRuleName,
+				Description:        &description,
+				MatchSpecification: NewComparisionExpression("call.http.path", EqualsOperator, "/health"),
+				Enabled:            true,
+			},
+			{
+				Name: "kubernetes-probes",
+				MatchSpecification: NewBinaryOperator(
+					NewComparisionExpression("call.http.path", StartsWithOperator, "/probe"),
+					LogicalAnd,
+					NewUnaryOperationExpression("call.http.header", IsEmptyOperator),
+				),
+			},
+		},
+	}
+
+	assert.Nil(t, config.Validate())
+}
+
+func TestInvalidSyntheticCallConfigBecauseOfTooManyCustomRules(t *testing.T) {
+	rules := make([]SyntheticCallRule, SyntheticCallConfigMaxCustomRules+1)
+	for i := range rules {
+		rules[i] = SyntheticCallRule{
+			Name:               syntheticCallRuleName,
+			MatchSpecification: NewComparisionExpression("call.http.path", EqualsOperator, "/health"),
+		}
+	}
+	config := SyntheticCallConfig{CustomRules: rules}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "at most 500 custom rules")
+}
+
+func TestInvalidSyntheticCallConfigBecauseOfMissingRuleName(t *testing.T) {
+	for _, name := range []string{"", " "} {
+		t.Run("name '"+name+"'", func(t *testing.T) {
+			config := SyntheticCallConfig{
+				CustomRules: []SyntheticCallRule{
+					{Name: name, MatchSpecification: NewComparisionExpression("call.http.path", EqualsOperator, "/health")},
+				},
+			}
+
+			err := config.Validate()
+
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "name")
+		})
+	}
+}
+
+func TestInvalidSyntheticCallConfigBecauseOfMissingMatchSpecification(t *testing.T) {
+	config := SyntheticCallConfig{
+		CustomRules: []SyntheticCallRule{{Name: syntheticCallRuleName}},
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "match specification")
+}
+
+func TestInvalidSyntheticCallConfigBecauseOfInvalidMatchSpecification(t *testing.T) {
+	config := SyntheticCallConfig{
+		CustomRules: []SyntheticCallRule{
+			{Name: syntheticCallRuleName, MatchSpecification: NewComparisionExpression("", EqualsOperator, "/health")},
+		},
+	}
+
+	assert.NotNil(t, config.Validate())
+}
@@ -0,0 +1,170 @@
+package restapi
+
+import (
+	"context"
+	"strings"
+)
+
+//NewTenantUserRestResource creates a new REST resource for tenant users. New users are invited by their email address and the given role.
+//The role of active users is updated directly while pending invitations are revoked and sent again with the new role. Deleting a tenant user
+//removes the active user from the tenant unit or revokes the pending invitation
+func NewTenantUserRestResource(client RestClient) RestResource {
+	return &tenantUserRestResource{
+		unmarshaller: NewUserUnmarshaller(),
+		client:       client,
+	}
+}
+
+type tenantUserRestResource struct {
+	unmarshaller Unmarshaller
+	client       RestClient
+}
+
+//GetAll returns all active users and all pending invitations of the tenant unit
+func (r *tenantUserRestResource) GetAll(ctx context.Context) ([]InstanaDataObject, error) {
+	users, err := r.getUsers(ctx, UsersResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	invitations, err := r.getUsers(ctx, UserInvitationsResourcePath)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]InstanaDataObject, 0, len(users)+len(invitations))
+	for _, user := range users {
+		result = append(result, r.mapActiveUser(user))
+	}
+	for _, invitation := range invitations {
+		result = append(result, r.mapInvitation(invitation))
+	}
+	return result, nil
+}
+
+//GetOne returns the active user or the pending invitation with the given email address
+func (r *tenantUserRestResource) GetOne(ctx context.Context, email string) (InstanaDataObject, error) {
+	users, err := r.getUsers(ctx, UsersResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	if user, ok := r.findByEmail(users, email); ok {
+		return r.mapActiveUser(user), nil
+	}
+
+	invitations, err := r.getUsers(ctx, UserInvitationsResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	if invitation, ok := r.findByEmail(invitations, email); ok {
+		return r.mapInvitation(invitation), nil
+	}
+	return nil, ErrEntityNotFound
+}
+
+//Upsert invites the user when no active user or pending invitation exists for the email address. Otherwise the role is updated when required
+func (r *tenantUserRestResource) Upsert(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	user := data.(TenantUser)
+
+	existing, err := r.GetOne(ctx, user.Email)
+	if err != nil && err != ErrEntityNotFound {
+		return data, err
+	}
+	if err == ErrEntityNotFound {
+		err = r.invite(ctx, user)
+	} else {
+		err = r.updateRole(ctx, existing.(TenantUser), user.RoleID)
+	}
+	if err != nil {
+		return data, err
+	}
+	return r.GetOne(ctx, user.Email)
+}
+
+func (r *tenantUserRestResource) invite(ctx context.Context, user TenantUser) error {
+	_, err := r.client.PostByQuery(ctx, UserInvitationsResourcePath, map[string]string{"email": user.Email, "roleId": user.RoleID})
+	return err
+}
+
+func (r *tenantUserRestResource) updateRole(ctx context.Context, existing TenantUser, roleID string) error {
+	if existing.RoleID == roleID {
+		return nil
+	}
+	if existing.IsInvitationPending() {
+		if err := r.revokeInvitation(ctx, existing.Email); err != nil {
+			return err
+		}
+		return r.invite(ctx, TenantUser{Email: existing.Email, RoleID: roleID})
+	}
+	_, err := r.client.PutByQuery(ctx, UsersResourcePath+"/"+existing.UserID, UserRolePathElement, map[string]string{"roleId": roleID})
+	return err
+}
+
+func (r *tenantUserRestResource) revokeInvitation(ctx context.Context, email string) error {
+	return r.client.DeleteByQuery(ctx, UserInvitationsResourcePath, map[string]string{"email": email})
+}
+
+//Delete removes the active user from the tenant unit or revokes the pending invitation
+func (r *tenantUserRestResource) Delete(ctx context.Context, data InstanaDataObject) error {
+	return r.DeleteByID(ctx, data.GetID())
+}
+
+//DeleteByID removes the active user with the given email address from the tenant unit or revokes the pending invitation. Nothing is
+//done when neither an active user nor a pending invitation exists for the email address
+func (r *tenantUserRestResource) DeleteByID(ctx context.Context, email string) error {
+	existing, err := r.GetOne(ctx, email)
+	if err == ErrEntityNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	user := existing.(TenantUser)
+	if user.IsInvitationPending() {
+		return r.revokeInvitation(ctx, user.Email)
+	}
+	return r.client.Delete(ctx, user.UserID, UsersResourcePath)
+}
+
+func (r *tenantUserRestResource) getUsers(ctx context.Context, resourcePath string) ([]User, error) {
+	data, err := r.client.Get(ctx, resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.unmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]User, len(objects))
+	for i, obj := range objects {
+		users[i] = obj.(User)
+	}
+	return users, nil
+}
+
+func (r *tenantUserRestResource) findByEmail(users []User, email string) (User, bool) {
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			return user, true
+		}
+	}
+	return User{}, false
+}
+
+func (r *tenantUserRestResource) mapActiveUser(user User) TenantUser {
+	return TenantUser{
+		Email:    user.Email,
+		RoleID:   user.RoleID,
+		UserID:   user.ID,
+		FullName: user.FullName,
+	}
+}
+
+func (r *tenantUserRestResource) mapInvitation(invitation User) TenantUser {
+	return TenantUser{
+		Email:  invitation.Email,
+		RoleID: invitation.RoleID,
+	}
+}
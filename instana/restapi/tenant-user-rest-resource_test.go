@@ -0,0 +1,258 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	tenantUserEmail     = "user@example.com"
+	tenantUserID        = "user-id"
+	tenantUserRoleID    = "role-id"
+	tenantUserNewRoleID = "new-role-id"
+	activeUsersResponse = `[
+		{ "id" : "other-user-id", "email" : "other@example.com", "fullName" : "Other User", "roleId" : "role-id" },
+		{ "id" : "user-id", "email" : "User@Example.com", "fullName" : "Test User", "roleId" : "role-id" }
+	]`
+	pendingInvitationsResponse = `[
+		{ "email" : "user@example.com", "roleId" : "role-id" }
+	]`
+	emptyUsersResponse = `[]`
+)
+
+func TestShouldGetActiveTenantUserByEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte(activeUsersResponse), nil)
+
+	result, err := sut.GetOne(context.Background(), tenantUserEmail)
+
+	assert.Nil(t, err)
+	assert.Equal(t, TenantUser{Email: "User@Example.com", RoleID: tenantUserRoleID, UserID: tenantUserID, FullName: "Test User"}, result)
+}
+
+func TestShouldGetPendingInvitationOfTenantUserByEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte(emptyUsersResponse), nil)
+	client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Return([]byte(pendingInvitationsResponse), nil)
+
+	result, err := sut.GetOne(context.Background(), tenantUserEmail)
+
+	assert.Nil(t, err)
+	assert.Equal(t, TenantUser{Email: tenantUserEmail, RoleID: tenantUserRoleID}, result)
+	assert.True(t, result.(TenantUser).IsInvitationPending())
+}
+
+func TestShouldReturnNotFoundErrorWhenNeitherActiveUserNorPendingInvitationExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte(emptyUsersResponse), nil)
+	client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Return([]byte(emptyUsersResponse), nil)
+
+	_, err := sut.GetOne(context.Background(), tenantUserEmail)
+
+	assert.Equal(t, ErrEntityNotFound, err)
+}
+
+func TestShouldFailToGetTenantUserWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return(nil, expectedError)
+
+	_, err := sut.GetOne(context.Background(), tenantUserEmail)
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToGetTenantUserWhenResponseIsNotAJsonArray(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte("invalid"), nil)
+
+	_, err := sut.GetOne(context.Background(), tenantUserEmail)
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldGetAllActiveTenantUsersAndPendingInvitations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte(activeUsersResponse), nil)
+	client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Return([]byte(pendingInvitationsResponse), nil)
+
+	result, err := sut.GetAll(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 3)
+	assert.False(t, result[1].(TenantUser).IsInvitationPending())
+	assert.True(t, result[2].(TenantUser).IsInvitationPending())
+}
+
+func TestShouldInviteTenantUserWhenNeitherActiveUserNorPendingInvitationExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+
+	gomock.InOrder(
+		client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte(emptyUsersResponse), nil),
+		client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Return([]byte(emptyUsersResponse), nil),
+		client.EXPECT().PostByQuery(gomock.Any(), UserInvitationsResourcePath, map[string]string{"email": tenantUserEmail, "roleId": tenantUserRoleID}).Return(emptyResponse(), nil),
+		client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte(emptyUsersResponse), nil),
+		client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Return([]byte(pendingInvitationsResponse), nil),
+	)
+
+	result, err := sut.Upsert(context.Background(), TenantUser{Email: tenantUserEmail, RoleID: tenantUserRoleID})
+
+	assert.Nil(t, err)
+	assert.Equal(t, TenantUser{Email: tenantUserEmail, RoleID: tenantUserRoleID}, result)
+}
+
+func TestShouldUpdateRoleOfActiveTenantUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+
+	gomock.InOrder(
+		client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte(activeUsersResponse), nil),
+		client.EXPECT().PutByQuery(gomock.Any(), UsersResourcePath+"/"+tenantUserID, UserRolePathElement, map[string]string{"roleId": tenantUserNewRoleID}).Return(emptyResponse(), nil),
+		client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte(activeUsersResponse), nil),
+	)
+
+	_, err := sut.Upsert(context.Background(), TenantUser{Email: tenantUserEmail, RoleID: tenantUserNewRoleID})
+
+	assert.Nil(t, err)
+}
+
+func TestShouldNotUpdateRoleOfActiveTenantUserWhenRoleIsUnchanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte(activeUsersResponse), nil).Times(2)
+
+	result, err := sut.Upsert(context.Background(), TenantUser{Email: tenantUserEmail, RoleID: tenantUserRoleID})
+
+	assert.Nil(t, err)
+	assert.Equal(t, tenantUserID, result.(TenantUser).UserID)
+}
+
+func TestShouldRevokeAndResendPendingInvitationWhenRoleIsChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+
+	gomock.InOrder(
+		client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte(emptyUsersResponse), nil),
+		client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Return([]byte(pendingInvitationsResponse), nil),
+		client.EXPECT().DeleteByQuery(gomock.Any(), UserInvitationsResourcePath, map[string]string{"email": tenantUserEmail}).Return(nil),
+		client.EXPECT().PostByQuery(gomock.Any(), UserInvitationsResourcePath, map[string]string{"email": tenantUserEmail, "roleId": tenantUserNewRoleID}).Return(emptyResponse(), nil),
+		client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte(emptyUsersResponse), nil),
+		client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Return([]byte(pendingInvitationsResponse), nil),
+	)
+
+	_, err := sut.Upsert(context.Background(), TenantUser{Email: tenantUserEmail, RoleID: tenantUserNewRoleID})
+
+	assert.Nil(t, err)
+}
+
+func TestShouldFailToUpsertTenantUserWhenDataObjectIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+
+	_, err := sut.Upsert(context.Background(), TenantUser{Email: tenantUserEmail})
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUpsertTenantUserWhenInvitationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(emptyUsersResponse), nil).Times(2)
+	client.EXPECT().PostByQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedError)
+
+	_, err := sut.Upsert(context.Background(), TenantUser{Email: tenantUserEmail, RoleID: tenantUserRoleID})
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestShouldRemoveActiveTenantUserOnDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte(activeUsersResponse), nil)
+	client.EXPECT().Delete(gomock.Any(), tenantUserID, UsersResourcePath).Return(nil)
+
+	err := sut.Delete(context.Background(), TenantUser{Email: tenantUserEmail, RoleID: tenantUserRoleID})
+
+	assert.Nil(t, err)
+}
+
+func TestShouldRevokePendingInvitationOnDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Return([]byte(emptyUsersResponse), nil)
+	client.EXPECT().Get(gomock.Any(), UserInvitationsResourcePath).Return([]byte(pendingInvitationsResponse), nil)
+	client.EXPECT().DeleteByQuery(gomock.Any(), UserInvitationsResourcePath, map[string]string{"email": tenantUserEmail}).Return(nil)
+
+	err := sut.DeleteByID(context.Background(), tenantUserEmail)
+
+	assert.Nil(t, err)
+}
+
+func TestShouldDoNothingOnDeleteWhenNeitherActiveUserNorPendingInvitationExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewTenantUserRestResource(client)
+
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(emptyUsersResponse), nil).Times(2)
+
+	err := sut.DeleteByID(context.Background(), tenantUserEmail)
+
+	assert.Nil(t, err)
+}
+
+func emptyResponse() []byte {
+	return make([]byte, 0)
+}
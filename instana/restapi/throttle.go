@@ -0,0 +1,184 @@
+package restapi
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+//ThrottlingConfig defines how requests to the Instana API are throttled
+type ThrottlingConfig struct {
+	//Rate the maximum number of throttled requests per second
+	Rate float64
+	//Burst the maximum number of throttled requests which can be sent at once after an idle period
+	Burst int
+	//Timeout the maximum duration of a throttled request including the time waiting for the throttle
+	Timeout time.Duration
+	//Adaptive when set to true the rate is reduced when the API reports that the rate limit is (almost) reached and
+	//increased again step by step up to the configured rate afterwards
+	Adaptive bool
+	//ThrottleReadRequests when set to true read requests are throttled as well. By default only write requests are throttled
+	ThrottleReadRequests bool
+}
+
+//DefaultThrottlingConfig the throttling configuration which is used when no explicit configuration is provided
+var DefaultThrottlingConfig = ThrottlingConfig{
+	Rate:    5,
+	Burst:   1,
+	Timeout: 30 * time.Second,
+}
+
+//adaptiveMinimumRate the lower bound of the rate in adaptive mode (one request every ten seconds)
+const adaptiveMinimumRate = 0.1
+
+//adaptiveRecoverySteps the number of successful requests without rate limit pressure which are required to get back
+//from the minimum to the configured rate
+const adaptiveRecoverySteps = 10
+
+const headerRateLimitLimit = "X-RateLimit-Limit"
+
+//Clock abstraction of the time functions used by the throttle so that time can be controlled in tests
+type Clock interface {
+	//Now returns the current time
+	Now() time.Time
+	//After waits for the duration to elapse and then sends the current time on the returned channel
+	After(d time.Duration) <-chan time.Time
+}
+
+//NewSystemClock creates a Clock which is backed by the system time
+func NewSystemClock() Clock {
+	return &systemClock{}
+}
+
+type systemClock struct{}
+
+//Now Clock interface implementation for the system clock
+func (c *systemClock) Now() time.Time {
+	return time.Now()
+}
+
+//After Clock interface implementation for the system clock
+func (c *systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+//Throttle limits the rate of requests sent to the Instana API
+type Throttle interface {
+	//Wait blocks until the next request is allowed to be sent or the context is done
+	Wait(ctx context.Context) error
+	//Observe updates the rate of an adaptive throttle from the status code and the rate limit headers of a response
+	Observe(statusCode int, headers http.Header)
+	//Rate returns the current rate in requests per second
+	Rate() float64
+}
+
+//NewThrottle creates a new token bucket based Throttle for the given configuration
+func NewThrottle(config ThrottlingConfig, clock Clock) Throttle {
+	burst := math.Max(float64(config.Burst), 1)
+	return &tokenBucketThrottle{
+		config:  config,
+		clock:   clock,
+		burst:   burst,
+		rate:    config.Rate,
+		tokens:  burst,
+		updated: clock.Now(),
+	}
+}
+
+type tokenBucketThrottle struct {
+	config  ThrottlingConfig
+	clock   Clock
+	burst   float64
+	mutex   sync.Mutex
+	rate    float64
+	tokens  float64
+	updated time.Time
+}
+
+//Wait Throttle interface implementation for the token bucket throttle
+func (t *tokenBucketThrottle) Wait(ctx context.Context) error {
+	for {
+		wait, ok := t.reserve()
+		if ok {
+			return nil
+		}
+		select {
+		case <-t.clock.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (t *tokenBucketThrottle) reserve() (time.Duration, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.refill()
+	if t.tokens >= 1 {
+		t.tokens--
+		return 0, true
+	}
+	return time.Duration((1 - t.tokens) / t.rate * float64(time.Second)), false
+}
+
+func (t *tokenBucketThrottle) refill() {
+	now := t.clock.Now()
+	elapsed := now.Sub(t.updated).Seconds()
+	if elapsed > 0 {
+		t.tokens = math.Min(t.burst, t.tokens+elapsed*t.rate)
+		t.updated = now
+	}
+}
+
+//Observe Throttle interface implementation for the token bucket throttle
+func (t *tokenBucketThrottle) Observe(statusCode int, headers http.Header) {
+	if !t.config.Adaptive {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.refill()
+	minimumRate := math.Min(adaptiveMinimumRate, t.config.Rate)
+	if statusCode == http.StatusTooManyRequests {
+		t.rate = math.Max(t.rate/2, minimumRate)
+		return
+	}
+	if allowedRate, ok := t.calculateAllowedRate(headers); ok && allowedRate < t.rate {
+		t.rate = math.Max(allowedRate, minimumRate)
+		return
+	}
+	t.rate = math.Min(t.rate+t.config.Rate/adaptiveRecoverySteps, t.config.Rate)
+}
+
+//calculateAllowedRate calculates the rate which keeps the requests within the remaining rate limit of the current
+//rate limit window. No rate is returned when the rate limit headers are missing or the rate limit is not under pressure
+func (t *tokenBucketThrottle) calculateAllowedRate(headers http.Header) (float64, bool) {
+	remaining, err := strconv.ParseFloat(headers.Get(headerRateLimitRemaining), 64)
+	if err != nil {
+		return 0, false
+	}
+	if limit, err := strconv.ParseFloat(headers.Get(headerRateLimitLimit), 64); err == nil && remaining > limit/2 {
+		return 0, false
+	}
+	reset, err := strconv.ParseInt(headers.Get(headerRateLimitReset), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	secondsUntilReset := time.Unix(reset, 0).Sub(t.clock.Now()).Seconds()
+	if secondsUntilReset <= 0 {
+		return 0, false
+	}
+	return remaining / secondsUntilReset, true
+}
+
+//Rate Throttle interface implementation for the token bucket throttle
+func (t *tokenBucketThrottle) Rate() float64 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.rate
+}
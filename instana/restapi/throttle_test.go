@@ -0,0 +1,176 @@
+package restapi_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(1600000000, 0)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func (c *fakeClock) elapsedSince(start time.Time) time.Duration {
+	return c.Now().Sub(start)
+}
+
+func TestShouldNotWaitForFirstRequestOfThrottle(t *testing.T) {
+	clock := newFakeClock()
+	start := clock.Now()
+	sut := NewThrottle(ThrottlingConfig{Rate: 5, Burst: 1}, clock)
+
+	err := sut.Wait(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), clock.elapsedSince(start))
+}
+
+func TestShouldLimitRequestsToConfiguredRate(t *testing.T) {
+	clock := newFakeClock()
+	start := clock.Now()
+	sut := NewThrottle(ThrottlingConfig{Rate: 5, Burst: 1}, clock)
+
+	for i := 0; i < 6; i++ {
+		assert.Nil(t, sut.Wait(context.Background()))
+	}
+
+	assert.Equal(t, time.Second, clock.elapsedSince(start))
+}
+
+func TestShouldAllowBurstOfRequestsAfterIdlePeriod(t *testing.T) {
+	clock := newFakeClock()
+	sut := NewThrottle(ThrottlingConfig{Rate: 1, Burst: 3}, clock)
+
+	assert.Nil(t, sut.Wait(context.Background()))
+	clock.Advance(time.Minute)
+	start := clock.Now()
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, sut.Wait(context.Background()))
+	}
+	assert.Equal(t, time.Duration(0), clock.elapsedSince(start))
+
+	assert.Nil(t, sut.Wait(context.Background()))
+	assert.Equal(t, time.Second, clock.elapsedSince(start))
+}
+
+func TestShouldReturnErrorWhenContextIsDoneWhileWaitingForThrottle(t *testing.T) {
+	sut := NewThrottle(ThrottlingConfig{Rate: 0.001, Burst: 1}, NewSystemClock())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.Nil(t, sut.Wait(ctx))
+	err := sut.Wait(ctx)
+
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestShouldNotChangeRateWhenThrottleIsNotAdaptive(t *testing.T) {
+	sut := NewThrottle(ThrottlingConfig{Rate: 5, Burst: 1}, newFakeClock())
+
+	sut.Observe(http.StatusTooManyRequests, http.Header{})
+
+	assert.Equal(t, float64(5), sut.Rate())
+}
+
+func TestShouldHalveRateOfAdaptiveThrottleWhenRateLimitIsExceeded(t *testing.T) {
+	sut := NewThrottle(ThrottlingConfig{Rate: 5, Burst: 1, Adaptive: true}, newFakeClock())
+
+	sut.Observe(http.StatusTooManyRequests, http.Header{})
+	assert.Equal(t, 2.5, sut.Rate())
+
+	sut.Observe(http.StatusTooManyRequests, http.Header{})
+	assert.Equal(t, 1.25, sut.Rate())
+}
+
+func TestShouldNotReduceRateOfAdaptiveThrottleBelowMinimumRate(t *testing.T) {
+	sut := NewThrottle(ThrottlingConfig{Rate: 5, Burst: 1, Adaptive: true}, newFakeClock())
+
+	for i := 0; i < 20; i++ {
+		sut.Observe(http.StatusTooManyRequests, http.Header{})
+	}
+
+	assert.Equal(t, 0.1, sut.Rate())
+}
+
+func TestShouldReduceRateOfAdaptiveThrottleToRemainingRateLimitOfCurrentWindow(t *testing.T) {
+	clock := newFakeClock()
+	sut := NewThrottle(ThrottlingConfig{Rate: 5, Burst: 1, Adaptive: true}, clock)
+
+	sut.Observe(http.StatusOK, createRateLimitHeaders(100, 20, clock.Now().Add(10*time.Second)))
+
+	assert.Equal(t, float64(2), sut.Rate())
+}
+
+func TestShouldNotReduceRateOfAdaptiveThrottleWhenMoreThanHalfOfTheRateLimitIsRemaining(t *testing.T) {
+	clock := newFakeClock()
+	sut := NewThrottle(ThrottlingConfig{Rate: 5, Burst: 1, Adaptive: true}, clock)
+
+	sut.Observe(http.StatusOK, createRateLimitHeaders(100, 60, clock.Now().Add(100*time.Second)))
+
+	assert.Equal(t, float64(5), sut.Rate())
+}
+
+func TestShouldIncreaseRateOfAdaptiveThrottleStepByStepUpToConfiguredRate(t *testing.T) {
+	sut := NewThrottle(ThrottlingConfig{Rate: 5, Burst: 1, Adaptive: true}, newFakeClock())
+	sut.Observe(http.StatusTooManyRequests, http.Header{})
+
+	sut.Observe(http.StatusOK, http.Header{})
+	assert.Equal(t, float64(3), sut.Rate())
+
+	for i := 0; i < 10; i++ {
+		sut.Observe(http.StatusOK, http.Header{})
+	}
+	assert.Equal(t, float64(5), sut.Rate())
+}
+
+func TestShouldApplyReducedRateOfAdaptiveThrottleToWaitingRequests(t *testing.T) {
+	clock := newFakeClock()
+	sut := NewThrottle(ThrottlingConfig{Rate: 2, Burst: 1, Adaptive: true}, clock)
+	sut.Observe(http.StatusTooManyRequests, http.Header{})
+	start := clock.Now()
+
+	assert.Nil(t, sut.Wait(context.Background()))
+	assert.Nil(t, sut.Wait(context.Background()))
+
+	assert.Equal(t, time.Second, clock.elapsedSince(start))
+}
+
+func createRateLimitHeaders(limit int, remaining int, reset time.Time) http.Header {
+	headers := http.Header{}
+	headers.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	headers.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	headers.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	return headers
+}
@@ -20,3 +20,8 @@ func (u *userRoleUnmarshaller) Unmarshal(data []byte) (InstanaDataObject, error)
 	}
 	return userRole, nil
 }
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *userRoleUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
@@ -11,32 +11,34 @@ import (
 
 func TestShouldSuccessfullyUnmarshalUserRole(t *testing.T) {
 	userRole := UserRole{
-		ID:                                "role-id",
-		Name:                              "role-name",
-		CanConfigureServiceMapping:        true,
-		CanConfigureEumApplications:       true,
-		CanConfigureMobileAppMonitoring:   true,
-		CanConfigureUsers:                 true,
-		CanInstallNewAgents:               true,
-		CanSeeUsageInformation:            true,
-		CanConfigureIntegrations:          true,
-		CanSeeOnPremiseLicenseInformation: true,
-		CanConfigureRoles:                 true,
-		CanConfigureCustomAlerts:          true,
-		CanConfigureAPITokens:             true,
-		CanConfigureAgentRunMode:          true,
-		CanViewAuditLog:                   true,
-		CanConfigureObjectives:            true,
-		CanConfigureAgents:                true,
-		CanConfigureAuthenticationMethods: true,
-		CanConfigureApplications:          true,
-		CanConfigureTeams:                 true,
-		RestrictedAccess:                  true,
-		CanConfigureReleases:              true,
-		CanConfigureLogManagement:         true,
-		CanCreatePublicCustomDashboards:   true,
-		CanViewLogs:                       true,
-		CanViewTraceDetails:               true,
+		ID:   "role-id",
+		Name: "role-name",
+		Permissions: Permissions{
+			CanConfigureServiceMapping:        true,
+			CanConfigureEumApplications:       true,
+			CanConfigureMobileAppMonitoring:   true,
+			CanConfigureUsers:                 true,
+			CanInstallNewAgents:               true,
+			CanSeeUsageInformation:            true,
+			CanConfigureIntegrations:          true,
+			CanSeeOnPremiseLicenseInformation: true,
+			CanConfigureRoles:                 true,
+			CanConfigureCustomAlerts:          true,
+			CanConfigureAPITokens:             true,
+			CanConfigureAgentRunMode:          true,
+			CanViewAuditLog:                   true,
+			CanConfigureObjectives:            true,
+			CanConfigureAgents:                true,
+			CanConfigureAuthenticationMethods: true,
+			CanConfigureApplications:          true,
+			CanConfigureTeams:                 true,
+			CanConfigureReleases:              true,
+			CanConfigureLogManagement:         true,
+			CanCreatePublicCustomDashboards:   true,
+			CanViewLogs:                       true,
+			CanViewTraceDetails:               true,
+		},
+		RestrictedAccess: true,
 	}
 
 	serializedJSON, _ := json.Marshal(userRole)
@@ -72,3 +74,28 @@ func TestShouldReturnEmptyUserRoleWhenJsonObjectIsReturnWhereNoFiledMatches(t *t
 	assert.Nil(t, err)
 	assert.Equal(t, UserRole{}, result)
 }
+
+func TestShouldSuccessfullyUnmarshalArrayOfUserRoles(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"name" : "test-name-1"
+	},{
+		"id" : "test-id-2",
+		"name" : "test-name-2"
+	}]`
+
+	result, err := NewUserRoleUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "test-id-1", result[0].(UserRole).ID)
+	assert.Equal(t, "test-id-2", result[1].(UserRole).ID)
+}
+
+func TestShouldFailToUnmarshalArrayOfUserRolesWhenResponseIsAJsonObject(t *testing.T) {
+	response := `{"id" : "test-id"}`
+
+	_, err := NewUserRoleUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.NotNil(t, err)
+}
@@ -5,34 +5,39 @@ import "errors"
 //UserRolesResourcePath path to User Role resource of Instana RESTful API
 const UserRolesResourcePath = SettingsBasePath + "/roles"
 
-//UserRole is the representation of a user role in Instana
+//Permissions the permission flags which are shared by user roles and API tokens
+type Permissions struct {
+	CanConfigureServiceMapping        bool `json:"canConfigureServiceMapping"`
+	CanConfigureEumApplications       bool `json:"canConfigureEumApplications"`
+	CanConfigureMobileAppMonitoring   bool `json:"canConfigureMobileAppMonitoring"`
+	CanConfigureUsers                 bool `json:"canConfigureUsers"`
+	CanInstallNewAgents               bool `json:"canInstallNewAgents"`
+	CanSeeUsageInformation            bool `json:"canSeeUsageInformation"`
+	CanConfigureIntegrations          bool `json:"canConfigureIntegrations"`
+	CanSeeOnPremiseLicenseInformation bool `json:"canSeeOnPremLicenseInformation"`
+	CanConfigureRoles                 bool `json:"canConfigureRoles"`
+	CanConfigureCustomAlerts          bool `json:"canConfigureCustomAlerts"`
+	CanConfigureAPITokens             bool `json:"canConfigureApiTokens"`
+	CanConfigureAgentRunMode          bool `json:"canConfigureAgentRunMode"`
+	CanViewAuditLog                   bool `json:"canViewAuditLog"`
+	CanConfigureObjectives            bool `json:"canConfigureObjectives"`
+	CanConfigureAgents                bool `json:"canConfigureAgents"`
+	CanConfigureAuthenticationMethods bool `json:"canConfigureAuthenticationMethods"`
+	CanConfigureApplications          bool `json:"canConfigureApplications"`
+	CanConfigureTeams                 bool `json:"canConfigureTeams"`
+	CanConfigureReleases              bool `json:"canConfigureReleases"`
+	CanConfigureLogManagement         bool `json:"canConfigureLogManagement"`
+	CanCreatePublicCustomDashboards   bool `json:"canCreatePublicCustomDashboards"`
+	CanViewLogs                       bool `json:"canViewLogs"`
+	CanViewTraceDetails               bool `json:"canViewTraceDetails"`
+}
+
+//UserRole is the representation of a user role in Instana. The permission flags are provided by the embedded Permissions
 type UserRole struct {
-	ID                                string `json:"id"`
-	Name                              string `json:"name"`
-	CanConfigureServiceMapping        bool   `json:"canConfigureServiceMapping"`
-	CanConfigureEumApplications       bool   `json:"canConfigureEumApplications"`
-	CanConfigureMobileAppMonitoring   bool   `json:"canConfigureMobileAppMonitoring"` //NEW
-	CanConfigureUsers                 bool   `json:"canConfigureUsers"`
-	CanInstallNewAgents               bool   `json:"canInstallNewAgents"`
-	CanSeeUsageInformation            bool   `json:"canSeeUsageInformation"`
-	CanConfigureIntegrations          bool   `json:"canConfigureIntegrations"`
-	CanSeeOnPremiseLicenseInformation bool   `json:"canSeeOnPremLicenseInformation"`
-	CanConfigureRoles                 bool   `json:"canConfigureRoles"`
-	CanConfigureCustomAlerts          bool   `json:"canConfigureCustomAlerts"`
-	CanConfigureAPITokens             bool   `json:"canConfigureApiTokens"`
-	CanConfigureAgentRunMode          bool   `json:"canConfigureAgentRunMode"`
-	CanViewAuditLog                   bool   `json:"canViewAuditLog"`
-	CanConfigureObjectives            bool   `json:"canConfigureObjectives"`
-	CanConfigureAgents                bool   `json:"canConfigureAgents"`
-	CanConfigureAuthenticationMethods bool   `json:"canConfigureAuthenticationMethods"`
-	CanConfigureApplications          bool   `json:"canConfigureApplications"`
-	CanConfigureTeams                 bool   `json:"canConfigureTeams"`
-	RestrictedAccess                  bool   `json:"restrictedAccess"`
-	CanConfigureReleases              bool   `json:"canConfigureReleases"`
-	CanConfigureLogManagement         bool   `json:"canConfigureLogManagement"`
-	CanCreatePublicCustomDashboards   bool   `json:"canCreatePublicCustomDashboards"`
-	CanViewLogs                       bool   `json:"canViewLogs"`
-	CanViewTraceDetails               bool   `json:"canViewTraceDetails"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Permissions
+	RestrictedAccess bool `json:"restrictedAccess"`
 }
 
 //GetID implemention of the interface InstanaDataObject
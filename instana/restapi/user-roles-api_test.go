@@ -26,32 +26,34 @@ func TestValidMinimalUserRole(t *testing.T) {
 
 func TestValidFullUserRole(t *testing.T) {
 	userRole := UserRole{
-		ID:                                userRoleID,
-		Name:                              userRoleName,
-		CanConfigureServiceMapping:        true,
-		CanConfigureEumApplications:       true,
-		CanConfigureMobileAppMonitoring:   true,
-		CanConfigureUsers:                 true,
-		CanInstallNewAgents:               true,
-		CanSeeUsageInformation:            true,
-		CanConfigureIntegrations:          true,
-		CanSeeOnPremiseLicenseInformation: true,
-		CanConfigureRoles:                 true,
-		CanConfigureCustomAlerts:          true,
-		CanConfigureAPITokens:             true,
-		CanConfigureAgentRunMode:          true,
-		CanViewAuditLog:                   true,
-		CanConfigureObjectives:            true,
-		CanConfigureAgents:                true,
-		CanConfigureAuthenticationMethods: true,
-		CanConfigureApplications:          true,
-		CanConfigureTeams:                 true,
-		RestrictedAccess:                  true,
-		CanConfigureReleases:              true,
-		CanConfigureLogManagement:         true,
-		CanCreatePublicCustomDashboards:   true,
-		CanViewLogs:                       true,
-		CanViewTraceDetails:               true,
+		ID:   userRoleID,
+		Name: userRoleName,
+		Permissions: Permissions{
+			CanConfigureServiceMapping:        true,
+			CanConfigureEumApplications:       true,
+			CanConfigureMobileAppMonitoring:   true,
+			CanConfigureUsers:                 true,
+			CanInstallNewAgents:               true,
+			CanSeeUsageInformation:            true,
+			CanConfigureIntegrations:          true,
+			CanSeeOnPremiseLicenseInformation: true,
+			CanConfigureRoles:                 true,
+			CanConfigureCustomAlerts:          true,
+			CanConfigureAPITokens:             true,
+			CanConfigureAgentRunMode:          true,
+			CanViewAuditLog:                   true,
+			CanConfigureObjectives:            true,
+			CanConfigureAgents:                true,
+			CanConfigureAuthenticationMethods: true,
+			CanConfigureApplications:          true,
+			CanConfigureTeams:                 true,
+			CanConfigureReleases:              true,
+			CanConfigureLogManagement:         true,
+			CanCreatePublicCustomDashboards:   true,
+			CanViewLogs:                       true,
+			CanViewTraceDetails:               true,
+		},
+		RestrictedAccess: true,
 	}
 
 	assert.Equal(t, userRoleID, userRole.GetID())
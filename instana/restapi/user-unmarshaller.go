@@ -0,0 +1,27 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//NewUserUnmarshaller creates a new Unmarshaller instance for users
+func NewUserUnmarshaller() Unmarshaller {
+	return &userUnmarshaller{}
+}
+
+type userUnmarshaller struct{}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *userUnmarshaller) Unmarshal(data []byte) (InstanaDataObject, error) {
+	user := User{}
+	if err := json.Unmarshal(data, &user); err != nil {
+		return user, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return user, nil
+}
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *userUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
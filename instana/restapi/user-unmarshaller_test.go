@@ -0,0 +1,48 @@
+package restapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+func TestShouldSuccessfullyUnmarshalUser(t *testing.T) {
+	response := `{
+		"id" : "user-id",
+		"email" : "user@example.com",
+		"fullName" : "full name",
+		"roleId" : "role-id"
+	}`
+
+	result, err := NewUserUnmarshaller().Unmarshal([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Equal(t, User{ID: "user-id", Email: "user@example.com", FullName: "full name", RoleID: "role-id"}, result)
+}
+
+func TestShouldFailToUnmarshalUserWhenResponseIsNotAJsonMessage(t *testing.T) {
+	response := `foo bar`
+
+	_, err := NewUserUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldSuccessfullyUnmarshalArrayOfUsers(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"email" : "test1@example.com"
+	},{
+		"id" : "test-id-2",
+		"email" : "test2@example.com"
+	}]`
+
+	result, err := NewUserUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "test-id-1", result[0].(User).ID)
+	assert.Equal(t, "test-id-2", result[1].(User).ID)
+}
@@ -0,0 +1,71 @@
+package restapi
+
+import (
+	"errors"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//UsersResourcePath path to user resource of Instana RESTful API
+const UsersResourcePath = SettingsBasePath + "/users"
+
+//UserInvitationsResourcePath path to user invitation resource of Instana RESTful API
+const UserInvitationsResourcePath = UsersResourcePath + "/invitations"
+
+//UserRolePathElement path element of the role of a user which is appended to the path of the user
+const UserRolePathElement = "role"
+
+//User is the representation of a user in Instana
+type User struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	FullName string `json:"fullName"`
+	RoleID   string `json:"roleId"`
+}
+
+//GetID implemention of the interface InstanaDataObject
+func (u User) GetID() string {
+	return u.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (u User) Validate() error {
+	if utils.IsBlank(u.ID) {
+		return errors.New("ID is missing")
+	}
+	if utils.IsBlank(u.Email) {
+		return errors.New("email is missing")
+	}
+	return nil
+}
+
+//TenantUser is the representation of a user of the Instana tenant unit. The user is either invited and did not yet accept the invitation
+//or the user is an active user of the tenant unit. As the ID of a user is only available after the invitation is accepted, tenant users
+//are identified by their email address
+type TenantUser struct {
+	Email    string
+	RoleID   string
+	UserID   string
+	FullName string
+}
+
+//GetID implemention of the interface InstanaDataObject. The email address is used as ID
+func (u TenantUser) GetID() string {
+	return u.Email
+}
+
+//IsInvitationPending returns true when the user did not yet accept the invitation
+func (u TenantUser) IsInvitationPending() bool {
+	return u.UserID == ""
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (u TenantUser) Validate() error {
+	if utils.IsBlank(u.Email) {
+		return errors.New("email is missing")
+	}
+	if utils.IsBlank(u.RoleID) {
+		return errors.New("role ID is missing")
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	userID    = "user-id"
+	userEmail = "user@example.com"
+)
+
+func TestValidUser(t *testing.T) {
+	user := User{
+		ID:       userID,
+		Email:    userEmail,
+		FullName: "full name",
+		RoleID:   "role-id",
+	}
+
+	assert.Equal(t, userID, user.GetID())
+
+	err := user.Validate()
+	assert.Nil(t, err)
+}
+
+func TestInvalidUserBecauseOfMissingID(t *testing.T) {
+	user := User{
+		Email: userEmail,
+	}
+
+	err := user.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "ID")
+}
+
+func TestInvalidUserBecauseOfMissingEmail(t *testing.T) {
+	user := User{
+		ID: userID,
+	}
+
+	err := user.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "email")
+}
+
+func TestValidTenantUser(t *testing.T) {
+	user := TenantUser{
+		Email:  userEmail,
+		RoleID: "role-id",
+	}
+
+	assert.Equal(t, userEmail, user.GetID())
+
+	err := user.Validate()
+	assert.Nil(t, err)
+}
+
+func TestInvalidTenantUserBecauseOfMissingEmail(t *testing.T) {
+	user := TenantUser{
+		RoleID: "role-id",
+	}
+
+	err := user.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "email")
+}
+
+func TestInvalidTenantUserBecauseOfMissingRoleID(t *testing.T) {
+	user := TenantUser{
+		Email: userEmail,
+	}
+
+	err := user.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "role ID")
+}
+
+func TestTenantUserShouldHavePendingInvitationWhenUserIDIsNotSet(t *testing.T) {
+	assert.True(t, TenantUser{Email: userEmail, RoleID: "role-id"}.IsInvitationPending())
+	assert.False(t, TenantUser{Email: userEmail, RoleID: "role-id", UserID: userID}.IsInvitationPending())
+}
@@ -0,0 +1,63 @@
+package restapi
+
+import (
+	"context"
+	"fmt"
+)
+
+//NewWebsiteAlertConfigRestResource creates a new REST resource for website alert configs. Website alert configs are created and
+//updated via HTTP POST and the ID of new configs is assigned by Instana. The enabled flag cannot be changed by the create or update
+//request. Therefore, the config is enabled or disabled through the dedicated sub resources when the flag differs from the response
+func NewWebsiteAlertConfigRestResource(client RestClient) RestResource {
+	unmarshaller := NewWebsiteAlertConfigUnmarshaller()
+	return &websiteAlertConfigRestResource{
+		RestResource: NewRestResource(WebsiteAlertConfigResourcePath, unmarshaller, client),
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type websiteAlertConfigRestResource struct {
+	RestResource
+	unmarshaller Unmarshaller
+	client       RestClient
+}
+
+//Upsert creates a new website alert config when the ID of the given data object is empty. Otherwise the website alert config with the given ID is updated
+func (r *websiteAlertConfigRestResource) Upsert(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	config := data.(WebsiteAlertConfig)
+	resourcePath := WebsiteAlertConfigResourcePath
+	if config.ID != "" {
+		resourcePath = fmt.Sprintf("%s/%s", WebsiteAlertConfigResourcePath, config.ID)
+	}
+
+	response, err := r.client.Post(ctx, config, resourcePath)
+	if err != nil {
+		return data, err
+	}
+	object, err := unmarshalAndValidate(r.unmarshaller, response)
+	if err != nil {
+		return object, err
+	}
+
+	updatedConfig := object.(WebsiteAlertConfig)
+	if updatedConfig.Enabled != config.Enabled {
+		if err := r.toggle(ctx, updatedConfig.ID, config.Enabled); err != nil {
+			return updatedConfig, err
+		}
+		updatedConfig.Enabled = config.Enabled
+	}
+	return updatedConfig, nil
+}
+
+func (r *websiteAlertConfigRestResource) toggle(ctx context.Context, id string, enabled bool) error {
+	operation := WebsiteAlertConfigDisablePathElement
+	if enabled {
+		operation = WebsiteAlertConfigEnablePathElement
+	}
+	_, err := r.client.PutByPath(ctx, fmt.Sprintf("%s/%s/%s", WebsiteAlertConfigResourcePath, id, operation))
+	return err
+}
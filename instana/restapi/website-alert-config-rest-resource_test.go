@@ -0,0 +1,159 @@
+package restapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldCreateWebsiteAlertConfigViaPostToResourcePathWhenIDIsNotSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteAlertConfigRestResource(client)
+	config := createTestWebsiteAlertConfig()
+	config.ID = ""
+
+	client.EXPECT().Post(gomock.Any(), config, WebsiteAlertConfigResourcePath).Return(marshalTestWebsiteAlertConfig(createTestWebsiteAlertConfig()), nil)
+
+	result, err := sut.Upsert(context.Background(), config)
+
+	assert.Nil(t, err)
+	assert.Equal(t, createTestWebsiteAlertConfig(), result)
+}
+
+func TestShouldUpdateWebsiteAlertConfigViaPostToPathOfConfigWhenIDIsSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteAlertConfigRestResource(client)
+	config := createTestWebsiteAlertConfig()
+
+	client.EXPECT().Post(gomock.Any(), config, WebsiteAlertConfigResourcePath+"/"+websiteAlertConfigID).Return(marshalTestWebsiteAlertConfig(config), nil)
+
+	result, err := sut.Upsert(context.Background(), config)
+
+	assert.Nil(t, err)
+	assert.Equal(t, config, result)
+}
+
+func TestShouldDisableWebsiteAlertConfigWhenEnabledFlagDiffersFromResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteAlertConfigRestResource(client)
+	config := createTestWebsiteAlertConfig()
+	config.Enabled = false
+
+	gomock.InOrder(
+		client.EXPECT().Post(gomock.Any(), config, gomock.Any()).Return(marshalTestWebsiteAlertConfig(createTestWebsiteAlertConfig()), nil),
+		client.EXPECT().PutByPath(gomock.Any(), WebsiteAlertConfigResourcePath+"/"+websiteAlertConfigID+"/"+WebsiteAlertConfigDisablePathElement).Return([]byte{}, nil),
+	)
+
+	result, err := sut.Upsert(context.Background(), config)
+
+	assert.Nil(t, err)
+	assert.False(t, result.(WebsiteAlertConfig).Enabled)
+}
+
+func TestShouldEnableWebsiteAlertConfigWhenEnabledFlagDiffersFromResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteAlertConfigRestResource(client)
+	config := createTestWebsiteAlertConfig()
+	response := createTestWebsiteAlertConfig()
+	response.Enabled = false
+
+	gomock.InOrder(
+		client.EXPECT().Post(gomock.Any(), config, gomock.Any()).Return(marshalTestWebsiteAlertConfig(response), nil),
+		client.EXPECT().PutByPath(gomock.Any(), WebsiteAlertConfigResourcePath+"/"+websiteAlertConfigID+"/"+WebsiteAlertConfigEnablePathElement).Return([]byte{}, nil),
+	)
+
+	result, err := sut.Upsert(context.Background(), config)
+
+	assert.Nil(t, err)
+	assert.True(t, result.(WebsiteAlertConfig).Enabled)
+}
+
+func TestShouldFailToUpsertWebsiteAlertConfigWhenToggleOfEnabledFlagFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteAlertConfigRestResource(client)
+	config := createTestWebsiteAlertConfig()
+	config.Enabled = false
+	expectedError := errors.New("test")
+
+	client.EXPECT().Post(gomock.Any(), config, gomock.Any()).Return(marshalTestWebsiteAlertConfig(createTestWebsiteAlertConfig()), nil)
+	client.EXPECT().PutByPath(gomock.Any(), gomock.Any()).Return(nil, expectedError)
+
+	result, err := sut.Upsert(context.Background(), config)
+
+	assert.Equal(t, expectedError, err)
+	assert.Equal(t, websiteAlertConfigID, result.GetID())
+}
+
+func TestShouldFailToUpsertWebsiteAlertConfigWhenDataObjectIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteAlertConfigRestResource(client)
+	config := createTestWebsiteAlertConfig()
+	config.Name = ""
+
+	_, err := sut.Upsert(context.Background(), config)
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUpsertWebsiteAlertConfigWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteAlertConfigRestResource(client)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedError)
+
+	_, err := sut.Upsert(context.Background(), createTestWebsiteAlertConfig())
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToUpsertWebsiteAlertConfigWhenResponseIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteAlertConfigRestResource(client)
+
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte(`{"id" : "website-alert-config-id"}`), nil)
+
+	_, err := sut.Upsert(context.Background(), createTestWebsiteAlertConfig())
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUpsertWebsiteAlertConfigWhenResponseIsNotAJsonDocument(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteAlertConfigRestResource(client)
+
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("invalid"), nil)
+
+	_, err := sut.Upsert(context.Background(), createTestWebsiteAlertConfig())
+
+	assert.NotNil(t, err)
+}
+
+func marshalTestWebsiteAlertConfig(config WebsiteAlertConfig) []byte {
+	data, _ := json.Marshal(config)
+	return data
+}
@@ -0,0 +1,27 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//NewWebsiteAlertConfigUnmarshaller creates a new Unmarshaller instance for website alert configs
+func NewWebsiteAlertConfigUnmarshaller() Unmarshaller {
+	return &websiteAlertConfigUnmarshaller{}
+}
+
+type websiteAlertConfigUnmarshaller struct{}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *websiteAlertConfigUnmarshaller) Unmarshal(data []byte) (InstanaDataObject, error) {
+	config := WebsiteAlertConfig{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return config, nil
+}
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *websiteAlertConfigUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
@@ -0,0 +1,73 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+func TestShouldSuccessfullyUnmarshalWebsiteAlertConfig(t *testing.T) {
+	config := createTestWebsiteAlertConfig()
+
+	serializedJSON, _ := json.Marshal(config)
+
+	result, err := NewWebsiteAlertConfigUnmarshaller().Unmarshal(serializedJSON)
+
+	assert.Nil(t, err)
+	assert.Equal(t, config, result)
+}
+
+func TestShouldFailToUnmarshalWebsiteAlertConfigWhenResponseIsAJsonArray(t *testing.T) {
+	response := `["foo","bar"]`
+
+	_, err := NewWebsiteAlertConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUnmarshalWebsiteAlertConfigWhenResponseIsNotAJsonMessage(t *testing.T) {
+	response := `foo bar`
+
+	_, err := NewWebsiteAlertConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldReturnEmptyWebsiteAlertConfigWhenJsonObjectIsReturnWhereNoFiledMatches(t *testing.T) {
+	response := `{"foo" : "bar" }`
+
+	result, err := NewWebsiteAlertConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Equal(t, WebsiteAlertConfig{}, result)
+}
+
+func TestShouldSuccessfullyUnmarshalArrayOfWebsiteAlertConfigs(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"name" : "test-name-1",
+		"rule" : { "alertType" : "throughput", "metricName" : "pageViews" }
+	},{
+		"id" : "test-id-2",
+		"name" : "test-name-2",
+		"timeThreshold" : { "type" : "violationsInPeriod", "timeWindow" : 600000, "violations" : 3 }
+	}]`
+
+	result, err := NewWebsiteAlertConfigUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, WebsiteAlertRuleTypeThroughput, result[0].(WebsiteAlertConfig).Rule.AlertType)
+	assert.Equal(t, 3, *result[1].(WebsiteAlertConfig).TimeThreshold.Violations)
+}
+
+func TestShouldFailToUnmarshalArrayOfWebsiteAlertConfigsWhenResponseIsAJsonObject(t *testing.T) {
+	response := `{"id" : "test-id"}`
+
+	_, err := NewWebsiteAlertConfigUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,281 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//WebsiteAlertConfigResourcePath path to website alert config resource of Instana RESTful API
+const WebsiteAlertConfigResourcePath = EventSettingsBasePath + "/website-alert-configs"
+
+//WebsiteAlertConfigEnablePathElement path element of the sub resource to enable a website alert config
+const WebsiteAlertConfigEnablePathElement = "enable"
+
+//WebsiteAlertConfigDisablePathElement path element of the sub resource to disable a website alert config
+const WebsiteAlertConfigDisablePathElement = "disable"
+
+//WebsiteAlertRuleType custom type representing the alert type of a website alert rule
+type WebsiteAlertRuleType string
+
+const (
+	//WebsiteAlertRuleTypeSlowness const for WebsiteAlertRuleType of slowness
+	WebsiteAlertRuleTypeSlowness = WebsiteAlertRuleType("slowness")
+	//WebsiteAlertRuleTypeStatusCode const for WebsiteAlertRuleType of status code
+	WebsiteAlertRuleTypeStatusCode = WebsiteAlertRuleType("statusCode")
+	//WebsiteAlertRuleTypeSpecificJsError const for WebsiteAlertRuleType of specific JS errors
+	WebsiteAlertRuleTypeSpecificJsError = WebsiteAlertRuleType("specificJsError")
+	//WebsiteAlertRuleTypeThroughput const for WebsiteAlertRuleType of throughput
+	WebsiteAlertRuleTypeThroughput = WebsiteAlertRuleType("throughput")
+)
+
+//WebsiteTimeThresholdType custom type representing the type of a website time threshold
+type WebsiteTimeThresholdType string
+
+const (
+	//WebsiteTimeThresholdTypeViolationsInSequence const for WebsiteTimeThresholdType of violations in sequence
+	WebsiteTimeThresholdTypeViolationsInSequence = WebsiteTimeThresholdType("violationsInSequence")
+	//WebsiteTimeThresholdTypeViolationsInPeriod const for WebsiteTimeThresholdType of violations in period
+	WebsiteTimeThresholdTypeViolationsInPeriod = WebsiteTimeThresholdType("violationsInPeriod")
+	//WebsiteTimeThresholdTypeUserImpactOfViolationsInSequence const for WebsiteTimeThresholdType of user impact of violations in sequence
+	WebsiteTimeThresholdTypeUserImpactOfViolationsInSequence = WebsiteTimeThresholdType("userImpactOfViolationsInSequence")
+)
+
+//ThresholdTypeStatic const for the type of static thresholds
+const ThresholdTypeStatic = "staticThreshold"
+
+//StringValues custom type representing a slice of supported string values of the Instana API
+type StringValues []string
+
+//IsSupported checks if the given value is part of the supported values
+func (values StringValues) IsSupported(val string) bool {
+	for _, v := range values {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
+
+//SupportedWebsiteAlertAggregations slice of all supported aggregations of website alert rules
+var SupportedWebsiteAlertAggregations = StringValues{"SUM", "MEAN", "MAX", "MIN", "P25", "P50", "P75", "P90", "P95", "P98", "P99", "DISTINCT_COUNT"}
+
+//SupportedExpressionOperators slice of all supported operators of website alert rules and tag filters
+var SupportedExpressionOperators = StringValues{"EQUALS", "CONTAINS", "LESS_THAN", "LESS_OR_EQUAL_THAN", "GREATER_THAN", "GREATER_OR_EQUAL_THAN", "NOT_EMPTY", "NOT_EQUAL", "NOT_CONTAIN", "IS_EMPTY", "NOT_BLANK", "IS_BLANK", "STARTS_WITH", "ENDS_WITH", "NOT_STARTS_WITH", "NOT_ENDS_WITH"}
+
+//SupportedThresholdOperators slice of all supported operators of thresholds
+var SupportedThresholdOperators = StringValues{">", ">=", "<", "<="}
+
+//SupportedWebsiteAlertGranularities slice of all supported granularities of website alert configs in milliseconds
+var SupportedWebsiteAlertGranularities = []int{60000, 300000, 600000, 1800000}
+
+//WebsiteAlertRule representation of the rule of a website alert config. Depending on the alert type different fields are required
+type WebsiteAlertRule struct {
+	AlertType  WebsiteAlertRuleType `json:"alertType"`
+	MetricName string               `json:"metricName"`
+
+	//Slowness and throughput rule fields
+	Aggregation *string `json:"aggregation"`
+
+	//Status code and specific JS error rule fields
+	Operator *string `json:"operator"`
+	Value    *string `json:"value"`
+}
+
+//Validate checks if the website alert rule is consistent
+func (r *WebsiteAlertRule) Validate() error {
+	if len(r.AlertType) == 0 {
+		return errors.New("alert type of rule is missing")
+	}
+	if utils.IsBlank(r.MetricName) {
+		return errors.New("metric name of rule is missing")
+	}
+	if r.AlertType == WebsiteAlertRuleTypeSlowness {
+		return r.validateSlownessRule()
+	} else if r.AlertType == WebsiteAlertRuleTypeStatusCode {
+		return r.validateStatusCodeRule()
+	} else if r.AlertType == WebsiteAlertRuleTypeSpecificJsError {
+		return r.validateSpecificJsErrorRule()
+	} else if r.AlertType == WebsiteAlertRuleTypeThroughput {
+		return r.validateThroughputRule()
+	}
+	return errors.New("Unsupported alert type " + string(r.AlertType))
+}
+
+func (r *WebsiteAlertRule) validateSlownessRule() error {
+	if r.Aggregation == nil || !SupportedWebsiteAlertAggregations.IsSupported(*r.Aggregation) {
+		return errors.New("aggregation of slowness rule is missing or not valid")
+	}
+	return nil
+}
+
+func (r *WebsiteAlertRule) validateStatusCodeRule() error {
+	if r.Operator == nil || !SupportedExpressionOperators.IsSupported(*r.Operator) {
+		return errors.New("operator of status code rule is missing or not valid")
+	}
+	if r.Value == nil || utils.IsBlank(*r.Value) {
+		return errors.New("value of status code rule is missing")
+	}
+	return nil
+}
+
+func (r *WebsiteAlertRule) validateSpecificJsErrorRule() error {
+	if r.Operator == nil || !SupportedExpressionOperators.IsSupported(*r.Operator) {
+		return errors.New("operator of specific JS error rule is missing or not valid")
+	}
+	return nil
+}
+
+func (r *WebsiteAlertRule) validateThroughputRule() error {
+	if r.Aggregation != nil && !SupportedWebsiteAlertAggregations.IsSupported(*r.Aggregation) {
+		return errors.New("aggregation of throughput rule is not valid")
+	}
+	return nil
+}
+
+//Threshold representation of a static threshold of an alert config
+type Threshold struct {
+	Type     string   `json:"type"`
+	Operator string   `json:"operator"`
+	Value    *float64 `json:"value"`
+}
+
+//Validate checks if the threshold is consistent
+func (t *Threshold) Validate() error {
+	if t.Type != ThresholdTypeStatic {
+		return fmt.Errorf("threshold type %s is not supported", t.Type)
+	}
+	if !SupportedThresholdOperators.IsSupported(t.Operator) {
+		return errors.New("operator of threshold is missing or not valid")
+	}
+	if t.Value == nil || *t.Value < 0 {
+		return errors.New("value of threshold is missing or negative")
+	}
+	return nil
+}
+
+//WebsiteTimeThreshold representation of the time threshold of a website alert config. Depending on the type different fields are required
+type WebsiteTimeThreshold struct {
+	Type       WebsiteTimeThresholdType `json:"type"`
+	TimeWindow *int64                   `json:"timeWindow"`
+
+	//Violations in period fields
+	Violations *int `json:"violations"`
+
+	//User impact of violations in sequence fields
+	Users          *int     `json:"users"`
+	UserPercentage *float64 `json:"userPercentage"`
+}
+
+//Validate checks if the website time threshold is consistent
+func (t *WebsiteTimeThreshold) Validate() error {
+	if len(t.Type) == 0 {
+		return errors.New("type of time threshold is missing")
+	}
+	if t.TimeWindow == nil || *t.TimeWindow <= 0 {
+		return errors.New("time window of time threshold is missing or not positive")
+	}
+	if t.Type == WebsiteTimeThresholdTypeViolationsInSequence {
+		return nil
+	} else if t.Type == WebsiteTimeThresholdTypeViolationsInPeriod {
+		return t.validateViolationsInPeriod()
+	} else if t.Type == WebsiteTimeThresholdTypeUserImpactOfViolationsInSequence {
+		return t.validateUserImpactOfViolationsInSequence()
+	}
+	return errors.New("Unsupported time threshold type " + string(t.Type))
+}
+
+func (t *WebsiteTimeThreshold) validateViolationsInPeriod() error {
+	if t.Violations == nil || *t.Violations < 1 || *t.Violations > 12 {
+		return errors.New("violations of violations in period time threshold are missing or not between 1 and 12")
+	}
+	return nil
+}
+
+func (t *WebsiteTimeThreshold) validateUserImpactOfViolationsInSequence() error {
+	if t.Users == nil && t.UserPercentage == nil {
+		return errors.New("either users or user percentage of user impact time threshold must be defined")
+	}
+	if t.Users != nil && *t.Users < 1 {
+		return errors.New("users of user impact time threshold must be at least 1")
+	}
+	if t.UserPercentage != nil && (*t.UserPercentage <= 0 || *t.UserPercentage > 1) {
+		return errors.New("user percentage of user impact time threshold must be greater than 0 and less or equal than 1")
+	}
+	return nil
+}
+
+//TagFilter representation of a tag filter of an alert config
+type TagFilter struct {
+	Name     string `json:"name"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+//Validate checks if the tag filter is consistent
+func (f *TagFilter) Validate() error {
+	if utils.IsBlank(f.Name) {
+		return errors.New("name of tag filter is missing")
+	}
+	if !SupportedExpressionOperators.IsSupported(f.Operator) {
+		return errors.New("operator of tag filter is missing or not valid")
+	}
+	return nil
+}
+
+//WebsiteAlertConfig is the representation of a website alert configuration in Instana. The ID is assigned by Instana when the config is created
+type WebsiteAlertConfig struct {
+	ID              string               `json:"id"`
+	Name            string               `json:"name"`
+	Description     string               `json:"description"`
+	WebsiteID       string               `json:"websiteId"`
+	Severity        int                  `json:"severity"`
+	Triggering      bool                 `json:"triggering"`
+	TagFilters      []TagFilter          `json:"tagFilters"`
+	Rule            WebsiteAlertRule     `json:"rule"`
+	Threshold       Threshold            `json:"threshold"`
+	AlertChannelIDs []string             `json:"alertChannelIds"`
+	Granularity     int                  `json:"granularity"`
+	TimeThreshold   WebsiteTimeThreshold `json:"timeThreshold"`
+	Enabled         bool                 `json:"enabled"`
+}
+
+//GetID implemention of the interface InstanaDataObject
+func (c WebsiteAlertConfig) GetID() string {
+	return c.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct. The ID is not validated as it is not available before the config is created
+func (c WebsiteAlertConfig) Validate() error {
+	if utils.IsBlank(c.Name) {
+		return errors.New("name is missing")
+	}
+	if utils.IsBlank(c.WebsiteID) {
+		return errors.New("website ID is missing")
+	}
+	if !c.isSupportedGranularity() {
+		return fmt.Errorf("granularity %d is not supported", c.Granularity)
+	}
+	for _, f := range c.TagFilters {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := c.Rule.Validate(); err != nil {
+		return err
+	}
+	if err := c.Threshold.Validate(); err != nil {
+		return err
+	}
+	return c.TimeThreshold.Validate()
+}
+
+func (c WebsiteAlertConfig) isSupportedGranularity() bool {
+	for _, g := range SupportedWebsiteAlertGranularities {
+		if g == c.Granularity {
+			return true
+		}
+	}
+	return false
+}
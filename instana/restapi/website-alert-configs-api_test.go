@@ -0,0 +1,378 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	websiteAlertConfigID         = "website-alert-config-id"
+	websiteAlertConfigName       = "website-alert-config-name"
+	websiteAlertConfigWebsiteID  = "website-id"
+	websiteAlertConfigMetricName = "onLoadTime"
+)
+
+func TestValidWebsiteAlertConfig(t *testing.T) {
+	config := createTestWebsiteAlertConfig()
+
+	assert.Equal(t, websiteAlertConfigID, config.GetID())
+	assert.Nil(t, config.Validate())
+}
+
+func TestValidWebsiteAlertConfigWithoutID(t *testing.T) {
+	config := createTestWebsiteAlertConfig()
+	config.ID = ""
+
+	assert.Nil(t, config.Validate())
+}
+
+func TestInvalidWebsiteAlertConfigBecauseOfMissingName(t *testing.T) {
+	config := createTestWebsiteAlertConfig()
+	config.Name = ""
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name")
+}
+
+func TestInvalidWebsiteAlertConfigBecauseOfMissingWebsiteID(t *testing.T) {
+	config := createTestWebsiteAlertConfig()
+	config.WebsiteID = " "
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "website ID")
+}
+
+func TestInvalidWebsiteAlertConfigBecauseOfUnsupportedGranularity(t *testing.T) {
+	config := createTestWebsiteAlertConfig()
+	config.Granularity = 1234
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "granularity")
+}
+
+func TestInvalidWebsiteAlertConfigBecauseOfInvalidTagFilter(t *testing.T) {
+	config := createTestWebsiteAlertConfig()
+	config.TagFilters = []TagFilter{{Name: "", Operator: "EQUALS", Value: "value"}}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "tag filter")
+}
+
+func TestInvalidWebsiteAlertConfigBecauseOfUnsupportedOperatorOfTagFilter(t *testing.T) {
+	config := createTestWebsiteAlertConfig()
+	config.TagFilters = []TagFilter{{Name: "beacon.page.name", Operator: "INVALID", Value: "value"}}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "operator of tag filter")
+}
+
+func TestInvalidWebsiteAlertConfigBecauseOfInvalidRule(t *testing.T) {
+	config := createTestWebsiteAlertConfig()
+	config.Rule.AlertType = ""
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "alert type")
+}
+
+func TestInvalidWebsiteAlertConfigBecauseOfInvalidThreshold(t *testing.T) {
+	config := createTestWebsiteAlertConfig()
+	config.Threshold.Operator = "=="
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "operator of threshold")
+}
+
+func TestInvalidWebsiteAlertConfigBecauseOfInvalidTimeThreshold(t *testing.T) {
+	config := createTestWebsiteAlertConfig()
+	config.TimeThreshold.TimeWindow = nil
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "time window")
+}
+
+func TestValidSlownessWebsiteAlertRule(t *testing.T) {
+	aggregation := "P90"
+	rule := WebsiteAlertRule{AlertType: WebsiteAlertRuleTypeSlowness, MetricName: websiteAlertConfigMetricName, Aggregation: &aggregation}
+
+	assert.Nil(t, rule.Validate())
+}
+
+func TestInvalidWebsiteAlertRuleBecauseOfMissingMetricName(t *testing.T) {
+	aggregation := "P90"
+	rule := WebsiteAlertRule{AlertType: WebsiteAlertRuleTypeSlowness, Aggregation: &aggregation}
+
+	err := rule.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "metric name")
+}
+
+func TestInvalidWebsiteAlertRuleBecauseOfUnsupportedAlertType(t *testing.T) {
+	rule := WebsiteAlertRule{AlertType: WebsiteAlertRuleType("invalid"), MetricName: websiteAlertConfigMetricName}
+
+	err := rule.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Unsupported alert type")
+}
+
+func TestInvalidSlownessWebsiteAlertRuleBecauseOfMissingAggregation(t *testing.T) {
+	rule := WebsiteAlertRule{AlertType: WebsiteAlertRuleTypeSlowness, MetricName: websiteAlertConfigMetricName}
+
+	err := rule.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "aggregation of slowness rule")
+}
+
+func TestInvalidSlownessWebsiteAlertRuleBecauseOfUnsupportedAggregation(t *testing.T) {
+	aggregation := "INVALID"
+	rule := WebsiteAlertRule{AlertType: WebsiteAlertRuleTypeSlowness, MetricName: websiteAlertConfigMetricName, Aggregation: &aggregation}
+
+	err := rule.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "aggregation of slowness rule")
+}
+
+func TestValidStatusCodeWebsiteAlertRule(t *testing.T) {
+	operator := "EQUALS"
+	value := "404"
+	rule := WebsiteAlertRule{AlertType: WebsiteAlertRuleTypeStatusCode, MetricName: "httpxxx", Operator: &operator, Value: &value}
+
+	assert.Nil(t, rule.Validate())
+}
+
+func TestInvalidStatusCodeWebsiteAlertRuleBecauseOfMissingOperator(t *testing.T) {
+	value := "404"
+	rule := WebsiteAlertRule{AlertType: WebsiteAlertRuleTypeStatusCode, MetricName: "httpxxx", Value: &value}
+
+	err := rule.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "operator of status code rule")
+}
+
+func TestInvalidStatusCodeWebsiteAlertRuleBecauseOfMissingValue(t *testing.T) {
+	operator := "EQUALS"
+	rule := WebsiteAlertRule{AlertType: WebsiteAlertRuleTypeStatusCode, MetricName: "httpxxx", Operator: &operator}
+
+	err := rule.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "value of status code rule")
+}
+
+func TestValidSpecificJsErrorWebsiteAlertRuleWithoutValue(t *testing.T) {
+	operator := "NOT_EMPTY"
+	rule := WebsiteAlertRule{AlertType: WebsiteAlertRuleTypeSpecificJsError, MetricName: "errors", Operator: &operator}
+
+	assert.Nil(t, rule.Validate())
+}
+
+func TestInvalidSpecificJsErrorWebsiteAlertRuleBecauseOfUnsupportedOperator(t *testing.T) {
+	operator := "INVALID"
+	rule := WebsiteAlertRule{AlertType: WebsiteAlertRuleTypeSpecificJsError, MetricName: "errors", Operator: &operator}
+
+	err := rule.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "operator of specific JS error rule")
+}
+
+func TestValidThroughputWebsiteAlertRuleWithoutAggregation(t *testing.T) {
+	rule := WebsiteAlertRule{AlertType: WebsiteAlertRuleTypeThroughput, MetricName: "pageViews"}
+
+	assert.Nil(t, rule.Validate())
+}
+
+func TestInvalidThroughputWebsiteAlertRuleBecauseOfUnsupportedAggregation(t *testing.T) {
+	aggregation := "INVALID"
+	rule := WebsiteAlertRule{AlertType: WebsiteAlertRuleTypeThroughput, MetricName: "pageViews", Aggregation: &aggregation}
+
+	err := rule.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "aggregation of throughput rule")
+}
+
+func TestInvalidThresholdBecauseOfUnsupportedType(t *testing.T) {
+	value := 1.0
+	threshold := Threshold{Type: "historicBaseline", Operator: ">=", Value: &value}
+
+	err := threshold.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "historicBaseline")
+}
+
+func TestInvalidThresholdBecauseOfMissingValue(t *testing.T) {
+	threshold := Threshold{Type: ThresholdTypeStatic, Operator: ">="}
+
+	err := threshold.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "value of threshold")
+}
+
+func TestInvalidThresholdBecauseOfNegativeValue(t *testing.T) {
+	value := -1.0
+	threshold := Threshold{Type: ThresholdTypeStatic, Operator: ">=", Value: &value}
+
+	err := threshold.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "value of threshold")
+}
+
+func TestValidViolationsInSequenceTimeThreshold(t *testing.T) {
+	timeWindow := int64(600000)
+	threshold := WebsiteTimeThreshold{Type: WebsiteTimeThresholdTypeViolationsInSequence, TimeWindow: &timeWindow}
+
+	assert.Nil(t, threshold.Validate())
+}
+
+func TestInvalidTimeThresholdBecauseOfMissingType(t *testing.T) {
+	timeWindow := int64(600000)
+	threshold := WebsiteTimeThreshold{TimeWindow: &timeWindow}
+
+	err := threshold.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "type of time threshold")
+}
+
+func TestInvalidTimeThresholdBecauseOfUnsupportedType(t *testing.T) {
+	timeWindow := int64(600000)
+	threshold := WebsiteTimeThreshold{Type: WebsiteTimeThresholdType("invalid"), TimeWindow: &timeWindow}
+
+	err := threshold.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Unsupported time threshold type")
+}
+
+func TestInvalidTimeThresholdBecauseOfZeroTimeWindow(t *testing.T) {
+	timeWindow := int64(0)
+	threshold := WebsiteTimeThreshold{Type: WebsiteTimeThresholdTypeViolationsInSequence, TimeWindow: &timeWindow}
+
+	err := threshold.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "time window")
+}
+
+func TestValidViolationsInPeriodTimeThreshold(t *testing.T) {
+	timeWindow := int64(600000)
+	violations := 3
+	threshold := WebsiteTimeThreshold{Type: WebsiteTimeThresholdTypeViolationsInPeriod, TimeWindow: &timeWindow, Violations: &violations}
+
+	assert.Nil(t, threshold.Validate())
+}
+
+func TestInvalidViolationsInPeriodTimeThresholdBecauseOfMissingViolations(t *testing.T) {
+	timeWindow := int64(600000)
+	threshold := WebsiteTimeThreshold{Type: WebsiteTimeThresholdTypeViolationsInPeriod, TimeWindow: &timeWindow}
+
+	err := threshold.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "violations")
+}
+
+func TestInvalidViolationsInPeriodTimeThresholdBecauseOfTooManyViolations(t *testing.T) {
+	timeWindow := int64(600000)
+	violations := 13
+	threshold := WebsiteTimeThreshold{Type: WebsiteTimeThresholdTypeViolationsInPeriod, TimeWindow: &timeWindow, Violations: &violations}
+
+	err := threshold.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "violations")
+}
+
+func TestValidUserImpactTimeThresholdWithUsers(t *testing.T) {
+	timeWindow := int64(600000)
+	users := 5
+	threshold := WebsiteTimeThreshold{Type: WebsiteTimeThresholdTypeUserImpactOfViolationsInSequence, TimeWindow: &timeWindow, Users: &users}
+
+	assert.Nil(t, threshold.Validate())
+}
+
+func TestValidUserImpactTimeThresholdWithUserPercentage(t *testing.T) {
+	timeWindow := int64(600000)
+	userPercentage := 0.5
+	threshold := WebsiteTimeThreshold{Type: WebsiteTimeThresholdTypeUserImpactOfViolationsInSequence, TimeWindow: &timeWindow, UserPercentage: &userPercentage}
+
+	assert.Nil(t, threshold.Validate())
+}
+
+func TestInvalidUserImpactTimeThresholdBecauseNeitherUsersNorUserPercentageIsDefined(t *testing.T) {
+	timeWindow := int64(600000)
+	threshold := WebsiteTimeThreshold{Type: WebsiteTimeThresholdTypeUserImpactOfViolationsInSequence, TimeWindow: &timeWindow}
+
+	err := threshold.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "either users or user percentage")
+}
+
+func TestInvalidUserImpactTimeThresholdBecauseOfZeroUsers(t *testing.T) {
+	timeWindow := int64(600000)
+	users := 0
+	threshold := WebsiteTimeThreshold{Type: WebsiteTimeThresholdTypeUserImpactOfViolationsInSequence, TimeWindow: &timeWindow, Users: &users}
+
+	err := threshold.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "users of user impact")
+}
+
+func TestInvalidUserImpactTimeThresholdBecauseOfUserPercentageOutOfRange(t *testing.T) {
+	timeWindow := int64(600000)
+	userPercentage := 1.5
+	threshold := WebsiteTimeThreshold{Type: WebsiteTimeThresholdTypeUserImpactOfViolationsInSequence, TimeWindow: &timeWindow, UserPercentage: &userPercentage}
+
+	err := threshold.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "user percentage")
+}
+
+func createTestWebsiteAlertConfig() WebsiteAlertConfig {
+	aggregation := "P90"
+	thresholdValue := 5000.0
+	timeWindow := int64(600000)
+	return WebsiteAlertConfig{
+		ID:              websiteAlertConfigID,
+		Name:            websiteAlertConfigName,
+		Description:     "description",
+		WebsiteID:       websiteAlertConfigWebsiteID,
+		Severity:        SeverityWarning.GetAPIRepresentation(),
+		TagFilters:      []TagFilter{{Name: "beacon.page.name", Operator: "EQUALS", Value: "home"}},
+		Rule:            WebsiteAlertRule{AlertType: WebsiteAlertRuleTypeSlowness, MetricName: websiteAlertConfigMetricName, Aggregation: &aggregation},
+		Threshold:       Threshold{Type: ThresholdTypeStatic, Operator: ">=", Value: &thresholdValue},
+		AlertChannelIDs: []string{"channel-1"},
+		Granularity:     600000,
+		TimeThreshold:   WebsiteTimeThreshold{Type: WebsiteTimeThresholdTypeViolationsInSequence, TimeWindow: &timeWindow},
+		Enabled:         true,
+	}
+}
@@ -0,0 +1,30 @@
+package restapi
+
+import (
+	"errors"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//WebsiteMonitoringConfigResourcePath path to website monitoring config resource of Instana RESTful API
+const WebsiteMonitoringConfigResourcePath = InstanaAPIBasePath + "/website-monitoring/config"
+
+//WebsiteMonitoringConfig is the representation of a website monitoring configuration (website) in Instana. The ID is assigned by Instana when the website is created
+type WebsiteMonitoringConfig struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	AppName string `json:"appName"`
+}
+
+//GetID implemention of the interface InstanaDataObject
+func (c WebsiteMonitoringConfig) GetID() string {
+	return c.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct. The ID is not validated as it is not available before the website is created
+func (c WebsiteMonitoringConfig) Validate() error {
+	if utils.IsBlank(c.Name) {
+		return errors.New("name is missing")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	websiteMonitoringConfigID      = "website-monitoring-config-id"
+	websiteMonitoringConfigName    = "website-monitoring-config-name"
+	websiteMonitoringConfigAppName = "website-monitoring-config-app-name"
+)
+
+func TestValidWebsiteMonitoringConfig(t *testing.T) {
+	config := WebsiteMonitoringConfig{
+		ID:      websiteMonitoringConfigID,
+		Name:    websiteMonitoringConfigName,
+		AppName: websiteMonitoringConfigAppName,
+	}
+
+	assert.Equal(t, websiteMonitoringConfigID, config.GetID())
+	assert.Nil(t, config.Validate())
+}
+
+func TestValidWebsiteMonitoringConfigWithoutID(t *testing.T) {
+	config := WebsiteMonitoringConfig{
+		Name: websiteMonitoringConfigName,
+	}
+
+	assert.Nil(t, config.Validate())
+}
+
+func TestInvalidWebsiteMonitoringConfigBecauseOfMissingName(t *testing.T) {
+	config := WebsiteMonitoringConfig{
+		ID: websiteMonitoringConfigID,
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name")
+}
+
+func TestInvalidWebsiteMonitoringConfigBecauseOfBlankName(t *testing.T) {
+	config := WebsiteMonitoringConfig{
+		ID:   websiteMonitoringConfigID,
+		Name: " ",
+	}
+
+	err := config.Validate()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name")
+}
@@ -0,0 +1,43 @@
+package restapi
+
+import "context"
+
+//NewWebsiteMonitoringConfigRestResource creates a new REST resource for website monitoring configs. Website monitoring configs are not
+//created or updated by sending the data object as JSON body. Instead, the name of the website is provided as query parameter and the
+//ID of new websites is assigned by Instana
+func NewWebsiteMonitoringConfigRestResource(client RestClient) RestResource {
+	unmarshaller := NewWebsiteMonitoringConfigUnmarshaller()
+	return &websiteMonitoringConfigRestResource{
+		RestResource: NewRestResource(WebsiteMonitoringConfigResourcePath, unmarshaller, client),
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type websiteMonitoringConfigRestResource struct {
+	RestResource
+	unmarshaller Unmarshaller
+	client       RestClient
+}
+
+//Upsert creates a new website when the ID of the given data object is empty. Otherwise the website with the given ID is renamed
+func (r *websiteMonitoringConfigRestResource) Upsert(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	config := data.(WebsiteMonitoringConfig)
+	queryParams := map[string]string{"name": config.Name}
+
+	var response []byte
+	var err error
+	if config.ID == "" {
+		response, err = r.client.PostByQuery(ctx, WebsiteMonitoringConfigResourcePath, queryParams)
+	} else {
+		response, err = r.client.PutByQuery(ctx, WebsiteMonitoringConfigResourcePath, config.ID, queryParams)
+	}
+	if err != nil {
+		return data, err
+	}
+
+	return unmarshalAndValidate(r.unmarshaller, response)
+}
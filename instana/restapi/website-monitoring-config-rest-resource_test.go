@@ -0,0 +1,97 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const websiteMonitoringConfigResponse = `{
+	"id" : "website-monitoring-config-id",
+	"name" : "website-monitoring-config-name",
+	"appName" : "website-monitoring-config-app-name"
+}`
+
+func TestShouldCreateWebsiteMonitoringConfigWhenIDIsNotSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteMonitoringConfigRestResource(client)
+
+	client.EXPECT().PostByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, map[string]string{"name": websiteMonitoringConfigName}).Return([]byte(websiteMonitoringConfigResponse), nil)
+
+	result, err := sut.Upsert(context.Background(), WebsiteMonitoringConfig{Name: websiteMonitoringConfigName})
+
+	assert.Nil(t, err)
+	assert.Equal(t, WebsiteMonitoringConfig{ID: websiteMonitoringConfigID, Name: websiteMonitoringConfigName, AppName: websiteMonitoringConfigAppName}, result)
+}
+
+func TestShouldUpdateWebsiteMonitoringConfigWhenIDIsSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteMonitoringConfigRestResource(client)
+
+	client.EXPECT().PutByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, map[string]string{"name": websiteMonitoringConfigName}).Return([]byte(websiteMonitoringConfigResponse), nil)
+
+	result, err := sut.Upsert(context.Background(), WebsiteMonitoringConfig{ID: websiteMonitoringConfigID, Name: websiteMonitoringConfigName})
+
+	assert.Nil(t, err)
+	assert.Equal(t, websiteMonitoringConfigID, result.GetID())
+}
+
+func TestShouldFailToUpsertWebsiteMonitoringConfigWhenDataObjectIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteMonitoringConfigRestResource(client)
+
+	_, err := sut.Upsert(context.Background(), WebsiteMonitoringConfig{ID: websiteMonitoringConfigID})
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUpsertWebsiteMonitoringConfigWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteMonitoringConfigRestResource(client)
+	expectedError := errors.New("test")
+
+	client.EXPECT().PostByQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedError)
+
+	_, err := sut.Upsert(context.Background(), WebsiteMonitoringConfig{Name: websiteMonitoringConfigName})
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToUpsertWebsiteMonitoringConfigWhenResponseIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteMonitoringConfigRestResource(client)
+
+	client.EXPECT().PutByQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte(`{"id" : "website-monitoring-config-id"}`), nil)
+
+	_, err := sut.Upsert(context.Background(), WebsiteMonitoringConfig{ID: websiteMonitoringConfigID, Name: websiteMonitoringConfigName})
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUpsertWebsiteMonitoringConfigWhenResponseIsNotAJsonDocument(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewWebsiteMonitoringConfigRestResource(client)
+
+	client.EXPECT().PutByQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("invalid"), nil)
+
+	_, err := sut.Upsert(context.Background(), WebsiteMonitoringConfig{ID: websiteMonitoringConfigID, Name: websiteMonitoringConfigName})
+
+	assert.NotNil(t, err)
+}
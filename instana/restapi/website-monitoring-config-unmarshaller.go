@@ -0,0 +1,27 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//NewWebsiteMonitoringConfigUnmarshaller creates a new Unmarshaller instance for website monitoring configs
+func NewWebsiteMonitoringConfigUnmarshaller() Unmarshaller {
+	return &websiteMonitoringConfigUnmarshaller{}
+}
+
+type websiteMonitoringConfigUnmarshaller struct{}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *websiteMonitoringConfigUnmarshaller) Unmarshal(data []byte) (InstanaDataObject, error) {
+	config := WebsiteMonitoringConfig{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return config, nil
+}
+
+//UnmarshalArray Unmarshaller interface implementation
+func (u *websiteMonitoringConfigUnmarshaller) UnmarshalArray(data []byte) ([]InstanaDataObject, error) {
+	return unmarshalArray(data, u.Unmarshal)
+}
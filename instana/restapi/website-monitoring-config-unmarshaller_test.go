@@ -0,0 +1,77 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+func TestShouldSuccessfullyUnmarshalWebsiteMonitoringConfig(t *testing.T) {
+	config := WebsiteMonitoringConfig{
+		ID:      "config-id",
+		Name:    "config-name",
+		AppName: "app-name",
+	}
+
+	serializedJSON, _ := json.Marshal(config)
+
+	result, err := NewWebsiteMonitoringConfigUnmarshaller().Unmarshal(serializedJSON)
+
+	assert.Nil(t, err)
+	assert.Equal(t, config, result)
+}
+
+func TestShouldFailToUnmarshalWebsiteMonitoringConfigWhenResponseIsAJsonArray(t *testing.T) {
+	response := `["foo","bar"]`
+
+	_, err := NewWebsiteMonitoringConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldFailToUnmarshalWebsiteMonitoringConfigWhenResponseIsNotAJsonMessage(t *testing.T) {
+	response := `foo bar`
+
+	_, err := NewWebsiteMonitoringConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.NotNil(t, err)
+}
+
+func TestShouldReturnEmptyWebsiteMonitoringConfigWhenJsonObjectIsReturnWhereNoFiledMatches(t *testing.T) {
+	response := `{"foo" : "bar" }`
+
+	result, err := NewWebsiteMonitoringConfigUnmarshaller().Unmarshal([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Equal(t, WebsiteMonitoringConfig{}, result)
+}
+
+func TestShouldSuccessfullyUnmarshalArrayOfWebsiteMonitoringConfigs(t *testing.T) {
+	response := `[{
+		"id" : "test-id-1",
+		"name" : "test-name-1",
+		"appName" : "app-name-1"
+	},{
+		"id" : "test-id-2",
+		"name" : "test-name-2",
+		"appName" : "app-name-2"
+	}]`
+
+	result, err := NewWebsiteMonitoringConfigUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "test-id-1", result[0].(WebsiteMonitoringConfig).ID)
+	assert.Equal(t, "app-name-2", result[1].(WebsiteMonitoringConfig).AppName)
+}
+
+func TestShouldFailToUnmarshalArrayOfWebsiteMonitoringConfigsWhenResponseIsAJsonObject(t *testing.T) {
+	response := `{"id" : "test-id"}`
+
+	_, err := NewWebsiteMonitoringConfigUnmarshaller().UnmarshalArray([]byte(response))
+
+	assert.NotNil(t, err)
+}
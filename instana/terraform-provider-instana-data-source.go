@@ -0,0 +1,10 @@
+package instana
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//DataSource interface definition of a terraform data source implementation of the instana provider
+type DataSource interface {
+	CreateResource() *schema.Resource
+}
@@ -1,7 +1,9 @@
 package instana
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/utils"
@@ -9,7 +11,7 @@ import (
 )
 
 //SetComputedFieldsFunc function definition used by a ResourceHandle to set computed fieds of a terraform resource at the time of creation
-type SetComputedFieldsFunc func(d *schema.ResourceData)
+type SetComputedFieldsFunc func(d *schema.ResourceData) error
 
 //UpdateStateFunc function definition used by a ResourceHandle to update the state of a terraform resource with the data provided by the InstanaDataObject
 type UpdateStateFunc func(d *schema.ResourceData, obj restapi.InstanaDataObject) error
@@ -17,20 +19,32 @@ type UpdateStateFunc func(d *schema.ResourceData, obj restapi.InstanaDataObject)
 //MapStateFunc function definition used by a ResourceHandle to map the terraform state to the corresponting struct of type InstanaDataObject
 type MapStateFunc func(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error)
 
+//BeforeUpsertFunc function definition used by a ResourceHandle to run additional checks against the Instana API before the data object is created or updated
+type BeforeUpsertFunc func(ctx context.Context, d *schema.ResourceData, obj restapi.InstanaDataObject, api restapi.InstanaAPI) error
+
 //RestResourceFactoryFunc factory method definition to create/return the RestResource from the given InstanaAPI for a ResourceHandle
 type RestResourceFactoryFunc func(api restapi.InstanaAPI) restapi.RestResource
 
-//ResourceHandle resource specific implementation which provides meta data and maps data from/to terraform state. Together with TerraformResource terraform schema resources can be created
+//ResourceHandle resource specific implementation which provides meta data and maps data from/to terraform state. Together with TerraformResource terraform schema resources can be created.
+//NameField and FullNameField are optional and refer to the schema fields of the configured name and the computed full name. When set the name is restored from the full name on import.
+//SkipIDGeneration is optional and must be set for resources where the ID is assigned by Instana. In this case no random ID is generated on create.
+//BeforeUpsert is optional and is called with the mapped data object before it is created or updated. When an error is returned the data object is not sent to Instana.
+//CustomizeDiff is optional and can be used to validate dependencies between schema fields at plan time which cannot be expressed by the schema itself.
 type ResourceHandle struct {
-	ResourceName   string
-	Schema         map[string]*schema.Schema
-	SchemaVersion  int
-	StateUpgraders []schema.StateUpgrader
+	ResourceName     string
+	Schema           map[string]*schema.Schema
+	SchemaVersion    int
+	StateUpgraders   []schema.StateUpgrader
+	NameField        string
+	FullNameField    string
+	SkipIDGeneration bool
 
 	RestResourceFactory  RestResourceFactoryFunc
 	UpdateState          UpdateStateFunc
 	MapStateToDataObject MapStateFunc
 	SetComputedFields    SetComputedFieldsFunc
+	BeforeUpsert         BeforeUpsertFunc
+	CustomizeDiff        schema.CustomizeDiffFunc
 }
 
 //NewTerraformResource creates a new terraform resource for the given handle
@@ -40,37 +54,81 @@ func NewTerraformResource(handle *ResourceHandle) TerraformResource {
 	}
 }
 
-//TerraformResource internal simplified representation of a Terraform resource
+//TerraformResource internal simplified representation of a Terraform resource. The context of the operations is cancelled when terraform is interrupted or when the timeout of the operation is exceeded
 type TerraformResource interface {
-	Create(d *schema.ResourceData, meta interface{}) error
-	Read(d *schema.ResourceData, meta interface{}) error
-	Update(d *schema.ResourceData, meta interface{}) error
-	Delete(d *schema.ResourceData, meta interface{}) error
+	Create(ctx context.Context, d *schema.ResourceData, meta interface{}) error
+	Read(ctx context.Context, d *schema.ResourceData, meta interface{}) error
+	Update(ctx context.Context, d *schema.ResourceData, meta interface{}) error
+	Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) error
+	Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error)
 	ToSchemaResource() *schema.Resource
 }
 
+//DefaultResourceTimeout the default timeout of the create, update and delete operations of the terraform resources
+const DefaultResourceTimeout = 5 * time.Minute
+
+//ContextAwareCRUDFunc function definition of a terraform CRUD operation which is executed with a context
+type ContextAwareCRUDFunc func(ctx context.Context, d *schema.ResourceData, meta interface{}) error
+
+//ContextAwareImportFunc function definition of a terraform import operation which is executed with a context
+type ContextAwareImportFunc func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error)
+
+//WithOperationContext adapts the context aware CRUD function to the terraform CRUD function. The context is derived from the stop context of the provider and is cancelled when the timeout with the given key is exceeded
+func WithOperationContext(timeoutKey string, f ContextAwareCRUDFunc) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		ctx, cancel := newOperationContext(d, meta, timeoutKey)
+		defer cancel()
+		return f(ctx, d, meta)
+	}
+}
+
+//WithImportContext adapts the context aware import function to the terraform import function. The read timeout is applied to imports
+func WithImportContext(f ContextAwareImportFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		ctx, cancel := newOperationContext(d, meta, schema.TimeoutRead)
+		defer cancel()
+		return f(ctx, d, meta)
+	}
+}
+
+func newOperationContext(d *schema.ResourceData, meta interface{}, timeoutKey string) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(meta.(*ProviderMeta).stopContext(), d.Timeout(timeoutKey))
+}
+
+func newDefaultResourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(DefaultResourceTimeout),
+		Update: schema.DefaultTimeout(DefaultResourceTimeout),
+		Delete: schema.DefaultTimeout(DefaultResourceTimeout),
+	}
+}
+
 type terraformResourceImpl struct {
 	resourceHandle *ResourceHandle
 }
 
 //Create defines the create operation for the terraform resource
-func (r *terraformResourceImpl) Create(d *schema.ResourceData, meta interface{}) error {
-	d.SetId(RandomID())
+func (r *terraformResourceImpl) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	if !r.resourceHandle.SkipIDGeneration {
+		d.SetId(RandomID())
+	}
 	if r.resourceHandle.SetComputedFields != nil {
-		r.resourceHandle.SetComputedFields(d)
+		if err := r.resourceHandle.SetComputedFields(d); err != nil {
+			return err
+		}
 	}
-	return r.Update(d, meta)
+	return r.Update(ctx, d, meta)
 }
 
 //Read defines the read operation for the terraform resource
-func (r *terraformResourceImpl) Read(d *schema.ResourceData, meta interface{}) error {
+func (r *terraformResourceImpl) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 	id := d.Id()
 	if len(id) == 0 {
 		return fmt.Errorf("ID of %s is missing", r.resourceHandle.ResourceName)
 	}
-	obj, err := r.resourceHandle.RestResourceFactory(instanaAPI).GetOne(id)
+	obj, err := r.resourceHandle.RestResourceFactory(instanaAPI).GetOne(ctx, id)
 	if err != nil {
 		if err == restapi.ErrEntityNotFound {
 			d.SetId("")
@@ -83,7 +141,7 @@ func (r *terraformResourceImpl) Read(d *schema.ResourceData, meta interface{}) e
 }
 
 //Update defines the update operation for the terraform resource
-func (r *terraformResourceImpl) Update(d *schema.ResourceData, meta interface{}) error {
+func (r *terraformResourceImpl) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
@@ -91,16 +149,31 @@ func (r *terraformResourceImpl) Update(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	updatedObject, err := r.resourceHandle.RestResourceFactory(instanaAPI).Upsert(obj)
+	if r.resourceHandle.BeforeUpsert != nil {
+		if err = r.resourceHandle.BeforeUpsert(ctx, d, obj, instanaAPI); err != nil {
+			return err
+		}
+	}
+	updatedObject, err := r.resourceHandle.RestResourceFactory(instanaAPI).Upsert(ctx, obj)
 	if err != nil {
+		keepIDOfCreatedObject(d, updatedObject)
 		return err
 	}
 	r.resourceHandle.UpdateState(d, updatedObject)
 	return nil
 }
 
+//keepIDOfCreatedObject sets the ID of an object which was created by Instana although the upsert failed afterwards, e.g. because the
+//response is not valid or a subsequent request failed. Terraform keeps the resource as tainted in the state and replaces it with the
+//next apply instead of creating a duplicate
+func keepIDOfCreatedObject(d *schema.ResourceData, obj restapi.InstanaDataObject) {
+	if d.Id() == "" && obj != nil && obj.GetID() != "" {
+		d.SetId(obj.GetID())
+	}
+}
+
 //Delete defines the delete operation for the terraform resource
-func (r *terraformResourceImpl) Delete(d *schema.ResourceData, meta interface{}) error {
+func (r *terraformResourceImpl) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
@@ -108,7 +181,7 @@ func (r *terraformResourceImpl) Delete(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	err = r.resourceHandle.RestResourceFactory(instanaAPI).DeleteByID(object.GetID())
+	err = r.resourceHandle.RestResourceFactory(instanaAPI).DeleteByID(ctx, object.GetID())
 	if err != nil {
 		return err
 	}
@@ -116,14 +189,41 @@ func (r *terraformResourceImpl) Delete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+//Import defines the import operation for the terraform resource. The object is read by the ID provided by the user and the name is restored from the full name when supported by the resource handle
+func (r *terraformResourceImpl) Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	obj, err := r.resourceHandle.RestResourceFactory(instanaAPI).GetOne(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err = r.resourceHandle.UpdateState(d, obj); err != nil {
+		return nil, err
+	}
+	if len(r.resourceHandle.NameField) > 0 && len(r.resourceHandle.FullNameField) > 0 {
+		fullName := d.Get(r.resourceHandle.FullNameField).(string)
+		if err = d.Set(r.resourceHandle.NameField, providerMeta.ResourceNameFormatter.UndoFormat(fullName)); err != nil {
+			return nil, err
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+//ToSchemaResource creates the terraform schema resource for the resource handle
 func (r *terraformResourceImpl) ToSchemaResource() *schema.Resource {
 	return &schema.Resource{
-		Create:         r.Create,
-		Read:           r.Read,
-		Update:         r.Update,
-		Delete:         r.Delete,
+		Create: WithOperationContext(schema.TimeoutCreate, r.Create),
+		Read:   WithOperationContext(schema.TimeoutRead, r.Read),
+		Update: WithOperationContext(schema.TimeoutUpdate, r.Update),
+		Delete: WithOperationContext(schema.TimeoutDelete, r.Delete),
+		Importer: &schema.ResourceImporter{
+			State: WithImportContext(r.Import),
+		},
+		Timeouts:       newDefaultResourceTimeouts(),
 		Schema:         r.resourceHandle.Schema,
 		SchemaVersion:  r.resourceHandle.SchemaVersion,
 		StateUpgraders: r.resourceHandle.StateUpgraders,
+		CustomizeDiff:  r.resourceHandle.CustomizeDiff,
 	}
 }
@@ -1,6 +1,7 @@
 package instana_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -20,13 +21,13 @@ func TestShouldSuccessfullyReadTestObjectFromInstanaAPIWhenBaseDataIsReturned(t
 	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
 		resourceData := createEmptyAlertingChannelEmailResourceData(t)
 		resourceData.SetId(alertingChannelEmailID)
-		mockTestObjectApi := mocks.NewMockRestResource(ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().GetOne(gomock.Eq(alertingChannelEmailID)).Return(expectedModel, nil).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).Return(expectedModel, nil).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Read(resourceData, providerMeta)
+		err := NewTerraformResource(resourceHandle).Read(context.Background(), resourceData, providerMeta)
 
 		assert.Nil(t, err)
 		verifyTestObjectModelAppliedToResource(expectedModel, resourceData, t)
@@ -39,7 +40,7 @@ func TestShouldFailToReadTestObjectFromInstanaAPIWhenIDIsMissing(t *testing.T) {
 		resourceData := createEmptyAlertingChannelEmailResourceData(t)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Read(resourceData, providerMeta)
+		err := NewTerraformResource(resourceHandle).Read(context.Background(), resourceData, providerMeta)
 
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "ID of instana_alerting_channel_email")
@@ -51,13 +52,13 @@ func TestShouldFailToReadTestObjectFromInstanaAPIAndDeleteResourceWhenRoleDoesNo
 	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
 		resourceData := createEmptyAlertingChannelEmailResourceData(t)
 		resourceData.SetId(alertingChannelEmailID)
-		mockTestObjectApi := mocks.NewMockRestResource(ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().GetOne(gomock.Eq(alertingChannelEmailID)).Return(restapi.AlertingChannel{}, restapi.ErrEntityNotFound).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).Return(restapi.AlertingChannel{}, restapi.ErrEntityNotFound).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Read(resourceData, providerMeta)
+		err := NewTerraformResource(resourceHandle).Read(context.Background(), resourceData, providerMeta)
 
 		assert.Nil(t, err)
 		assert.GreaterOrEqual(t, 0, len(resourceData.Id()))
@@ -70,13 +71,13 @@ func TestShouldFailToReadTestObjectFromInstanaAPIAndReturnErrorWhenAPICallFails(
 		resourceData := createEmptyAlertingChannelEmailResourceData(t)
 		resourceData.SetId(alertingChannelEmailID)
 		expectedError := errors.New("test")
-		mockTestObjectApi := mocks.NewMockRestResource(ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().GetOne(gomock.Eq(alertingChannelEmailID)).Return(restapi.AlertingChannel{}, expectedError).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).Return(restapi.AlertingChannel{}, expectedError).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Read(resourceData, providerMeta)
+		err := NewTerraformResource(resourceHandle).Read(context.Background(), resourceData, providerMeta)
 
 		assert.Equal(t, expectedError, err)
 		assert.NotEqual(t, 0, len(resourceData.Id()))
@@ -89,14 +90,38 @@ func TestShouldCreateTestObjectThroughInstanaAPI(t *testing.T) {
 		data := createTestAlertingChannelEmailData()
 		resourceData := createAlertingChannelEmailResourceData(data, t)
 		expectedModel := createTestAlertingChannelEmailObject()
-		mockTestObjectApi := mocks.NewMockRestResource(ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
-		mockTestObjectApi.EXPECT().Upsert(gomock.AssignableToTypeOf(restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1)
+		mockTestObjectApi.EXPECT().Upsert(gomock.Any(), gomock.AssignableToTypeOf(restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Create(resourceData, providerMeta)
+		err := NewTerraformResource(resourceHandle).Create(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		verifyTestObjectModelAppliedToResource(expectedModel, resourceData, t)
+	})
+}
+
+func TestShouldCreateTestObjectWithoutGeneratedIDThroughInstanaAPIWhenIDGenerationIsSkipped(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		data := createTestAlertingChannelEmailData()
+		resourceData := createAlertingChannelEmailResourceData(data, t)
+		expectedModel := createTestAlertingChannelEmailObject()
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
+		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
+		mockTestObjectApi.EXPECT().Upsert(gomock.Any(), gomock.AssignableToTypeOf(restapi.AlertingChannel{})).DoAndReturn(func(ctx context.Context, obj restapi.InstanaDataObject) (restapi.InstanaDataObject, error) {
+			assert.Empty(t, obj.GetID())
+			return expectedModel, nil
+		}).Times(1)
+
+		resourceHandle := NewAlertingChannelEmailResourceHandle()
+		resourceHandle.SkipIDGeneration = true
+		err := NewTerraformResource(resourceHandle).Create(context.Background(), resourceData, providerMeta)
 
 		assert.Nil(t, err)
 		verifyTestObjectModelAppliedToResource(expectedModel, resourceData, t)
@@ -109,19 +134,107 @@ func TestShouldReturnErrorWhenCreateTestObjectFailsThroughInstanaAPI(t *testing.
 		data := createTestAlertingChannelEmailData()
 		resourceData := createAlertingChannelEmailResourceData(data, t)
 		expectedError := errors.New("test")
-		mockTestObjectApi := mocks.NewMockRestResource(ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
-		mockTestObjectApi.EXPECT().Upsert(gomock.AssignableToTypeOf(restapi.AlertingChannel{})).Return(restapi.AlertingChannel{}, expectedError).Times(1)
+		mockTestObjectApi.EXPECT().Upsert(gomock.Any(), gomock.AssignableToTypeOf(restapi.AlertingChannel{})).Return(restapi.AlertingChannel{}, expectedError).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Create(resourceData, providerMeta)
+		err := NewTerraformResource(resourceHandle).Create(context.Background(), resourceData, providerMeta)
 
 		assert.Equal(t, expectedError, err)
 	})
 }
 
+func TestShouldKeepIDOfCreatedTestObjectWhenUpsertFailsAfterTheObjectWasCreatedByInstana(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		data := createTestAlertingChannelEmailData()
+		resourceData := createAlertingChannelEmailResourceData(data, t)
+		expectedError := errors.New("test")
+		createdModel := createTestAlertingChannelEmailObject()
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
+		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
+		mockTestObjectApi.EXPECT().Upsert(gomock.Any(), gomock.AssignableToTypeOf(restapi.AlertingChannel{})).Return(createdModel, expectedError).Times(1)
+
+		resourceHandle := NewAlertingChannelEmailResourceHandle()
+		resourceHandle.SkipIDGeneration = true
+		err := NewTerraformResource(resourceHandle).Create(context.Background(), resourceData, providerMeta)
+
+		assert.Equal(t, expectedError, err)
+		assert.Equal(t, createdModel.ID, resourceData.Id())
+	})
+}
+
+func TestShouldNotSetIDWhenCreateTestObjectFailsWithoutObjectCreatedByInstana(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		data := createTestAlertingChannelEmailData()
+		resourceData := createAlertingChannelEmailResourceData(data, t)
+		expectedError := errors.New("test")
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
+		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
+		mockTestObjectApi.EXPECT().Upsert(gomock.Any(), gomock.AssignableToTypeOf(restapi.AlertingChannel{})).Return(nil, expectedError).Times(1)
+
+		resourceHandle := NewAlertingChannelEmailResourceHandle()
+		resourceHandle.SkipIDGeneration = true
+		err := NewTerraformResource(resourceHandle).Create(context.Background(), resourceData, providerMeta)
+
+		assert.Equal(t, expectedError, err)
+		assert.Empty(t, resourceData.Id())
+	})
+}
+
+func TestShouldCreateTestObjectThroughInstanaAPIWhenCheckBeforeUpsertSucceeds(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		data := createTestAlertingChannelEmailData()
+		data[AlertingChannelFieldVerifyOnApply] = true
+		resourceData := createAlertingChannelEmailResourceData(data, t)
+		expectedModel := createTestAlertingChannelEmailObject()
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(2)
+		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
+		gomock.InOrder(
+			mockTestObjectApi.EXPECT().Test(gomock.Any(), gomock.AssignableToTypeOf(restapi.AlertingChannel{})).Return(nil).Times(1),
+			mockTestObjectApi.EXPECT().Upsert(gomock.Any(), gomock.AssignableToTypeOf(restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1),
+		)
+
+		resourceHandle := NewAlertingChannelEmailResourceHandle()
+		err := NewTerraformResource(resourceHandle).Create(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		verifyTestObjectModelAppliedToResource(expectedModel, resourceData, t)
+	})
+}
+
+func TestShouldNotCreateTestObjectThroughInstanaAPIWhenCheckBeforeUpsertFails(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		data := createTestAlertingChannelEmailData()
+		data[AlertingChannelFieldVerifyOnApply] = true
+		resourceData := createAlertingChannelEmailResourceData(data, t)
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
+		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
+		mockTestObjectApi.EXPECT().Test(gomock.Any(), gomock.AssignableToTypeOf(restapi.AlertingChannel{})).Return(errors.New("test")).Times(1)
+		mockTestObjectApi.EXPECT().Upsert(gomock.Any(), gomock.Any()).Times(0)
+
+		resourceHandle := NewAlertingChannelEmailResourceHandle()
+		err := NewTerraformResource(resourceHandle).Create(context.Background(), resourceData, providerMeta)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "failed to send test notification through alerting channel name; test")
+	})
+}
+
 func TestShouldDeleteTestObjectThroughInstanaAPI(t *testing.T) {
 	testHelper := NewTestHelper(t)
 	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
@@ -129,14 +242,14 @@ func TestShouldDeleteTestObjectThroughInstanaAPI(t *testing.T) {
 		data := createTestAlertingChannelEmailData()
 		resourceData := createAlertingChannelEmailResourceData(data, t)
 		resourceData.SetId(id)
-		mockTestObjectApi := mocks.NewMockRestResource(ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
-		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq(id)).Return(nil).Times(1)
+		mockTestObjectApi.EXPECT().DeleteByID(gomock.Any(), gomock.Eq(id)).Return(nil).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Delete(resourceData, providerMeta)
+		err := NewTerraformResource(resourceHandle).Delete(context.Background(), resourceData, providerMeta)
 
 		assert.Nil(t, err)
 		assert.GreaterOrEqual(t, 0, len(resourceData.Id()))
@@ -151,20 +264,161 @@ func TestShouldReturnErrorWhenDeleteTestObjectFailsThroughInstanaAPI(t *testing.
 		resourceData := createAlertingChannelEmailResourceData(data, t)
 		resourceData.SetId(id)
 		expectedError := errors.New("test")
-		mockTestObjectApi := mocks.NewMockRestResource(ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
-		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq(id)).Return(expectedError).Times(1)
+		mockTestObjectApi.EXPECT().DeleteByID(gomock.Any(), gomock.Eq(id)).Return(expectedError).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Delete(resourceData, providerMeta)
+		err := NewTerraformResource(resourceHandle).Delete(context.Background(), resourceData, providerMeta)
 
 		assert.Equal(t, expectedError, err)
 		assert.NotEqual(t, 0, len(resourceData.Id()))
 	})
 }
 
+func TestShouldImportTestObjectThroughInstanaAPIAndUndoNameFormatting(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createEmptyAlertingChannelEmailResourceData(t)
+		resourceData.SetId(alertingChannelEmailID)
+		expectedModel := createTestAlertingChannelEmailObject()
+		expectedModel.Name = "prefix name suffix"
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).Return(expectedModel, nil).Times(1)
+		mockResourceNameFormatter.EXPECT().UndoFormat(expectedModel.Name).Return("name").Times(1)
+
+		resourceHandle := NewAlertingChannelEmailResourceHandle()
+		result, err := NewTerraformResource(resourceHandle).Import(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*schema.ResourceData{resourceData}, result)
+		verifyTestObjectModelAppliedToResource(expectedModel, resourceData, t)
+		assert.Equal(t, "name", resourceData.Get(AlertingChannelFieldName))
+	})
+}
+
+func TestShouldImportTestObjectThroughInstanaAPIWithoutNameFormattingWhenResourceHandleDoesNotDefineNameFields(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceHandle := NewUserRoleResourceHandle()
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+		resourceData.SetId("role-id")
+		expectedModel := restapi.UserRole{ID: "role-id", Name: "role-name"}
+		mockTestObjectApi := mocks.NewMockRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().UserRoles().Return(mockTestObjectApi).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq("role-id")).Return(expectedModel, nil).Times(1)
+
+		result, err := NewTerraformResource(resourceHandle).Import(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "role-id", resourceData.Id())
+		assert.Equal(t, "role-name", resourceData.Get(UserRoleFieldName))
+	})
+}
+
+func TestShouldReturnErrorWhenNameFieldCannotBeSetDuringImport(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createEmptyAlertingChannelEmailResourceData(t)
+		resourceData.SetId(alertingChannelEmailID)
+		expectedModel := createTestAlertingChannelEmailObject()
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).Return(expectedModel, nil).Times(1)
+		mockResourceNameFormatter.EXPECT().UndoFormat(expectedModel.Name).Return("name").Times(1)
+
+		resourceHandle := NewAlertingChannelEmailResourceHandle()
+		resourceHandle.NameField = "unknown_field"
+		result, err := NewTerraformResource(resourceHandle).Import(context.Background(), resourceData, providerMeta)
+
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestShouldReturnErrorWhenImportOfTestObjectFailsThroughInstanaAPI(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createEmptyAlertingChannelEmailResourceData(t)
+		resourceData.SetId(alertingChannelEmailID)
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).Return(restapi.AlertingChannel{}, restapi.ErrEntityNotFound).Times(1)
+
+		resourceHandle := NewAlertingChannelEmailResourceHandle()
+		result, err := NewTerraformResource(resourceHandle).Import(context.Background(), resourceData, providerMeta)
+
+		assert.Equal(t, restapi.ErrEntityNotFound, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestShouldProvideImporterForSchemaResource(t *testing.T) {
+	resource := NewTerraformResource(NewAlertingChannelEmailResourceHandle()).ToSchemaResource()
+
+	assert.NotNil(t, resource.Importer)
+	assert.NotNil(t, resource.Importer.State)
+}
+
+func TestShouldProvideDefaultTimeoutsForSchemaResource(t *testing.T) {
+	resource := NewTerraformResource(NewAlertingChannelEmailResourceHandle()).ToSchemaResource()
+
+	assert.NotNil(t, resource.Timeouts)
+	assert.Equal(t, DefaultResourceTimeout, *resource.Timeouts.Create)
+	assert.Equal(t, DefaultResourceTimeout, *resource.Timeouts.Update)
+	assert.Equal(t, DefaultResourceTimeout, *resource.Timeouts.Delete)
+}
+
+func TestShouldPassContextWithTimeoutToInstanaAPIWhenSchemaResourceIsRead(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceData := createEmptyAlertingChannelEmailResourceData(t)
+		resourceData.SetId(alertingChannelEmailID)
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).DoAndReturn(func(ctx context.Context, id string) (restapi.InstanaDataObject, error) {
+			_, hasDeadline := ctx.Deadline()
+			assert.True(t, hasDeadline)
+			assert.Nil(t, ctx.Err())
+			return createTestAlertingChannelEmailObject(), nil
+		}).Times(1)
+
+		err := NewTerraformResource(NewAlertingChannelEmailResourceHandle()).ToSchemaResource().Read(resourceData, providerMeta)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestShouldPassCancelledContextToInstanaAPIWhenStopContextOfProviderIsCancelled(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		stopContext, cancel := context.WithCancel(context.Background())
+		cancel()
+		providerMeta.StopContext = stopContext
+		resourceData := createEmptyAlertingChannelEmailResourceData(t)
+		resourceData.SetId(alertingChannelEmailID)
+		mockTestObjectApi := mocks.NewMockAlertingChannelResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).DoAndReturn(func(ctx context.Context, id string) (restapi.InstanaDataObject, error) {
+			return nil, ctx.Err()
+		}).Times(1)
+
+		err := NewTerraformResource(NewAlertingChannelEmailResourceHandle()).ToSchemaResource().Read(resourceData, providerMeta)
+
+		assert.Equal(t, context.Canceled, err)
+	})
+}
+
 func verifyTestObjectModelAppliedToResource(model restapi.AlertingChannel, resourceData *schema.ResourceData, t *testing.T) {
 	assert.Equal(t, model.ID, resourceData.Id())
 	assert.Equal(t, model.Name, resourceData.Get(AlertingChannelFieldFullName))
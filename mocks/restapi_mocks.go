@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	restapi "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
@@ -85,48 +86,137 @@ func (m *MockRestClient) EXPECT() *MockRestClientMockRecorder {
 	return m.recorder
 }
 
+// Get mocks base method
+func (m *MockRestClient) Get(ctx context.Context, resourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockRestClientMockRecorder) Get(ctx, resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRestClient)(nil).Get), ctx, resourcePath)
+}
+
 // GetOne mocks base method
-func (m *MockRestClient) GetOne(id, resourcePath string) ([]byte, error) {
+func (m *MockRestClient) GetOne(ctx context.Context, id, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOne", id, resourcePath)
+	ret := m.ctrl.Call(m, "GetOne", ctx, id, resourcePath)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOne indicates an expected call of GetOne
-func (mr *MockRestClientMockRecorder) GetOne(id, resourcePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) GetOne(ctx, id, resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockRestClient)(nil).GetOne), ctx, id, resourcePath)
+}
+
+// Post mocks base method
+func (m *MockRestClient) Post(ctx context.Context, data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", ctx, data, resourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Post indicates an expected call of Post
+func (mr *MockRestClientMockRecorder) Post(ctx, data, resourcePath interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockRestClient)(nil).GetOne), id, resourcePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockRestClient)(nil).Post), ctx, data, resourcePath)
+}
+
+// PostByQuery mocks base method
+func (m *MockRestClient) PostByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostByQuery", ctx, resourcePath, queryParams)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostByQuery indicates an expected call of PostByQuery
+func (mr *MockRestClientMockRecorder) PostByQuery(ctx, resourcePath, queryParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostByQuery", reflect.TypeOf((*MockRestClient)(nil).PostByQuery), ctx, resourcePath, queryParams)
 }
 
 // Put mocks base method
-func (m *MockRestClient) Put(data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
+func (m *MockRestClient) Put(ctx context.Context, data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Put", data, resourcePath)
+	ret := m.ctrl.Call(m, "Put", ctx, data, resourcePath)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Put indicates an expected call of Put
-func (mr *MockRestClientMockRecorder) Put(data, resourcePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) Put(ctx, data, resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockRestClient)(nil).Put), ctx, data, resourcePath)
+}
+
+// PutByQuery mocks base method
+func (m *MockRestClient) PutByQuery(ctx context.Context, resourcePath, id string, queryParams map[string]string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutByQuery", ctx, resourcePath, id, queryParams)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutByQuery indicates an expected call of PutByQuery
+func (mr *MockRestClientMockRecorder) PutByQuery(ctx, resourcePath, id, queryParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockRestClient)(nil).Put), data, resourcePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutByQuery", reflect.TypeOf((*MockRestClient)(nil).PutByQuery), ctx, resourcePath, id, queryParams)
+}
+
+// PutByPath mocks base method
+func (m *MockRestClient) PutByPath(ctx context.Context, resourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutByPath", ctx, resourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutByPath indicates an expected call of PutByPath
+func (mr *MockRestClientMockRecorder) PutByPath(ctx, resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutByPath", reflect.TypeOf((*MockRestClient)(nil).PutByPath), ctx, resourcePath)
 }
 
 // Delete mocks base method
-func (m *MockRestClient) Delete(resourceID, resourceBasePath string) error {
+func (m *MockRestClient) Delete(ctx context.Context, resourceID, resourceBasePath string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", resourceID, resourceBasePath)
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceID, resourceBasePath)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockRestClientMockRecorder) Delete(resourceID, resourceBasePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) Delete(ctx, resourceID, resourceBasePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestClient)(nil).Delete), ctx, resourceID, resourceBasePath)
+}
+
+// DeleteByQuery mocks base method
+func (m *MockRestClient) DeleteByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByQuery", ctx, resourcePath, queryParams)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByQuery indicates an expected call of DeleteByQuery
+func (mr *MockRestClientMockRecorder) DeleteByQuery(ctx, resourcePath, queryParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestClient)(nil).Delete), resourceID, resourceBasePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByQuery", reflect.TypeOf((*MockRestClient)(nil).DeleteByQuery), ctx, resourcePath, queryParams)
 }
 
 // MockRestResource is a mock of RestResource interface
@@ -152,62 +242,423 @@ func (m *MockRestResource) EXPECT() *MockRestResourceMockRecorder {
 	return m.recorder
 }
 
+// GetAll mocks base method
+func (m *MockRestResource) GetAll(ctx context.Context) ([]restapi.InstanaDataObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll
+func (mr *MockRestResourceMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRestResource)(nil).GetAll), ctx)
+}
+
+// GetOne mocks base method
+func (m *MockRestResource) GetOne(ctx context.Context, id string) (restapi.InstanaDataObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOne", ctx, id)
+	ret0, _ := ret[0].(restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOne indicates an expected call of GetOne
+func (mr *MockRestResourceMockRecorder) GetOne(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockRestResource)(nil).GetOne), ctx, id)
+}
+
+// Upsert mocks base method
+func (m *MockRestResource) Upsert(ctx context.Context, data restapi.InstanaDataObject) (restapi.InstanaDataObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, data)
+	ret0, _ := ret[0].(restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert
+func (mr *MockRestResourceMockRecorder) Upsert(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockRestResource)(nil).Upsert), ctx, data)
+}
+
+// Delete mocks base method
+func (m *MockRestResource) Delete(ctx context.Context, data restapi.InstanaDataObject) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockRestResourceMockRecorder) Delete(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestResource)(nil).Delete), ctx, data)
+}
+
+// DeleteByID mocks base method
+func (m *MockRestResource) DeleteByID(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID
+func (mr *MockRestResourceMockRecorder) DeleteByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockRestResource)(nil).DeleteByID), ctx, id)
+}
+
+// MockAlertingChannelResource is a mock of AlertingChannelResource interface
+type MockAlertingChannelResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertingChannelResourceMockRecorder
+}
+
+// MockAlertingChannelResourceMockRecorder is the mock recorder for MockAlertingChannelResource
+type MockAlertingChannelResourceMockRecorder struct {
+	mock *MockAlertingChannelResource
+}
+
+// NewMockAlertingChannelResource creates a new mock instance
+func NewMockAlertingChannelResource(ctrl *gomock.Controller) *MockAlertingChannelResource {
+	mock := &MockAlertingChannelResource{ctrl: ctrl}
+	mock.recorder = &MockAlertingChannelResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAlertingChannelResource) EXPECT() *MockAlertingChannelResourceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method
+func (m *MockAlertingChannelResource) GetAll(ctx context.Context) ([]restapi.InstanaDataObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll
+func (mr *MockAlertingChannelResourceMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAlertingChannelResource)(nil).GetAll), ctx)
+}
+
+// GetOne mocks base method
+func (m *MockAlertingChannelResource) GetOne(ctx context.Context, id string) (restapi.InstanaDataObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOne", ctx, id)
+	ret0, _ := ret[0].(restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOne indicates an expected call of GetOne
+func (mr *MockAlertingChannelResourceMockRecorder) GetOne(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockAlertingChannelResource)(nil).GetOne), ctx, id)
+}
+
+// Upsert mocks base method
+func (m *MockAlertingChannelResource) Upsert(ctx context.Context, data restapi.InstanaDataObject) (restapi.InstanaDataObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, data)
+	ret0, _ := ret[0].(restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert
+func (mr *MockAlertingChannelResourceMockRecorder) Upsert(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockAlertingChannelResource)(nil).Upsert), ctx, data)
+}
+
+// Delete mocks base method
+func (m *MockAlertingChannelResource) Delete(ctx context.Context, data restapi.InstanaDataObject) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockAlertingChannelResourceMockRecorder) Delete(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAlertingChannelResource)(nil).Delete), ctx, data)
+}
+
+// DeleteByID mocks base method
+func (m *MockAlertingChannelResource) DeleteByID(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID
+func (mr *MockAlertingChannelResourceMockRecorder) DeleteByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockAlertingChannelResource)(nil).DeleteByID), ctx, id)
+}
+
+// Test mocks base method
+func (m *MockAlertingChannelResource) Test(ctx context.Context, channel restapi.AlertingChannel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Test", ctx, channel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Test indicates an expected call of Test
+func (mr *MockAlertingChannelResourceMockRecorder) Test(ctx, channel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Test", reflect.TypeOf((*MockAlertingChannelResource)(nil).Test), ctx, channel)
+}
+
+// MockBuiltinEventSpecificationResource is a mock of BuiltinEventSpecificationResource interface
+type MockBuiltinEventSpecificationResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockBuiltinEventSpecificationResourceMockRecorder
+}
+
+// MockBuiltinEventSpecificationResourceMockRecorder is the mock recorder for MockBuiltinEventSpecificationResource
+type MockBuiltinEventSpecificationResourceMockRecorder struct {
+	mock *MockBuiltinEventSpecificationResource
+}
+
+// NewMockBuiltinEventSpecificationResource creates a new mock instance
+func NewMockBuiltinEventSpecificationResource(ctrl *gomock.Controller) *MockBuiltinEventSpecificationResource {
+	mock := &MockBuiltinEventSpecificationResource{ctrl: ctrl}
+	mock.recorder = &MockBuiltinEventSpecificationResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBuiltinEventSpecificationResource) EXPECT() *MockBuiltinEventSpecificationResourceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method
+func (m *MockBuiltinEventSpecificationResource) GetAll(ctx context.Context) ([]restapi.InstanaDataObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll
+func (mr *MockBuiltinEventSpecificationResourceMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBuiltinEventSpecificationResource)(nil).GetAll), ctx)
+}
+
+// GetOne mocks base method
+func (m *MockBuiltinEventSpecificationResource) GetOne(ctx context.Context, id string) (restapi.InstanaDataObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOne", ctx, id)
+	ret0, _ := ret[0].(restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOne indicates an expected call of GetOne
+func (mr *MockBuiltinEventSpecificationResourceMockRecorder) GetOne(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockBuiltinEventSpecificationResource)(nil).GetOne), ctx, id)
+}
+
+// Upsert mocks base method
+func (m *MockBuiltinEventSpecificationResource) Upsert(ctx context.Context, data restapi.InstanaDataObject) (restapi.InstanaDataObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, data)
+	ret0, _ := ret[0].(restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert
+func (mr *MockBuiltinEventSpecificationResourceMockRecorder) Upsert(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockBuiltinEventSpecificationResource)(nil).Upsert), ctx, data)
+}
+
+// Delete mocks base method
+func (m *MockBuiltinEventSpecificationResource) Delete(ctx context.Context, data restapi.InstanaDataObject) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockBuiltinEventSpecificationResourceMockRecorder) Delete(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBuiltinEventSpecificationResource)(nil).Delete), ctx, data)
+}
+
+// DeleteByID mocks base method
+func (m *MockBuiltinEventSpecificationResource) DeleteByID(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID
+func (mr *MockBuiltinEventSpecificationResourceMockRecorder) DeleteByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockBuiltinEventSpecificationResource)(nil).DeleteByID), ctx, id)
+}
+
+// Enable mocks base method
+func (m *MockBuiltinEventSpecificationResource) Enable(ctx context.Context, id string) (restapi.InstanaDataObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enable", ctx, id)
+	ret0, _ := ret[0].(restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enable indicates an expected call of Enable
+func (mr *MockBuiltinEventSpecificationResourceMockRecorder) Enable(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockBuiltinEventSpecificationResource)(nil).Enable), ctx, id)
+}
+
+// Disable mocks base method
+func (m *MockBuiltinEventSpecificationResource) Disable(ctx context.Context, id string) (restapi.InstanaDataObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", ctx, id)
+	ret0, _ := ret[0].(restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Disable indicates an expected call of Disable
+func (mr *MockBuiltinEventSpecificationResourceMockRecorder) Disable(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockBuiltinEventSpecificationResource)(nil).Disable), ctx, id)
+}
+
+// MockServiceConfigResource is a mock of ServiceConfigResource interface
+type MockServiceConfigResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceConfigResourceMockRecorder
+}
+
+// MockServiceConfigResourceMockRecorder is the mock recorder for MockServiceConfigResource
+type MockServiceConfigResourceMockRecorder struct {
+	mock *MockServiceConfigResource
+}
+
+// NewMockServiceConfigResource creates a new mock instance
+func NewMockServiceConfigResource(ctrl *gomock.Controller) *MockServiceConfigResource {
+	mock := &MockServiceConfigResource{ctrl: ctrl}
+	mock.recorder = &MockServiceConfigResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockServiceConfigResource) EXPECT() *MockServiceConfigResourceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method
+func (m *MockServiceConfigResource) GetAll(ctx context.Context) ([]restapi.InstanaDataObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll
+func (mr *MockServiceConfigResourceMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockServiceConfigResource)(nil).GetAll), ctx)
+}
+
 // GetOne mocks base method
-func (m *MockRestResource) GetOne(id string) (restapi.InstanaDataObject, error) {
+func (m *MockServiceConfigResource) GetOne(ctx context.Context, id string) (restapi.InstanaDataObject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOne", id)
+	ret := m.ctrl.Call(m, "GetOne", ctx, id)
 	ret0, _ := ret[0].(restapi.InstanaDataObject)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOne indicates an expected call of GetOne
-func (mr *MockRestResourceMockRecorder) GetOne(id interface{}) *gomock.Call {
+func (mr *MockServiceConfigResourceMockRecorder) GetOne(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockRestResource)(nil).GetOne), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockServiceConfigResource)(nil).GetOne), ctx, id)
 }
 
 // Upsert mocks base method
-func (m *MockRestResource) Upsert(data restapi.InstanaDataObject) (restapi.InstanaDataObject, error) {
+func (m *MockServiceConfigResource) Upsert(ctx context.Context, data restapi.InstanaDataObject) (restapi.InstanaDataObject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Upsert", data)
+	ret := m.ctrl.Call(m, "Upsert", ctx, data)
 	ret0, _ := ret[0].(restapi.InstanaDataObject)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Upsert indicates an expected call of Upsert
-func (mr *MockRestResourceMockRecorder) Upsert(data interface{}) *gomock.Call {
+func (mr *MockServiceConfigResourceMockRecorder) Upsert(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockRestResource)(nil).Upsert), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockServiceConfigResource)(nil).Upsert), ctx, data)
 }
 
 // Delete mocks base method
-func (m *MockRestResource) Delete(data restapi.InstanaDataObject) error {
+func (m *MockServiceConfigResource) Delete(ctx context.Context, data restapi.InstanaDataObject) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", data)
+	ret := m.ctrl.Call(m, "Delete", ctx, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockRestResourceMockRecorder) Delete(data interface{}) *gomock.Call {
+func (mr *MockServiceConfigResourceMockRecorder) Delete(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestResource)(nil).Delete), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceConfigResource)(nil).Delete), ctx, data)
 }
 
 // DeleteByID mocks base method
-func (m *MockRestResource) DeleteByID(id string) error {
+func (m *MockServiceConfigResource) DeleteByID(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByID", id)
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteByID indicates an expected call of DeleteByID
-func (mr *MockRestResourceMockRecorder) DeleteByID(id interface{}) *gomock.Call {
+func (mr *MockServiceConfigResourceMockRecorder) DeleteByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockRestResource)(nil).DeleteByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockServiceConfigResource)(nil).DeleteByID), ctx, id)
+}
+
+// UpdateOrder mocks base method
+func (m *MockServiceConfigResource) UpdateOrder(ctx context.Context, order restapi.ServiceConfigOrder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrder", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrder indicates an expected call of UpdateOrder
+func (mr *MockServiceConfigResourceMockRecorder) UpdateOrder(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrder", reflect.TypeOf((*MockServiceConfigResource)(nil).UpdateOrder), ctx, order)
 }
 
 // MockInstanaAPI is a mock of InstanaAPI interface
@@ -247,6 +698,20 @@ func (mr *MockInstanaAPIMockRecorder) CustomEventSpecifications() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomEventSpecifications", reflect.TypeOf((*MockInstanaAPI)(nil).CustomEventSpecifications))
 }
 
+// BuiltinEventSpecifications mocks base method
+func (m *MockInstanaAPI) BuiltinEventSpecifications() restapi.BuiltinEventSpecificationResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuiltinEventSpecifications")
+	ret0, _ := ret[0].(restapi.BuiltinEventSpecificationResource)
+	return ret0
+}
+
+// BuiltinEventSpecifications indicates an expected call of BuiltinEventSpecifications
+func (mr *MockInstanaAPIMockRecorder) BuiltinEventSpecifications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuiltinEventSpecifications", reflect.TypeOf((*MockInstanaAPI)(nil).BuiltinEventSpecifications))
+}
+
 // UserRoles mocks base method
 func (m *MockInstanaAPI) UserRoles() restapi.RestResource {
 	m.ctrl.T.Helper()
@@ -276,10 +741,10 @@ func (mr *MockInstanaAPIMockRecorder) ApplicationConfigs() *gomock.Call {
 }
 
 // AlertingChannels mocks base method
-func (m *MockInstanaAPI) AlertingChannels() restapi.RestResource {
+func (m *MockInstanaAPI) AlertingChannels() restapi.AlertingChannelResource {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AlertingChannels")
-	ret0, _ := ret[0].(restapi.RestResource)
+	ret0, _ := ret[0].(restapi.AlertingChannelResource)
 	return ret0
 }
 
@@ -302,3 +767,157 @@ func (mr *MockInstanaAPIMockRecorder) AlertingConfigurations() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertingConfigurations", reflect.TypeOf((*MockInstanaAPI)(nil).AlertingConfigurations))
 }
+
+// MaintenanceConfigurations mocks base method
+func (m *MockInstanaAPI) MaintenanceConfigurations() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaintenanceConfigurations")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// MaintenanceConfigurations indicates an expected call of MaintenanceConfigurations
+func (mr *MockInstanaAPIMockRecorder) MaintenanceConfigurations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaintenanceConfigurations", reflect.TypeOf((*MockInstanaAPI)(nil).MaintenanceConfigurations))
+}
+
+// WebsiteMonitoringConfig mocks base method
+func (m *MockInstanaAPI) WebsiteMonitoringConfig() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteMonitoringConfig")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// WebsiteMonitoringConfig indicates an expected call of WebsiteMonitoringConfig
+func (mr *MockInstanaAPIMockRecorder) WebsiteMonitoringConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteMonitoringConfig", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteMonitoringConfig))
+}
+
+// WebsiteAlertConfig mocks base method
+func (m *MockInstanaAPI) WebsiteAlertConfig() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteAlertConfig")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// WebsiteAlertConfig indicates an expected call of WebsiteAlertConfig
+func (mr *MockInstanaAPIMockRecorder) WebsiteAlertConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteAlertConfig", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteAlertConfig))
+}
+
+// ServiceConfigs mocks base method
+func (m *MockInstanaAPI) ServiceConfigs() restapi.ServiceConfigResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceConfigs")
+	ret0, _ := ret[0].(restapi.ServiceConfigResource)
+	return ret0
+}
+
+// ServiceConfigs indicates an expected call of ServiceConfigs
+func (mr *MockInstanaAPIMockRecorder) ServiceConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ServiceConfigs))
+}
+
+// HTTPEndpointConfigs mocks base method
+func (m *MockInstanaAPI) HTTPEndpointConfigs() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HTTPEndpointConfigs")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// HTTPEndpointConfigs indicates an expected call of HTTPEndpointConfigs
+func (mr *MockInstanaAPIMockRecorder) HTTPEndpointConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HTTPEndpointConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).HTTPEndpointConfigs))
+}
+
+// SyntheticCallConfig mocks base method
+func (m *MockInstanaAPI) SyntheticCallConfig() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyntheticCallConfig")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// SyntheticCallConfig indicates an expected call of SyntheticCallConfig
+func (mr *MockInstanaAPIMockRecorder) SyntheticCallConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyntheticCallConfig", reflect.TypeOf((*MockInstanaAPI)(nil).SyntheticCallConfig))
+}
+
+// APITokens mocks base method
+func (m *MockInstanaAPI) APITokens() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APITokens")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// APITokens indicates an expected call of APITokens
+func (mr *MockInstanaAPIMockRecorder) APITokens() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APITokens", reflect.TypeOf((*MockInstanaAPI)(nil).APITokens))
+}
+
+// Groups mocks base method
+func (m *MockInstanaAPI) Groups() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Groups")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// Groups indicates an expected call of Groups
+func (mr *MockInstanaAPIMockRecorder) Groups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Groups", reflect.TypeOf((*MockInstanaAPI)(nil).Groups))
+}
+
+// Users mocks base method
+func (m *MockInstanaAPI) Users() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Users")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// Users indicates an expected call of Users
+func (mr *MockInstanaAPIMockRecorder) Users() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Users", reflect.TypeOf((*MockInstanaAPI)(nil).Users))
+}
+
+// TenantUsers mocks base method
+func (m *MockInstanaAPI) TenantUsers() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantUsers")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// TenantUsers indicates an expected call of TenantUsers
+func (mr *MockInstanaAPIMockRecorder) TenantUsers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantUsers", reflect.TypeOf((*MockInstanaAPI)(nil).TenantUsers))
+}
+
+// Releases mocks base method
+func (m *MockInstanaAPI) Releases() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Releases")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// Releases indicates an expected call of Releases
+func (mr *MockInstanaAPIMockRecorder) Releases() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Releases", reflect.TypeOf((*MockInstanaAPI)(nil).Releases))
+}
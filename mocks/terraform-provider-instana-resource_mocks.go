@@ -183,6 +183,21 @@ func (mr *MockTerraformResourceMockRecorder) Delete(d, meta interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTerraformResource)(nil).Delete), d, meta)
 }
 
+// Import mocks base method
+func (m *MockTerraformResource) Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", d, meta)
+	ret0, _ := ret[0].([]*schema.ResourceData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import
+func (mr *MockTerraformResourceMockRecorder) Import(d, meta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockTerraformResource)(nil).Import), d, meta)
+}
+
 // ToSchemaResource mocks base method
 func (m *MockTerraformResource) ToSchemaResource() *schema.Resource {
 	m.ctrl.T.Helper()